@@ -5,7 +5,7 @@ go 1.22.7
 toolchain go1.23.4
 
 require (
-	github.com/Elbujito/2112/src/templates/go-server v0.0.1
+	github.com/Elbujito/2112/src/templates/go-server v0.0.2
 	github.com/clerk/clerk-sdk-go/v2 v2.2.0
 	github.com/go-gormigrate/gormigrate/v2 v2.1.3
 	github.com/go-playground/validator/v10 v10.23.0
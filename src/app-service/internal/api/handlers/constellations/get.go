@@ -0,0 +1,118 @@
+package constellations
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"github.com/Elbujito/2112/src/app-service/internal/services"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+	"github.com/labstack/echo/v4"
+)
+
+type ConstellationHandler struct {
+	Service services.ConstellationService
+}
+
+// NewConstellationHandler creates a new handler with the provided ConstellationService.
+func NewConstellationHandler(service services.ConstellationService) *ConstellationHandler {
+	return &ConstellationHandler{Service: service}
+}
+
+// ConstellationRequest is the payload used to design a constellation.
+// Walker kinds use the walker definition, the custom kind uses the list of planes.
+type ConstellationRequest struct {
+	Name   string                      `json:"name"`
+	Kind   domain.ConstellationKind    `json:"kind"`
+	Walker *xspace.WalkerConstellation `json:"walker"`
+	Planes []domain.ConstellationPlane `json:"planes"`
+	Epoch  *time.Time                  `json:"epoch"` // Defaults to now
+}
+
+func (r ConstellationRequest) toDomain() domain.Constellation {
+	epoch := time.Now().UTC()
+	if r.Epoch != nil && !r.Epoch.IsZero() {
+		epoch = r.Epoch.UTC()
+	}
+	return domain.Constellation{
+		Name:   r.Name,
+		Kind:   r.Kind,
+		Walker: r.Walker,
+		Planes: r.Planes,
+		Epoch:  epoch,
+	}
+}
+
+// GetConstellations lists the constellations of a context.
+func (h *ConstellationHandler) GetConstellations(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	constellations, err := h.Service.List(c.Request().Context(), contextName, tenantID)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch constellations: ", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Unable to fetch constellations")
+	}
+
+	return c.JSON(http.StatusOK, constellations)
+}
+
+// GetConstellation retrieves a constellation by ID.
+func (h *ConstellationHandler) GetConstellation(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	constellation, err := h.Service.Get(c.Request().Context(), contextName, tenantID, c.Param("id"))
+	if err != nil {
+		c.Echo().Logger.Error("Failed to retrieve constellation: ", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Constellation not found")
+	}
+
+	return c.JSON(http.StatusOK, constellation)
+}
+
+// CreateConstellation generates the satellites of a constellation and attaches them to a context.
+func (h *ConstellationHandler) CreateConstellation(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	var request ConstellationRequest
+	if err := c.Bind(&request); err != nil {
+		c.Echo().Logger.Error("Failed to bind constellation: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request payload")
+	}
+
+	constellation, err := h.Service.Create(c.Request().Context(), contextName, tenantID, request.toDomain())
+	if err != nil {
+		c.Echo().Logger.Error("Failed to create constellation: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.JSON(http.StatusCreated, constellation)
+}
+
+// DeleteConstellation removes a constellation and retires its satellites.
+func (h *ConstellationHandler) DeleteConstellation(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	if err := h.Service.Delete(c.Request().Context(), contextName, tenantID, c.Param("id")); err != nil {
+		c.Echo().Logger.Error("Failed to delete constellation: ", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Constellation not found")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetLatestCoverage retrieves the most recent coverage report of a constellation.
+func (h *ConstellationHandler) GetLatestCoverage(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	report, err := h.Service.GetLatestCoverage(c.Request().Context(), contextName, tenantID, c.Param("id"))
+	if err != nil {
+		c.Echo().Logger.Error("Failed to retrieve coverage report: ", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Constellation not found")
+	}
+	if report == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "No coverage computed for this constellation")
+	}
+
+	return c.JSON(http.StatusOK, report)
+}
@@ -0,0 +1,102 @@
+package contacts
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"github.com/Elbujito/2112/src/app-service/internal/services"
+	"github.com/labstack/echo/v4"
+)
+
+// formatICal selects the iCalendar output instead of JSON.
+const formatICal = "ical"
+
+type ContactPlanHandler struct {
+	Service services.ContactSchedulerService
+}
+
+// NewContactPlanHandler creates a new handler with the provided ContactSchedulerService.
+func NewContactPlanHandler(service services.ContactSchedulerService) *ContactPlanHandler {
+	return &ContactPlanHandler{Service: service}
+}
+
+// ContactPlanRequest is the payload used to schedule a contact plan.
+type ContactPlanRequest struct {
+	Satellites []struct {
+		NoradID  string `json:"noradID"`
+		Priority int    `json:"priority"`
+	} `json:"satellites"` // Defaults to every satellite of the context
+	StationIDs      []string `json:"stationIDs"` // Defaults to every station of the context
+	Hours           int      `json:"hours"`
+	SetupSeconds    int      `json:"setupSeconds"`
+	TeardownSeconds int      `json:"teardownSeconds"`
+}
+
+// CreateContactPlan schedules and stores a conflict-free contact plan for a context.
+func (h *ContactPlanHandler) CreateContactPlan(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	var request ContactPlanRequest
+	if err := c.Bind(&request); err != nil {
+		c.Echo().Logger.Error("Failed to bind contact plan request: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request payload")
+	}
+	if request.Hours <= 0 || request.Hours > 24*14 {
+		return echo.NewHTTPError(http.StatusBadRequest, "hours must be between 1 and 336")
+	}
+	if request.SetupSeconds < 0 || request.TeardownSeconds < 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "margins cannot be negative")
+	}
+
+	var requests []domain.ContactRequest
+	for _, satellite := range request.Satellites {
+		requests = append(requests, domain.ContactRequest{NoradID: satellite.NoradID, Priority: satellite.Priority})
+	}
+
+	start := time.Now().UTC()
+	end := start.Add(time.Duration(request.Hours) * time.Hour)
+	plan, err := h.Service.SchedulePlan(
+		c.Request().Context(),
+		contextName,
+		tenantID,
+		requests,
+		request.StationIDs,
+		start,
+		end,
+		time.Duration(request.SetupSeconds)*time.Second,
+		time.Duration(request.TeardownSeconds)*time.Second,
+	)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to schedule contact plan: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return renderPlan(c, http.StatusCreated, plan)
+}
+
+// GetLatestContactPlan retrieves the most recent contact plan of a context as JSON or iCalendar (format=ical).
+func (h *ContactPlanHandler) GetLatestContactPlan(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	plan, err := h.Service.GetLatestPlan(
+		c.Request().Context(),
+		contextName,
+		tenantID,
+	)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch contact plan: ", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Contact plan not found")
+	}
+
+	return renderPlan(c, http.StatusOK, plan)
+}
+
+func renderPlan(c echo.Context, status int, plan domain.ContactPlan) error {
+	if c.QueryParam("format") == formatICal {
+		c.Response().Header().Set(echo.HeaderContentDisposition, "attachment; filename=contact-plan.ics")
+		return c.Blob(status, "text/calendar; charset=utf-8", []byte(plan.ICalendar()))
+	}
+	return c.JSON(status, plan)
+}
@@ -0,0 +1,85 @@
+package dataquality
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"github.com/Elbujito/2112/src/app-service/internal/services"
+	"github.com/labstack/echo/v4"
+)
+
+type DataQualityHandler struct {
+	Service services.DataQualityService
+}
+
+// NewDataQualityHandler creates a new handler with the provided DataQualityService.
+func NewDataQualityHandler(service services.DataQualityService) *DataQualityHandler {
+	return &DataQualityHandler{Service: service}
+}
+
+// GetDataQuality runs the data quality checks of a context, or of every context, without changing anything.
+func (h *DataQualityHandler) GetDataQuality(c echo.Context) error {
+	options, err := parseDataQualityOptions(c)
+	if err != nil {
+		return err
+	}
+	return h.run(c, options)
+}
+
+// PostDataQuality runs the data quality checks and applies the requested fix and prune actions.
+func (h *DataQualityHandler) PostDataQuality(c echo.Context) error {
+	options, err := parseDataQualityOptions(c)
+	if err != nil {
+		return err
+	}
+	if options.Fix, err = boolQueryParam(c, "fix"); err != nil {
+		return err
+	}
+	if options.Prune, err = boolQueryParam(c, "prune"); err != nil {
+		return err
+	}
+	return h.run(c, options)
+}
+
+func (h *DataQualityHandler) run(c echo.Context, options domain.DataQualityOptions) error {
+	report, err := h.Service.Run(c.Request().Context(), domain.GameContextName(c.QueryParam("context")), options)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to run data quality checks: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to run data quality checks")
+	}
+
+	response := map[string]interface{}{
+		"issues": report.Issues(),
+		"report": report,
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// parseDataQualityOptions reads the stale limit in days, defaulting to DefaultDataQualityStaleAfter.
+func parseDataQualityOptions(c echo.Context) (domain.DataQualityOptions, error) {
+	options := domain.DataQualityOptions{StaleAfter: domain.DefaultDataQualityStaleAfter}
+	if daysStr := c.QueryParam("staleDays"); daysStr != "" {
+		days, err := strconv.Atoi(daysStr)
+		if err != nil || days <= 0 {
+			return domain.DataQualityOptions{}, echo.NewHTTPError(http.StatusBadRequest, "invalid staleDays parameter")
+		}
+		options.StaleAfter = time.Duration(days) * 24 * time.Hour
+	}
+	return options, nil
+}
+
+// boolQueryParam reads an optional boolean query parameter, false when missing.
+func boolQueryParam(c echo.Context, name string) (bool, error) {
+	value := c.QueryParam(name)
+	if value == "" {
+		return false, nil
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, echo.NewHTTPError(http.StatusBadRequest, "invalid "+name+" parameter")
+	}
+	return parsed, nil
+}
@@ -0,0 +1,44 @@
+package decay
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/services"
+	"github.com/labstack/echo/v4"
+)
+
+type DecayHandler struct {
+	Service services.DecayService
+}
+
+// NewDecayHandler creates a new handler with the provided DecayService.
+func NewDecayHandler(service services.DecayService) *DecayHandler {
+	return &DecayHandler{Service: service}
+}
+
+// GetUpcomingReentries lists the satellites predicted to reenter within the requested number of days.
+func (h *DecayHandler) GetUpcomingReentries(c echo.Context) error {
+	days := 30 // Default to the next 30 days
+	if daysStr := c.QueryParam("days"); daysStr != "" {
+		parsed, err := strconv.Atoi(daysStr)
+		if err != nil || parsed <= 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid days parameter")
+		}
+		days = parsed
+	}
+
+	predictions, err := h.Service.ListUpcomingReentries(c.Request().Context(), time.Duration(days)*24*time.Hour)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch upcoming reentries: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to fetch upcoming reentries")
+	}
+
+	response := map[string]interface{}{
+		"days":      days,
+		"reentries": predictions,
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
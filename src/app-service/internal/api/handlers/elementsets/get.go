@@ -0,0 +1,76 @@
+package elementsets
+
+import (
+	"net/http"
+
+	"github.com/Elbujito/2112/src/app-service/internal/services"
+	"github.com/labstack/echo/v4"
+)
+
+type ElementSetHandler struct {
+	Service services.ElementSetService
+}
+
+// NewElementSetHandler creates a new handler with the provided ElementSetService.
+func NewElementSetHandler(service services.ElementSetService) *ElementSetHandler {
+	return &ElementSetHandler{Service: service}
+}
+
+// OverrideRequest pins an element set for propagation of a satellite.
+type OverrideRequest struct {
+	NoradID string `json:"noradId"`
+	TleID   string `json:"tleId"`
+	Reason  string `json:"reason"`
+}
+
+// GetCandidates lists the candidate element sets of a satellite, one per source, with the current one.
+func (h *ElementSetHandler) GetCandidates(c echo.Context) error {
+	noradID := c.QueryParam("noradID")
+	if noradID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "noradID is required")
+	}
+
+	candidates, err := h.Service.ListCandidates(c.Request().Context(), noradID)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch element set candidates: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to fetch element set candidates")
+	}
+
+	return c.JSON(http.StatusOK, candidates)
+}
+
+// PutOverride pins an element set for propagation, whatever the precedence policy selects.
+func (h *ElementSetHandler) PutOverride(c echo.Context) error {
+	var request OverrideRequest
+	if err := c.Bind(&request); err != nil {
+		c.Echo().Logger.Error("Failed to bind element set override: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request payload")
+	}
+	if request.NoradID == "" || request.TleID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "noradId and tleId are required")
+	}
+
+	candidates, err := h.Service.SetOverride(c.Request().Context(), request.NoradID, request.TleID, request.Reason)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to override element set: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, candidates)
+}
+
+// DeleteOverride hands the selection of the element set of a satellite back to the precedence policy.
+func (h *ElementSetHandler) DeleteOverride(c echo.Context) error {
+	noradID := c.QueryParam("noradID")
+	if noradID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "noradID is required")
+	}
+
+	candidates, err := h.Service.ClearOverride(c.Request().Context(), noradID)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to clear element set override: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to clear element set override")
+	}
+
+	return c.JSON(http.StatusOK, candidates)
+}
@@ -0,0 +1,77 @@
+package geo
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/services"
+	xconstants "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xconstants"
+	"github.com/labstack/echo/v4"
+)
+
+type GeoHandler struct {
+	Service services.GeoService
+}
+
+// NewGeoHandler creates a new handler with the provided GeoService.
+func NewGeoHandler(service services.GeoService) *GeoHandler {
+	return &GeoHandler{Service: service}
+}
+
+// GetObjectsNearLongitude lists the GEO objects parked near a longitude slot.
+func (h *GeoHandler) GetObjectsNearLongitude(c echo.Context) error {
+	longitude, err := strconv.ParseFloat(c.QueryParam("longitude"), 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid longitude parameter")
+	}
+
+	tolerance := 1.0 // Default to one degree around the slot
+	if toleranceStr := c.QueryParam("tolerance"); toleranceStr != "" {
+		tolerance, err = strconv.ParseFloat(toleranceStr, 64)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid tolerance parameter")
+		}
+	}
+
+	objects, err := h.Service.ListObjectsNearLongitude(c.Request().Context(), longitude, tolerance)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch GEO objects: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	response := map[string]interface{}{
+		"longitude": longitude,
+		"tolerance": tolerance,
+		"objects":   objects,
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetObservationsByNoradID fetches the longitude, drift rate and inclination history of a GEO object.
+func (h *GeoHandler) GetObservationsByNoradID(c echo.Context) error {
+	noradID := c.QueryParam("noradID")
+	if noradID == "" {
+		c.Echo().Logger.Error(xconstants.ERROR_ID_NOT_FOUND)
+		return xconstants.ERROR_ID_NOT_FOUND
+	}
+
+	days := 30 // Default to the last 30 days
+	if daysStr := c.QueryParam("days"); daysStr != "" {
+		parsed, err := strconv.Atoi(daysStr)
+		if err != nil || parsed <= 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid days parameter")
+		}
+		days = parsed
+	}
+
+	since := time.Now().UTC().AddDate(0, 0, -days)
+	observations, err := h.Service.GetObservations(c.Request().Context(), noradID, since)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch GEO observations: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to fetch GEO observations")
+	}
+
+	return c.JSON(http.StatusOK, observations)
+}
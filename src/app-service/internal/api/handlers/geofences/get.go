@@ -0,0 +1,141 @@
+package geofences
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"github.com/Elbujito/2112/src/app-service/internal/services"
+	"github.com/labstack/echo/v4"
+)
+
+type GeofenceHandler struct {
+	Service services.GeofenceService
+}
+
+// NewGeofenceHandler creates a new handler with the provided GeofenceService.
+func NewGeofenceHandler(service services.GeofenceService) *GeofenceHandler {
+	return &GeofenceHandler{Service: service}
+}
+
+// GeofenceRequest is the payload used to create or update a geofence.
+type GeofenceRequest struct {
+	Name     string                `json:"name"`
+	Polygon  domain.GeoJSONPolygon `json:"polygon"`  // GeoJSON Polygon geometry
+	NoradIDs []string              `json:"noradIDs"` // Watched satellites
+}
+
+func (r GeofenceRequest) toDomain() domain.Geofence {
+	return domain.Geofence{
+		Name:     r.Name,
+		Polygon:  r.Polygon,
+		NoradIDs: r.NoradIDs,
+	}
+}
+
+// GetGeofences lists the geofences of a context.
+func (h *GeofenceHandler) GetGeofences(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	geofences, err := h.Service.List(c.Request().Context(), contextName, tenantID)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch geofences: ", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Unable to fetch geofences")
+	}
+
+	return c.JSON(http.StatusOK, geofences)
+}
+
+// GetGeofence retrieves a geofence by ID.
+func (h *GeofenceHandler) GetGeofence(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	geofence, err := h.Service.Get(c.Request().Context(), contextName, tenantID, c.Param("id"))
+	if err != nil {
+		c.Echo().Logger.Error("Failed to retrieve geofence: ", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Geofence not found")
+	}
+
+	return c.JSON(http.StatusOK, geofence)
+}
+
+// CreateGeofence adds a geofence to a context.
+func (h *GeofenceHandler) CreateGeofence(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	var request GeofenceRequest
+	if err := c.Bind(&request); err != nil {
+		c.Echo().Logger.Error("Failed to bind geofence: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request payload")
+	}
+
+	geofence, err := h.Service.Create(c.Request().Context(), contextName, tenantID, request.toDomain())
+	if err != nil {
+		c.Echo().Logger.Error("Failed to create geofence: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.JSON(http.StatusCreated, geofence)
+}
+
+// UpdateGeofence updates a geofence.
+func (h *GeofenceHandler) UpdateGeofence(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	var request GeofenceRequest
+	if err := c.Bind(&request); err != nil {
+		c.Echo().Logger.Error("Failed to bind geofence: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request payload")
+	}
+
+	geofence, err := h.Service.Update(c.Request().Context(), contextName, tenantID, c.Param("id"), request.toDomain())
+	if err != nil {
+		c.Echo().Logger.Error("Failed to update geofence: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, geofence)
+}
+
+// DeleteGeofence removes a geofence.
+func (h *GeofenceHandler) DeleteGeofence(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	if err := h.Service.Delete(c.Request().Context(), contextName, tenantID, c.Param("id")); err != nil {
+		c.Echo().Logger.Error("Failed to delete geofence: ", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Geofence not found")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetUpcomingCrossings lists the predicted enter/exit events of a geofence for the requested number of hours.
+func (h *GeofenceHandler) GetUpcomingCrossings(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	hours := 24 // Default to the next 24 hours
+	if hoursStr := c.QueryParam("hours"); hoursStr != "" {
+		parsed, err := strconv.Atoi(hoursStr)
+		if err != nil || parsed <= 0 || parsed > 24*14 {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid hours parameter")
+		}
+		hours = parsed
+	}
+
+	horizon := time.Duration(hours) * time.Hour
+	events, err := h.Service.GetUpcomingCrossings(c.Request().Context(), contextName, tenantID, c.Param("id"), horizon)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch geofence crossings: ", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Unable to fetch geofence crossings")
+	}
+
+	response := map[string]interface{}{
+		"geofenceID": c.Param("id"),
+		"hours":      hours,
+		"crossings":  events,
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
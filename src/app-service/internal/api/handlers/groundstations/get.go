@@ -0,0 +1,169 @@
+package groundstations
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"github.com/Elbujito/2112/src/app-service/internal/services"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+	"github.com/labstack/echo/v4"
+)
+
+type GroundStationHandler struct {
+	Service services.GroundStationService
+}
+
+// NewGroundStationHandler creates a new handler with the provided GroundStationService.
+func NewGroundStationHandler(service services.GroundStationService) *GroundStationHandler {
+	return &GroundStationHandler{Service: service}
+}
+
+// GroundStationRequest is the payload used to create or update a ground station.
+type GroundStationRequest struct {
+	Name         string             `json:"name"`
+	Latitude     float64            `json:"latitude"`
+	Longitude    float64            `json:"longitude"`
+	Altitude     float64            `json:"altitude"`     // Meters
+	MinElevation float64            `json:"minElevation"` // Degrees
+	HorizonMask  xspace.HorizonMask `json:"horizonMask"`
+	Bands        []domain.Band      `json:"bands"`
+	Refraction   *xspace.Atmosphere `json:"refraction,omitempty"` // Enables the refraction correction with these conditions
+}
+
+func (r GroundStationRequest) toDomain() domain.GroundStation {
+	return domain.GroundStation{
+		Name:         r.Name,
+		Latitude:     r.Latitude,
+		Longitude:    r.Longitude,
+		Altitude:     r.Altitude,
+		MinElevation: r.MinElevation,
+		HorizonMask:  r.HorizonMask,
+		Bands:        r.Bands,
+		Refraction:   r.Refraction,
+	}
+}
+
+// GetGroundStations lists the ground stations of a context.
+func (h *GroundStationHandler) GetGroundStations(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	stations, err := h.Service.List(c.Request().Context(), contextName, tenantID)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch ground stations: ", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Unable to fetch ground stations")
+	}
+
+	return c.JSON(http.StatusOK, stations)
+}
+
+// GetGroundStation retrieves a ground station by ID.
+func (h *GroundStationHandler) GetGroundStation(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	station, err := h.Service.Get(c.Request().Context(), contextName, tenantID, c.Param("id"))
+	if err != nil {
+		c.Echo().Logger.Error("Failed to retrieve ground station: ", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Ground station not found")
+	}
+
+	return c.JSON(http.StatusOK, station)
+}
+
+// CreateGroundStation adds a ground station to a context.
+func (h *GroundStationHandler) CreateGroundStation(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	var request GroundStationRequest
+	if err := c.Bind(&request); err != nil {
+		c.Echo().Logger.Error("Failed to bind ground station: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request payload")
+	}
+
+	station, err := h.Service.Create(c.Request().Context(), contextName, tenantID, request.toDomain())
+	if err != nil {
+		c.Echo().Logger.Error("Failed to create ground station: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.JSON(http.StatusCreated, station)
+}
+
+// UpdateGroundStation updates a ground station.
+func (h *GroundStationHandler) UpdateGroundStation(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	var request GroundStationRequest
+	if err := c.Bind(&request); err != nil {
+		c.Echo().Logger.Error("Failed to bind ground station: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request payload")
+	}
+
+	station, err := h.Service.Update(c.Request().Context(), contextName, tenantID, c.Param("id"), request.toDomain())
+	if err != nil {
+		c.Echo().Logger.Error("Failed to update ground station: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, station)
+}
+
+// DeleteGroundStation removes a ground station.
+func (h *GroundStationHandler) DeleteGroundStation(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	if err := h.Service.Delete(c.Request().Context(), contextName, tenantID, c.Param("id")); err != nil {
+		c.Echo().Logger.Error("Failed to delete ground station: ", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Ground station not found")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetGroundStationPasses predicts the passes of a satellite over a ground station for the requested number of hours.
+// Passes within interferenceDeg degrees of the Sun or the Moon are flagged when the parameter is set.
+func (h *GroundStationHandler) GetGroundStationPasses(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	noradID := c.QueryParam("noradID")
+	if noradID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "noradID is required")
+	}
+
+	hours := 24 // Default to the next 24 hours
+	if hoursStr := c.QueryParam("hours"); hoursStr != "" {
+		parsed, err := strconv.Atoi(hoursStr)
+		if err != nil || parsed <= 0 || parsed > 24*14 {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid hours parameter")
+		}
+		hours = parsed
+	}
+
+	interferenceDeg := 0.0 // Interference flags are disabled by default
+	if interferenceStr := c.QueryParam("interferenceDeg"); interferenceStr != "" {
+		parsed, err := strconv.ParseFloat(interferenceStr, 64)
+		if err != nil || parsed < 0 || parsed > 180 {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid interferenceDeg parameter")
+		}
+		interferenceDeg = parsed
+	}
+
+	start := time.Now().UTC()
+	end := start.Add(time.Duration(hours) * time.Hour)
+	passes, err := h.Service.PredictPasses(c.Request().Context(), contextName, tenantID, c.Param("id"), noradID, start, end, interferenceDeg)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to predict passes: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to predict passes")
+	}
+
+	response := map[string]interface{}{
+		"noradID": noradID,
+		"start":   start,
+		"end":     end,
+		"passes":  passes,
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
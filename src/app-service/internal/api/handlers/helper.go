@@ -1,7 +1,11 @@
 package handlers
 
 import (
+	"strings"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
 	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
 )
 
 func GetUUIDParam(param string) (uuid.UUID, error) {
@@ -31,3 +35,22 @@ type FieldValidationError struct {
 	Kind      string      `json:"kind"`
 	Value     interface{} `json:"value"`
 }
+
+// TenantHeader carries the tenant owning the requested context.
+const TenantHeader = "X-Tenant-ID"
+
+// ContextScope returns the context named by the name path parameter and the tenant of the request.
+func ContextScope(c echo.Context) (domain.GameContextName, domain.TenantID) {
+	return domain.GameContextName(c.Param("name")), domain.TenantID(c.Request().Header.Get(TenantHeader))
+}
+
+// SplitQueryList splits a comma separated query parameter, dropping empty entries.
+func SplitQueryList(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
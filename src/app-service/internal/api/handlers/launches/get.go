@@ -0,0 +1,106 @@
+package launches
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"github.com/Elbujito/2112/src/app-service/internal/services"
+	"github.com/labstack/echo/v4"
+)
+
+type LaunchHandler struct {
+	Service services.LaunchService
+}
+
+// NewLaunchHandler creates a new handler with the provided LaunchService.
+func NewLaunchHandler(service services.LaunchService) *LaunchHandler {
+	return &LaunchHandler{Service: service}
+}
+
+// GetLaunches lists the launches of the catalog with pagination, optionally restricted to a year.
+func (h *LaunchHandler) GetLaunches(c echo.Context) error {
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil || page <= 0 {
+		page = 1 // Default to page 1 if invalid
+	}
+
+	pageSize, err := strconv.Atoi(c.QueryParam("pageSize"))
+	if err != nil || pageSize <= 0 {
+		pageSize = 10 // Default to 10 records per page if invalid
+	}
+
+	year := 0 // All years by default
+	if yearStr := c.QueryParam("year"); yearStr != "" {
+		year, err = strconv.Atoi(yearStr)
+		if err != nil || year <= 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid year parameter")
+		}
+	}
+
+	launches, totalRecords, err := h.Service.ListLaunches(c.Request().Context(), year, page, pageSize)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch launches: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to fetch launches")
+	}
+
+	response := map[string]interface{}{
+		"totalRecords": totalRecords,
+		"page":         page,
+		"pageSize":     pageSize,
+		"launches":     launches,
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetLaunch returns the summary and every cataloged object of a launch, for example "1999-025".
+func (h *LaunchHandler) GetLaunch(c echo.Context) error {
+	designator := c.Param("designator")
+	if _, err := domain.ParseCosparID(designator); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	launch, objects, err := h.Service.GetLaunch(c.Request().Context(), designator)
+	if err != nil {
+		if errors.Is(err, domain.ErrLaunchNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Launch not found")
+		}
+		c.Echo().Logger.Error("Failed to fetch launch: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to fetch launch")
+	}
+
+	response := map[string]interface{}{
+		"launch":  launch,
+		"objects": objects,
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetFragmentations lists the fragmentation events detected within the requested number of days.
+func (h *LaunchHandler) GetFragmentations(c echo.Context) error {
+	days := 30 // Default to the last 30 days
+	if daysStr := c.QueryParam("days"); daysStr != "" {
+		parsed, err := strconv.Atoi(daysStr)
+		if err != nil || parsed <= 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid days parameter")
+		}
+		days = parsed
+	}
+
+	events, err := h.Service.ListFragmentations(c.Request().Context(), time.Duration(days)*24*time.Hour)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch fragmentation events: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to fetch fragmentation events")
+	}
+
+	response := map[string]interface{}{
+		"days":           days,
+		"fragmentations": events,
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,59 @@
+package links
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers"
+	"github.com/Elbujito/2112/src/app-service/internal/services"
+	"github.com/labstack/echo/v4"
+)
+
+type LinkHandler struct {
+	Service services.LinkService
+}
+
+// NewLinkHandler creates a new handler with the provided LinkService.
+func NewLinkHandler(service services.LinkService) *LinkHandler {
+	return &LinkHandler{Service: service}
+}
+
+// GetLinkWindows lists the inter-satellite link windows of a context for the requested number of hours,
+// optionally restricted to a satellite.
+func (h *LinkHandler) GetLinkWindows(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	hours := 24 // Default to the next 24 hours
+	if hoursStr := c.QueryParam("hours"); hoursStr != "" {
+		parsed, err := strconv.Atoi(hoursStr)
+		if err != nil || parsed <= 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid hours parameter")
+		}
+		hours = parsed
+	}
+
+	noradID := c.QueryParam("noradID")
+	from := time.Now().UTC()
+	until := from.Add(time.Duration(hours) * time.Hour)
+	windows, err := h.Service.ListLinkWindows(
+		c.Request().Context(),
+		contextName,
+		tenantID,
+		noradID,
+		from,
+		until,
+	)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch link windows: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to fetch link windows")
+	}
+
+	response := map[string]interface{}{
+		"from":    from,
+		"until":   until,
+		"windows": windows,
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
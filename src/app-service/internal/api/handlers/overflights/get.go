@@ -0,0 +1,160 @@
+package overflights
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"github.com/Elbujito/2112/src/app-service/internal/services"
+	"github.com/labstack/echo/v4"
+)
+
+// overflightCSVHeader is the header row of the CSV export.
+var overflightCSVHeader = []string{"norad_id", "satellite_name", "area_code", "area_name", "entry_at", "exit_at", "duration_seconds", "truncated"}
+
+type OverflightHandler struct {
+	Service services.OverflightService
+}
+
+// NewOverflightHandler creates a new handler with the provided OverflightService.
+func NewOverflightHandler(service services.OverflightService) *OverflightHandler {
+	return &OverflightHandler{Service: service}
+}
+
+// AdminAreaResponse describes an admin area without its boundary.
+type AdminAreaResponse struct {
+	Code       string  `json:"code"`
+	Name       string  `json:"name"`
+	Level      int     `json:"level"`
+	ParentCode string  `json:"parentCode,omitempty"`
+	MinLat     float64 `json:"minLat"`
+	MinLon     float64 `json:"minLon"`
+	MaxLat     float64 `json:"maxLat"`
+	MaxLon     float64 `json:"maxLon"`
+}
+
+// GetAdminAreas lists the admin areas of a level (0 for countries, 1 for regions) loaded from the boundary datasets.
+func (h *OverflightHandler) GetAdminAreas(c echo.Context) error {
+	level := domain.AdminLevelCountry
+	if value := c.QueryParam("level"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid 'level' parameter")
+		}
+		level = parsed
+	}
+
+	areas, err := h.Service.ListAreas(c.Request().Context(), level)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch admin areas: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to fetch admin areas")
+	}
+
+	response := make([]AdminAreaResponse, 0, len(areas))
+	for _, area := range areas {
+		response = append(response, AdminAreaResponse{
+			Code:       area.Code,
+			Name:       area.Name,
+			Level:      area.Level,
+			ParentCode: area.ParentCode,
+			MinLat:     area.MinLat,
+			MinLon:     area.MinLon,
+			MaxLat:     area.MaxLat,
+			MaxLon:     area.MaxLon,
+		})
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetOverflightReports lists the overflight reports of a context without their records.
+func (h *OverflightHandler) GetOverflightReports(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	reports, err := h.Service.ListReports(c.Request().Context(), contextName, tenantID)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch overflight reports: ", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Unable to fetch overflight reports")
+	}
+
+	return c.JSON(http.StatusOK, reports)
+}
+
+// GetOverflightReport retrieves an overflight report with its records.
+func (h *OverflightHandler) GetOverflightReport(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	report, err := h.Service.GetReport(c.Request().Context(), contextName, tenantID, c.Param("id"))
+	if err != nil {
+		c.Echo().Logger.Error("Failed to retrieve overflight report: ", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Overflight report not found")
+	}
+
+	return c.JSON(http.StatusOK, report)
+}
+
+// DeleteOverflightReport removes an overflight report from a context.
+func (h *OverflightHandler) DeleteOverflightReport(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	if err := h.Service.DeleteReport(c.Request().Context(), contextName, tenantID, c.Param("id")); err != nil {
+		c.Echo().Logger.Error("Failed to delete overflight report: ", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Overflight report not found")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ExportOverflightReport downloads the records of an overflight report as CSV or JSON (format query parameter, defaults to csv).
+func (h *OverflightHandler) ExportOverflightReport(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	format := c.QueryParam("format")
+	if format == "" {
+		format = "csv"
+	}
+	if format != "csv" && format != "json" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid 'format' parameter, expected csv or json")
+	}
+
+	report, err := h.Service.GetReport(c.Request().Context(), contextName, tenantID, c.Param("id"))
+	if err != nil {
+		c.Echo().Logger.Error("Failed to retrieve overflight report: ", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Overflight report not found")
+	}
+
+	filename := fmt.Sprintf("overflights-%s.%s", report.ID, format)
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+
+	if format == "json" {
+		return c.JSON(http.StatusOK, report.Records)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, "text/csv")
+	c.Response().WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(c.Response())
+	if err := writer.Write(overflightCSVHeader); err != nil {
+		return err
+	}
+	for _, record := range report.Records {
+		row := []string{
+			record.NoradID,
+			record.SatelliteName,
+			record.AreaCode,
+			record.AreaName,
+			record.EntryAt.Format(time.RFC3339),
+			record.ExitAt.Format(time.RFC3339),
+			strconv.FormatFloat(record.DurationSeconds, 'f', 0, 64),
+			strconv.FormatBool(record.Truncated),
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
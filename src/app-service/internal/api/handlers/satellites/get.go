@@ -3,8 +3,10 @@ package satellites
 import (
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers"
 	"github.com/Elbujito/2112/src/app-service/internal/domain"
 	"github.com/Elbujito/2112/src/app-service/internal/services"
 	xconstants "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xconstants"
@@ -12,12 +14,66 @@ import (
 )
 
 type SatelliteHandler struct {
-	Service services.SatelliteService
+	Service         services.SatelliteService
+	LightingService services.OrbitLightingService
 }
 
-// NewSatelliteHandler creates a new handler with the provided SatelliteService.
-func NewSatelliteHandler(service services.SatelliteService) *SatelliteHandler {
-	return &SatelliteHandler{Service: service}
+// NewSatelliteHandler creates a new handler with the provided SatelliteService and OrbitLightingService.
+func NewSatelliteHandler(service services.SatelliteService, lightingService services.OrbitLightingService) *SatelliteHandler {
+	return &SatelliteHandler{Service: service, LightingService: lightingService}
+}
+
+// GetSatelliteDetail fetches a satellite by NORAD ID with its orbit lighting time series.
+// The series covers the given number of past and upcoming days (query param "days", defaults to 30).
+func (h *SatelliteHandler) GetSatelliteDetail(c echo.Context) error {
+	noradID := c.QueryParam("noradID")
+	if noradID == "" {
+		c.Echo().Logger.Error(xconstants.ERROR_ID_NOT_FOUND)
+		return xconstants.ERROR_ID_NOT_FOUND
+	}
+
+	days, err := strconv.Atoi(c.QueryParam("days"))
+	if err != nil || days <= 0 {
+		days = 30 // Default to 30 days if invalid
+	}
+
+	satellite, err := h.Service.GetSatelliteByNoradID(c.Request().Context(), noradID)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch satellite: ", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Satellite not found")
+	}
+
+	now := time.Now().UTC()
+	window := time.Duration(days) * 24 * time.Hour
+	lighting, err := h.LightingService.GetLightingSeries(c.Request().Context(), noradID, now.Add(-window), now.Add(window))
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch orbit lighting: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to fetch orbit lighting")
+	}
+
+	response := map[string]interface{}{
+		"satellite": satellite,
+		"lighting":  lighting,
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetSatelliteNameHistory fetches the name changes of a satellite by NORAD ID, oldest first.
+func (h *SatelliteHandler) GetSatelliteNameHistory(c echo.Context) error {
+	noradID := c.QueryParam("noradID")
+	if noradID == "" {
+		c.Echo().Logger.Error(xconstants.ERROR_ID_NOT_FOUND)
+		return xconstants.ERROR_ID_NOT_FOUND
+	}
+
+	changes, err := h.Service.GetNameHistory(c.Request().Context(), noradID)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch satellite name history: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to fetch satellite name history")
+	}
+
+	return c.JSON(http.StatusOK, changes)
 }
 
 // GetSatellitePositionsByNoradID fetches satellite positions by NORAD ID.
@@ -62,7 +118,14 @@ func (h *SatelliteHandler) GetPaginatedSatellites(c echo.Context) error {
 
 	// Create SearchRequest object
 	searchRequest := &domain.SearchRequest{
-		Wildcard: searchWildcard,
+		Wildcard:    searchWildcard,
+		OrbitRegime: domain.OrbitRegime(strings.ToUpper(c.QueryParam("orbitRegime"))),
+		Tags:        handlers.SplitQueryList(c.QueryParam("tags")),
+	}
+	if searchRequest.OrbitRegime != "" {
+		if err := searchRequest.OrbitRegime.IsValid(); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
 	}
 
 	// Call the service method for pagination with search filters
@@ -103,7 +166,14 @@ func (h *SatelliteHandler) GetPaginatedSatelliteInfo(c echo.Context) error {
 
 	// Create SearchRequest object
 	searchRequest := &domain.SearchRequest{
-		Wildcard: searchWildcard,
+		Wildcard:    searchWildcard,
+		OrbitRegime: domain.OrbitRegime(strings.ToUpper(c.QueryParam("orbitRegime"))),
+		Tags:        handlers.SplitQueryList(c.QueryParam("tags")),
+	}
+	if searchRequest.OrbitRegime != "" {
+		if err := searchRequest.OrbitRegime.IsValid(); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
 	}
 
 	// Call the service method for paginated SatelliteInfo
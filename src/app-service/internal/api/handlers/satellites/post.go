@@ -0,0 +1,114 @@
+package satellites
+
+import (
+	"net/http"
+	"time"
+
+	xconstants "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xconstants"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+	"github.com/labstack/echo/v4"
+)
+
+// SyntheticSatelliteRequest is the payload used to create a satellite from Keplerian elements.
+// The orbit size is given either by its semi-major axis or by its altitude above the equatorial radius.
+type SyntheticSatelliteRequest struct {
+	Name            string     `json:"name"`
+	Epoch           *time.Time `json:"epoch"` // Defaults to now
+	SemiMajorAxisKm float64    `json:"semiMajorAxisKm"`
+	AltitudeKm      float64    `json:"altitudeKm"`
+	Eccentricity    float64    `json:"eccentricity"`
+	Inclination     float64    `json:"inclination"`
+	RAAN            float64    `json:"raan"`
+	ArgPerigee      float64    `json:"argPerigee"`
+	MeanAnomaly     float64    `json:"meanAnomaly"`
+	BStar           float64    `json:"bstar"`
+}
+
+func (r SyntheticSatelliteRequest) toElements() xspace.KeplerianElements {
+	semiMajorAxis := r.SemiMajorAxisKm
+	if semiMajorAxis == 0 {
+		semiMajorAxis = xconstants.EARTH_EQUATORIAL_RADIUS_KM + r.AltitudeKm
+	}
+	return xspace.KeplerianElements{
+		Epoch:           requestEpoch(r.Epoch),
+		SemiMajorAxisKm: semiMajorAxis,
+		Eccentricity:    r.Eccentricity,
+		Inclination:     r.Inclination,
+		RAAN:            r.RAAN,
+		ArgPerigee:      r.ArgPerigee,
+		MeanAnomaly:     r.MeanAnomaly,
+		BStar:           r.BStar,
+	}
+}
+
+// WalkerConstellationRequest is the payload used to create the satellites of a Walker T/P/F constellation.
+type WalkerConstellationRequest struct {
+	NamePrefix string     `json:"namePrefix"`
+	Epoch      *time.Time `json:"epoch"` // Defaults to now
+	xspace.WalkerConstellation
+}
+
+func requestEpoch(epoch *time.Time) time.Time {
+	if epoch == nil || epoch.IsZero() {
+		return time.Now().UTC()
+	}
+	return epoch.UTC()
+}
+
+// CreateSyntheticSatellite creates a user-defined satellite and its generated TLE.
+func (h *SatelliteHandler) CreateSyntheticSatellite(c echo.Context) error {
+	var request SyntheticSatelliteRequest
+	if err := c.Bind(&request); err != nil {
+		c.Echo().Logger.Error("Failed to bind synthetic satellite: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request payload")
+	}
+
+	satellite, tle, err := h.Service.CreateSyntheticSatellite(c.Request().Context(), request.Name, request.toElements())
+	if err != nil {
+		c.Echo().Logger.Error("Failed to create synthetic satellite: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	response := map[string]interface{}{
+		"satellite": satellite,
+		"tle":       tle,
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// CreateWalkerConstellation creates the synthetic satellites of a Walker constellation.
+func (h *SatelliteHandler) CreateWalkerConstellation(c echo.Context) error {
+	var request WalkerConstellationRequest
+	if err := c.Bind(&request); err != nil {
+		c.Echo().Logger.Error("Failed to bind walker constellation: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request payload")
+	}
+	if request.NamePrefix == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "namePrefix is required")
+	}
+
+	satellites, tles, err := h.Service.CreateWalkerConstellation(c.Request().Context(), request.NamePrefix, request.WalkerConstellation, requestEpoch(request.Epoch))
+	if err != nil {
+		c.Echo().Logger.Error("Failed to create walker constellation: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	response := map[string]interface{}{
+		"satellites": satellites,
+		"tles":       tles,
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// GetSyntheticSatellites lists the user-defined satellites.
+func (h *SatelliteHandler) GetSyntheticSatellites(c echo.Context) error {
+	satellites, err := h.Service.ListSyntheticSatellites(c.Request().Context())
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch synthetic satellites: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to fetch synthetic satellites")
+	}
+
+	return c.JSON(http.StatusOK, satellites)
+}
@@ -0,0 +1,110 @@
+package sensors
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/services"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+	"github.com/labstack/echo/v4"
+)
+
+type SensorHandler struct {
+	Service services.SensorService
+}
+
+// NewSensorHandler creates a new handler with the provided SensorService.
+func NewSensorHandler(service services.SensorService) *SensorHandler {
+	return &SensorHandler{Service: service}
+}
+
+// GetSensor retrieves the sensor of a satellite.
+func (h *SensorHandler) GetSensor(c echo.Context) error {
+	noradID := c.QueryParam("noradID")
+	if noradID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "noradID is required")
+	}
+
+	sensor, err := h.Service.GetSensor(c.Request().Context(), noradID)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch sensor: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to fetch sensor")
+	}
+	if sensor == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Sensor not found")
+	}
+
+	return c.JSON(http.StatusOK, sensor)
+}
+
+// PutSensor attaches or replaces the sensor of a satellite.
+func (h *SensorHandler) PutSensor(c echo.Context) error {
+	noradID := c.QueryParam("noradID")
+	if noradID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "noradID is required")
+	}
+
+	var sensor xspace.Sensor
+	if err := c.Bind(&sensor); err != nil {
+		c.Echo().Logger.Error("Failed to bind sensor: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request payload")
+	}
+
+	satelliteSensor, err := h.Service.AttachSensor(c.Request().Context(), noradID, sensor)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to attach sensor: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, satelliteSensor)
+}
+
+// DeleteSensor detaches the sensor of a satellite.
+func (h *SensorHandler) DeleteSensor(c echo.Context) error {
+	noradID := c.QueryParam("noradID")
+	if noradID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "noradID is required")
+	}
+
+	if err := h.Service.DetachSensor(c.Request().Context(), noradID); err != nil {
+		c.Echo().Logger.Error("Failed to detach sensor: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to detach sensor")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetImageableTiles lists the tiles a satellite sensor can image within the requested number of hours.
+func (h *SensorHandler) GetImageableTiles(c echo.Context) error {
+	noradID := c.QueryParam("noradID")
+	if noradID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "noradID is required")
+	}
+
+	hours := 24 // Default to the next 24 hours
+	if hoursStr := c.QueryParam("hours"); hoursStr != "" {
+		parsed, err := strconv.Atoi(hoursStr)
+		if err != nil || parsed <= 0 || parsed > 24*7 {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid hours parameter")
+		}
+		hours = parsed
+	}
+
+	start := time.Now().UTC()
+	end := start.Add(time.Duration(hours) * time.Hour)
+	mappings, err := h.Service.ListImageableTiles(c.Request().Context(), noradID, start, end)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to compute imageable tiles: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to compute imageable tiles")
+	}
+
+	response := map[string]interface{}{
+		"noradID": noradID,
+		"start":   start,
+		"end":     end,
+		"tiles":   mappings,
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
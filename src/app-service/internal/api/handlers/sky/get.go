@@ -0,0 +1,83 @@
+package sky
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers"
+	"github.com/Elbujito/2112/src/app-service/internal/services"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+	"github.com/labstack/echo/v4"
+)
+
+type SkyHandler struct {
+	Service services.SkyService
+}
+
+// NewSkyHandler creates a new handler with the provided SkyService.
+func NewSkyHandler(service services.SkyService) *SkyHandler {
+	return &SkyHandler{Service: service}
+}
+
+// GetSky returns the satellites of a context, the Sun, the Moon and the planets in azimuth and elevation
+// for the observer given by latitude, longitude and altitude (meters) at time (RFC3339, defaults to now).
+// Refraction is applied when both pressure (millibars) and temperature (Celsius) are provided.
+func (h *SkyHandler) GetSky(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	latitude, err := strconv.ParseFloat(c.QueryParam("latitude"), 64)
+	if err != nil || latitude < -90 || latitude > 90 {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid latitude parameter")
+	}
+	longitude, err := strconv.ParseFloat(c.QueryParam("longitude"), 64)
+	if err != nil || longitude < -180 || longitude > 180 {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid longitude parameter")
+	}
+	observer := xspace.Observer{Latitude: latitude, Longitude: longitude}
+
+	if altitudeStr := c.QueryParam("altitude"); altitudeStr != "" {
+		altitude, err := strconv.ParseFloat(altitudeStr, 64)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid altitude parameter")
+		}
+		observer.AltitudeKm = altitude / 1000
+	}
+
+	pressureStr, temperatureStr := c.QueryParam("pressure"), c.QueryParam("temperature")
+	if pressureStr != "" && temperatureStr != "" {
+		pressure, errPressure := strconv.ParseFloat(pressureStr, 64)
+		temperature, errTemperature := strconv.ParseFloat(temperatureStr, 64)
+		atmosphere := xspace.Atmosphere{PressureMbar: pressure, TemperatureC: temperature}
+		if errPressure != nil || errTemperature != nil || atmosphere.Validate() != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid pressure or temperature parameter")
+		}
+		observer.Refraction = &atmosphere
+	}
+
+	at := time.Now().UTC()
+	if timeStr := c.QueryParam("time"); timeStr != "" {
+		parsed, err := time.Parse(time.RFC3339, timeStr)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid time parameter, expected RFC3339")
+		}
+		at = parsed.UTC()
+	}
+
+	minElevation := 0.0 // Satellites above the horizon by default
+	if minElevationStr := c.QueryParam("minElevation"); minElevationStr != "" {
+		parsed, err := strconv.ParseFloat(minElevationStr, 64)
+		if err != nil || parsed < -90 || parsed > 90 {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid minElevation parameter")
+		}
+		minElevation = parsed
+	}
+
+	view, err := h.Service.GetSky(c.Request().Context(), contextName, tenantID, observer, at, minElevation)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to compute sky view: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to compute sky view")
+	}
+
+	return c.JSON(http.StatusOK, view)
+}
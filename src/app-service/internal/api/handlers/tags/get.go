@@ -0,0 +1,157 @@
+package tags
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"github.com/Elbujito/2112/src/app-service/internal/services"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+type SatelliteTagHandler struct {
+	Service services.SatelliteTagService
+}
+
+// NewSatelliteTagHandler creates a new handler with the provided SatelliteTagService.
+func NewSatelliteTagHandler(service services.SatelliteTagService) *SatelliteTagHandler {
+	return &SatelliteTagHandler{Service: service}
+}
+
+// TagRequest is the payload used to create a tag.
+type TagRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// TagSatellitesRequest is the payload used to tag satellites.
+type TagSatellitesRequest struct {
+	NoradIDs []string `json:"noradIds"`
+}
+
+// GetTags lists all tags.
+func (h *SatelliteTagHandler) GetTags(c echo.Context) error {
+	tags, err := h.Service.ListTags(c.Request().Context())
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch tags: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to fetch tags")
+	}
+	return c.JSON(http.StatusOK, tags)
+}
+
+// CreateTag creates a tag.
+func (h *SatelliteTagHandler) CreateTag(c echo.Context) error {
+	var request TagRequest
+	if err := c.Bind(&request); err != nil {
+		c.Echo().Logger.Error("Failed to bind tag: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request payload")
+	}
+
+	tag, err := h.Service.CreateTag(c.Request().Context(), request.Name, request.Description)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to create tag: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return c.JSON(http.StatusCreated, tag)
+}
+
+// DeleteTag deletes a tag and removes it from its satellites.
+func (h *SatelliteTagHandler) DeleteTag(c echo.Context) error {
+	if err := h.Service.DeleteTag(c.Request().Context(), c.Param("tag")); err != nil {
+		c.Echo().Logger.Error("Failed to delete tag: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to delete tag")
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetSatellitesByTags lists the satellites having any of the comma separated tags.
+func (h *SatelliteTagHandler) GetSatellitesByTags(c echo.Context) error {
+	tags := handlers.SplitQueryList(c.QueryParam("tags"))
+	if len(tags) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "tags is required")
+	}
+
+	satellites, err := h.Service.ListSatellitesByTags(c.Request().Context(), tags)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch satellites by tags: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to fetch satellites")
+	}
+	return c.JSON(http.StatusOK, satellites)
+}
+
+// GetSatelliteTags lists the tags of a satellite.
+func (h *SatelliteTagHandler) GetSatelliteTags(c echo.Context) error {
+	noradID := c.QueryParam("noradID")
+	if noradID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "noradID is required")
+	}
+
+	tags, err := h.Service.GetSatelliteTags(c.Request().Context(), noradID)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch satellite tags: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to fetch satellite tags")
+	}
+	return c.JSON(http.StatusOK, tags)
+}
+
+// TagSatellites adds a tag to satellites, creating the tag when missing, and reports the unknown NORAD IDs.
+func (h *SatelliteTagHandler) TagSatellites(c echo.Context) error {
+	var request TagSatellitesRequest
+	if err := c.Bind(&request); err != nil {
+		c.Echo().Logger.Error("Failed to bind satellites to tag: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request payload")
+	}
+	if len(request.NoradIDs) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "noradIds is required")
+	}
+
+	tag, unknown, err := h.Service.TagSatellites(c.Request().Context(), c.Param("tag"), request.NoradIDs)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to tag satellites: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	response := map[string]interface{}{
+		"tag":             tag,
+		"unknownNoradIds": unknown,
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// UntagSatellites removes a tag from the satellites of the comma separated noradIDs.
+func (h *SatelliteTagHandler) UntagSatellites(c echo.Context) error {
+	noradIDs := handlers.SplitQueryList(c.QueryParam("noradIDs"))
+	if len(noradIDs) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "noradIDs is required")
+	}
+
+	if err := h.Service.UntagSatellites(c.Request().Context(), c.Param("tag"), noradIDs); err != nil {
+		c.Echo().Logger.Error("Failed to untag satellites: ", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Tag not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to untag satellites")
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// AddTaggedSatellitesToContext adds all the satellites having a tag to a context.
+func (h *SatelliteTagHandler) AddTaggedSatellitesToContext(c echo.Context) error {
+	contextName, tenantID := handlers.ContextScope(c)
+
+	added, err := h.Service.AddTaggedSatellitesToContext(c.Request().Context(), contextName, tenantID, c.Param("tag"))
+	if err != nil {
+		c.Echo().Logger.Error("Failed to add tagged satellites to context: ", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Unable to add tagged satellites to context")
+	}
+
+	response := map[string]interface{}{
+		"context":    contextName,
+		"tag":        domain.NormalizeTagName(c.Param("tag")),
+		"added":      len(added),
+		"satellites": added,
+	}
+	return c.JSON(http.StatusOK, response)
+}
@@ -1,10 +1,12 @@
 package tiles
 
 import (
+	"math"
 	"net/http"
 	"strconv"
 	"time"
 
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers"
 	"github.com/Elbujito/2112/src/app-service/internal/domain"
 	"github.com/Elbujito/2112/src/app-service/internal/services"
 	xconstants "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xconstants"
@@ -12,12 +14,13 @@ import (
 )
 
 type TileHandler struct {
-	Service services.TileService
+	Service         services.TileService
+	CoverageService services.TileCoverageService
 }
 
-// NewTileHandler creates a new handler with the provided TileService.
-func NewTileHandler(service services.TileService) *TileHandler {
-	return &TileHandler{Service: service}
+// NewTileHandler creates a new handler with the provided TileService and TileCoverageService.
+func NewTileHandler(service services.TileService, coverageService services.TileCoverageService) *TileHandler {
+	return &TileHandler{Service: service, CoverageService: coverageService}
 }
 
 // GetAllTiles fetches all available tiles.
@@ -179,3 +182,66 @@ func (h *TileHandler) RecomputeMappingsByNoradID(c echo.Context) error {
 		"endTime":   endTime.Format(time.RFC3339),
 	})
 }
+
+// GetTileCoverage returns the coverage statistics of the tiles of a context as heatmap points.
+// The weight of each point is the requested metric normalized between 0 and 1.
+func (h *TileHandler) GetTileCoverage(c echo.Context) error {
+	contextName := c.QueryParam("contextName")
+	if contextName == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "contextName is required")
+	}
+
+	metric := domain.TileCoverageMetricPasses
+	if value := c.QueryParam("metric"); value != "" {
+		metric = domain.TileCoverageMetric(value)
+		if err := metric.IsValid(); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+	}
+
+	stats, err := h.CoverageService.GetCoverage(c.Request().Context(), domain.GameContextName(contextName), domain.TenantID(c.Request().Header.Get(handlers.TenantHeader)))
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch tile coverage: ", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Unable to fetch tile coverage")
+	}
+
+	maxValue := 0.0
+	for _, stat := range stats {
+		maxValue = math.Max(maxValue, stat.MetricValue(metric))
+	}
+
+	points := make([]map[string]interface{}, 0, len(stats))
+	for _, stat := range stats {
+		value := stat.MetricValue(metric)
+		weight := 0.0
+		if maxValue > 0 {
+			weight = value / maxValue
+		}
+		points = append(points, map[string]interface{}{
+			"quadkey":            stat.Quadkey,
+			"zoomLevel":          stat.ZoomLevel,
+			"latitude":           stat.CenterLat,
+			"longitude":          stat.CenterLon,
+			"value":              value,
+			"weight":             weight,
+			"passes":             stat.Passes,
+			"distinctSatellites": stat.DistinctSatellites,
+			"meanRevisitSeconds": stat.MeanRevisitSeconds,
+			"maxGapSeconds":      stat.MaxGapSeconds,
+			"dwellSeconds":       stat.DwellSeconds,
+		})
+	}
+
+	response := map[string]interface{}{
+		"contextName": contextName,
+		"metric":      metric,
+		"maxValue":    maxValue,
+		"points":      points,
+	}
+	if len(stats) > 0 {
+		response["windowStart"] = stats[0].WindowStart
+		response["windowEnd"] = stats[0].WindowEnd
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,118 @@
+package transmitters
+
+import (
+	"net/http"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"github.com/Elbujito/2112/src/app-service/internal/services"
+	"github.com/labstack/echo/v4"
+)
+
+type TransmitterHandler struct {
+	Service services.TransmitterService
+}
+
+// NewTransmitterHandler creates a new handler with the provided TransmitterService.
+func NewTransmitterHandler(service services.TransmitterService) *TransmitterHandler {
+	return &TransmitterHandler{Service: service}
+}
+
+// TransmitterRequest is the payload used to create or update a transmitter. Frequencies are in Hz,
+// the high bounds are omitted for single frequencies.
+type TransmitterRequest struct {
+	NoradID      string                   `json:"noradId"`
+	Description  string                   `json:"description"`
+	Type         string                   `json:"type"`
+	UplinkLow    *int64                   `json:"uplinkLow"`
+	UplinkHigh   *int64                   `json:"uplinkHigh"`
+	DownlinkLow  *int64                   `json:"downlinkLow"`
+	DownlinkHigh *int64                   `json:"downlinkHigh"`
+	Mode         string                   `json:"mode"`
+	UplinkMode   string                   `json:"uplinkMode"`
+	Baud         *float64                 `json:"baud"`
+	Invert       bool                     `json:"invert"`
+	Status       domain.TransmitterStatus `json:"status"` // Defaults to active on creation, left unchanged on update when omitted
+	Service      string                   `json:"service"`
+}
+
+func (r TransmitterRequest) toDomain() domain.Transmitter {
+	return domain.Transmitter{
+		NoradID:     r.NoradID,
+		Description: r.Description,
+		Type:        r.Type,
+		Uplink:      domain.NewFrequencyRange(r.UplinkLow, r.UplinkHigh),
+		Downlink:    domain.NewFrequencyRange(r.DownlinkLow, r.DownlinkHigh),
+		Mode:        r.Mode,
+		UplinkMode:  r.UplinkMode,
+		Baud:        r.Baud,
+		Invert:      r.Invert,
+		Status:      r.Status,
+		Service:     r.Service,
+	}
+}
+
+// GetTransmitters lists the transmitters of a satellite.
+func (h *TransmitterHandler) GetTransmitters(c echo.Context) error {
+	noradID := c.QueryParam("noradID")
+	if noradID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "noradID is required")
+	}
+
+	transmitters, err := h.Service.List(c.Request().Context(), noradID)
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch transmitters: ", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unable to fetch transmitters")
+	}
+	return c.JSON(http.StatusOK, transmitters)
+}
+
+// GetTransmitter retrieves a transmitter by ID.
+func (h *TransmitterHandler) GetTransmitter(c echo.Context) error {
+	transmitter, err := h.Service.Get(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		c.Echo().Logger.Error("Failed to fetch transmitter: ", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Transmitter not found")
+	}
+	return c.JSON(http.StatusOK, transmitter)
+}
+
+// CreateTransmitter adds a transmitter to a satellite.
+func (h *TransmitterHandler) CreateTransmitter(c echo.Context) error {
+	var request TransmitterRequest
+	if err := c.Bind(&request); err != nil {
+		c.Echo().Logger.Error("Failed to bind transmitter: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request payload")
+	}
+
+	transmitter, err := h.Service.Create(c.Request().Context(), request.toDomain())
+	if err != nil {
+		c.Echo().Logger.Error("Failed to create transmitter: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return c.JSON(http.StatusCreated, transmitter)
+}
+
+// UpdateTransmitter replaces the frequencies, modes and status of a transmitter.
+func (h *TransmitterHandler) UpdateTransmitter(c echo.Context) error {
+	var request TransmitterRequest
+	if err := c.Bind(&request); err != nil {
+		c.Echo().Logger.Error("Failed to bind transmitter: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request payload")
+	}
+
+	transmitter, err := h.Service.Update(c.Request().Context(), c.Param("id"), request.toDomain())
+	if err != nil {
+		c.Echo().Logger.Error("Failed to update transmitter: ", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return c.JSON(http.StatusOK, transmitter)
+}
+
+// DeleteTransmitter removes a transmitter.
+func (h *TransmitterHandler) DeleteTransmitter(c echo.Context) error {
+	if err := h.Service.Delete(c.Request().Context(), c.Param("id")); err != nil {
+		c.Echo().Logger.Error("Failed to delete transmitter: ", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Unable to delete transmitter")
+	}
+	return c.NoContent(http.StatusNoContent)
+}
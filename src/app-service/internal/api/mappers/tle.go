@@ -5,6 +5,7 @@ import "time"
 // RawTLE definition
 type RawTLE struct {
 	NoradID string `json:"norad_id"`
+	Name    string `json:"name,omitempty"` // Line 0 of 3LE element sets
 	Line1   string `json:"line1"`
 	Line2   string `json:"line2"`
 }
@@ -18,6 +19,10 @@ type SatelliteMetadata struct {
 	DecayDate      *time.Time
 	ObjectType     string
 	Owner          string
+	OpsStatus      string // Operational status code, for example "+" for operational
+	LaunchSite     string
+	OrbitCenter    string // Body orbited, for example "EA" for the Earth
+	OrbitType      string // For example "ORB", "LAN" or "IMP"
 	Period         *float64
 	Inclination    *float64
 	Apogee         *float64
@@ -0,0 +1,21 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers"
+	"github.com/labstack/echo/v4"
+)
+
+// TenantHeaderMiddleware rejects the requests without a tenant. It guards the routes scoped to the contexts
+// of a tenant, so that a caller omitting the header cannot reach the contexts of every tenant.
+func TenantHeaderMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if c.Request().Header.Get(handlers.TenantHeader) == "" {
+				return echo.NewHTTPError(http.StatusBadRequest, "missing "+handlers.TenantHeader+" header")
+			}
+			return next(c)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"os/signal"
 	"time"
 
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers/dataquality"
 	"github.com/Elbujito/2112/src/app-service/internal/api/handlers/errors"
 	healthHandlers "github.com/Elbujito/2112/src/app-service/internal/api/handlers/healthz"
 	metricsHandlers "github.com/Elbujito/2112/src/app-service/internal/api/handlers/metrics"
@@ -48,6 +49,9 @@ func InitProtectedAPIRouter(env *config.SEnv, services *services.ServiceComponen
 	logger.Debug("Registering metrics api protected routes ...")
 	protectedApiRouter.registerMetricsAPIRoutes()
 
+	logger.Debug("Registering data quality api protected routes ...")
+	protectedApiRouter.registerDataQualityAPIRoutes()
+
 	// finally register default fallback error handlers
 	// 404 is handled here as the last route
 	logger.Debug("Registering protected api error handlers ...")
@@ -99,6 +103,13 @@ func (r *ProtectedRouter) registerMetricsAPIRoutes() {
 	metrics.GET("", metricsHandlers.GetMetrics)
 }
 
+func (r *ProtectedRouter) registerDataQualityAPIRoutes() {
+	dataQualityHandler := dataquality.NewDataQualityHandler(r.ServiceComponent.DataQualityService)
+	dataQuality := r.Echo.Group("/data-quality")
+	dataQuality.GET("", dataQualityHandler.GetDataQuality)
+	dataQuality.POST("", dataQualityHandler.PostDataQuality)
+}
+
 // Start the Echo server
 func (r *ProtectedRouter) Start(host string, port string) {
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
@@ -9,11 +9,25 @@ import (
 	"time"
 
 	apiaudittrail "github.com/Elbujito/2112/src/app-service/internal/api/handlers/audits"
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers/constellations"
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers/contacts"
 	apicontext "github.com/Elbujito/2112/src/app-service/internal/api/handlers/context"
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers/decay"
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers/elementsets"
 	"github.com/Elbujito/2112/src/app-service/internal/api/handlers/errors"
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers/geo"
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers/geofences"
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers/groundstations"
 	healthHandlers "github.com/Elbujito/2112/src/app-service/internal/api/handlers/healthz"
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers/launches"
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers/links"
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers/overflights"
 	"github.com/Elbujito/2112/src/app-service/internal/api/handlers/satellites"
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers/sensors"
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers/sky"
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers/tags"
 	"github.com/Elbujito/2112/src/app-service/internal/api/handlers/tiles"
+	"github.com/Elbujito/2112/src/app-service/internal/api/handlers/transmitters"
 	apiuser "github.com/Elbujito/2112/src/app-service/internal/api/handlers/users"
 	"github.com/Elbujito/2112/src/app-service/internal/api/middlewares"
 	"github.com/Elbujito/2112/src/app-service/internal/config"
@@ -104,17 +118,67 @@ func (r *PublicRouter) registerPublicAPIRoutes() {
 	r.RouteTableMapping = middlewares.GenerateRouteTableMapping(r.Echo)
 
 	// Handlers
-	satelliteHandler := satellites.NewSatelliteHandler(r.ServiceComponent.SatelliteService)
+	satelliteHandler := satellites.NewSatelliteHandler(r.ServiceComponent.SatelliteService, r.ServiceComponent.OrbitLightingService)
 	contextHandler := apicontext.NewContextHandler(r.ServiceComponent.ContextService)
-	tileHandler := tiles.NewTileHandler(r.ServiceComponent.TileService)
+	tileHandler := tiles.NewTileHandler(r.ServiceComponent.TileService, r.ServiceComponent.TileCoverageService)
 	auditTrailHandler := apiaudittrail.NewAuditTrailHandler(r.ServiceComponent.AuditTrailService)
 	userHandler := apiuser.NewUserHandler()
+	geoHandler := geo.NewGeoHandler(r.ServiceComponent.GeoService)
+	decayHandler := decay.NewDecayHandler(r.ServiceComponent.DecayService)
+	groundStationHandler := groundstations.NewGroundStationHandler(r.ServiceComponent.GroundStationService)
+	contactPlanHandler := contacts.NewContactPlanHandler(r.ServiceComponent.ContactSchedulerService)
+	linkHandler := links.NewLinkHandler(r.ServiceComponent.LinkService)
+	sensorHandler := sensors.NewSensorHandler(r.ServiceComponent.SensorService)
+	constellationHandler := constellations.NewConstellationHandler(r.ServiceComponent.ConstellationService)
+	geofenceHandler := geofences.NewGeofenceHandler(r.ServiceComponent.GeofenceService)
+	overflightHandler := overflights.NewOverflightHandler(r.ServiceComponent.OverflightService)
+	skyHandler := sky.NewSkyHandler(r.ServiceComponent.SkyService)
+	elementSetHandler := elementsets.NewElementSetHandler(r.ServiceComponent.ElementSetService)
+	tagHandler := tags.NewSatelliteTagHandler(r.ServiceComponent.SatelliteTagService)
+	transmitterHandler := transmitters.NewTransmitterHandler(r.ServiceComponent.TransmitterService)
+	launchHandler := launches.NewLaunchHandler(r.ServiceComponent.LaunchService)
+
+	// Routes scoped to the contexts of a tenant require the tenant header
+	requireTenant := middlewares.TenantHeaderMiddleware()
 
 	// Satellite routes
 	satellite := r.Echo.Group("/satellites")
 	satellite.GET("/orbit", satelliteHandler.GetSatellitePositionsByNoradID)
+	satellite.GET("/detail", satelliteHandler.GetSatelliteDetail)
+	satellite.GET("/names", satelliteHandler.GetSatelliteNameHistory)
+	satellite.GET("/element-sets", elementSetHandler.GetCandidates)
+	satellite.PUT("/element-sets/override", elementSetHandler.PutOverride)
+	satellite.DELETE("/element-sets/override", elementSetHandler.DeleteOverride)
+	satellite.GET("/tags", tagHandler.GetTags)
+	satellite.POST("/tags", tagHandler.CreateTag)
+	satellite.GET("/tags/bynoradID", tagHandler.GetSatelliteTags)
+	satellite.GET("/tags/satellites", tagHandler.GetSatellitesByTags)
+	satellite.DELETE("/tags/:tag", tagHandler.DeleteTag)
+	satellite.POST("/tags/:tag/satellites", tagHandler.TagSatellites)
+	satellite.DELETE("/tags/:tag/satellites", tagHandler.UntagSatellites)
+	satellite.GET("/transmitters", transmitterHandler.GetTransmitters)
+	satellite.POST("/transmitters", transmitterHandler.CreateTransmitter)
+	satellite.GET("/transmitters/:id", transmitterHandler.GetTransmitter)
+	satellite.PUT("/transmitters/:id", transmitterHandler.UpdateTransmitter)
+	satellite.DELETE("/transmitters/:id", transmitterHandler.DeleteTransmitter)
+	satellite.GET("/synthetic", satelliteHandler.GetSyntheticSatellites)
+	satellite.POST("/synthetic", satelliteHandler.CreateSyntheticSatellite)
+	satellite.POST("/synthetic/walker", satelliteHandler.CreateWalkerConstellation)
 	satellite.GET("/paginated", satelliteHandler.GetPaginatedSatellites)
 	satellite.GET("/paginated/tles", satelliteHandler.GetPaginatedSatelliteInfo)
+	satellite.GET("/reentries", decayHandler.GetUpcomingReentries)
+	satellite.GET("/launches", launchHandler.GetLaunches)
+	satellite.GET("/launches/:designator", launchHandler.GetLaunch)
+	satellite.GET("/fragmentations", launchHandler.GetFragmentations)
+	satellite.GET("/sensor", sensorHandler.GetSensor)
+	satellite.PUT("/sensor", sensorHandler.PutSensor)
+	satellite.DELETE("/sensor", sensorHandler.DeleteSensor)
+	satellite.GET("/imageable-tiles", sensorHandler.GetImageableTiles)
+
+	// GEO belt routes
+	geoBelt := r.Echo.Group("/geo")
+	geoBelt.GET("/near", geoHandler.GetObjectsNearLongitude)
+	geoBelt.GET("/observations", geoHandler.GetObservationsByNoradID)
 
 	// Tile routes
 	tile := r.Echo.Group("/tiles")
@@ -123,8 +187,13 @@ func (r *PublicRouter) registerPublicAPIRoutes() {
 	tile.GET("/mappings", tileHandler.GetPaginatedSatelliteMappings)
 	tile.PUT("/mappings/recompute/bynoradID", tileHandler.RecomputeMappingsByNoradID)
 	tile.GET("/mappings/bynoradID", tileHandler.GetSatelliteMappingsByNoradID)
+	tile.GET("/coverage", tileHandler.GetTileCoverage, requireTenant)
+
+	// Admin boundary routes
+	boundaries := r.Echo.Group("/boundaries")
+	boundaries.GET("/areas", overflightHandler.GetAdminAreas)
 
-	// Context routes
+	// Context routes, the ones below the context CRUD are scoped to the tenant of the context
 	context := r.Echo.Group("/contexts")
 	context.GET("/all", contextHandler.GetPaginatedContexts)
 	context.POST("/", contextHandler.CreateContext)
@@ -134,6 +203,53 @@ func (r *PublicRouter) registerPublicAPIRoutes() {
 	context.PUT("/:name/activate", contextHandler.ActivateContext)
 	context.PUT("/:name/deactivate", contextHandler.DeactivateContext)
 
+	// Ground station routes
+	groundStation := context.Group("/:name/ground-stations", requireTenant)
+	groundStation.GET("", groundStationHandler.GetGroundStations)
+	groundStation.POST("", groundStationHandler.CreateGroundStation)
+	groundStation.GET("/:id", groundStationHandler.GetGroundStation)
+	groundStation.PUT("/:id", groundStationHandler.UpdateGroundStation)
+	groundStation.DELETE("/:id", groundStationHandler.DeleteGroundStation)
+	groundStation.GET("/:id/passes", groundStationHandler.GetGroundStationPasses)
+
+	// Contact plan routes
+	contactPlan := context.Group("/:name/contact-plans", requireTenant)
+	contactPlan.POST("", contactPlanHandler.CreateContactPlan)
+	contactPlan.GET("/latest", contactPlanHandler.GetLatestContactPlan)
+
+	// Populate a context with tagged satellites
+	context.POST("/:name/tags/:tag", tagHandler.AddTaggedSatellitesToContext, requireTenant)
+
+	// Inter-satellite link routes
+	context.GET("/:name/links", linkHandler.GetLinkWindows, requireTenant)
+
+	// Sky view routes
+	context.GET("/:name/sky", skyHandler.GetSky, requireTenant)
+
+	// Constellation routes
+	constellation := context.Group("/:name/constellations", requireTenant)
+	constellation.GET("", constellationHandler.GetConstellations)
+	constellation.POST("", constellationHandler.CreateConstellation)
+	constellation.GET("/:id", constellationHandler.GetConstellation)
+	constellation.DELETE("/:id", constellationHandler.DeleteConstellation)
+	constellation.GET("/:id/coverage", constellationHandler.GetLatestCoverage)
+
+	// Geofence routes
+	geofence := context.Group("/:name/geofences", requireTenant)
+	geofence.GET("", geofenceHandler.GetGeofences)
+	geofence.POST("", geofenceHandler.CreateGeofence)
+	geofence.GET("/:id", geofenceHandler.GetGeofence)
+	geofence.PUT("/:id", geofenceHandler.UpdateGeofence)
+	geofence.DELETE("/:id", geofenceHandler.DeleteGeofence)
+	geofence.GET("/:id/crossings", geofenceHandler.GetUpcomingCrossings)
+
+	// Overflight report routes
+	overflight := context.Group("/:name/overflight-reports", requireTenant)
+	overflight.GET("", overflightHandler.GetOverflightReports)
+	overflight.GET("/:id", overflightHandler.GetOverflightReport)
+	overflight.DELETE("/:id", overflightHandler.DeleteOverflightReport)
+	overflight.GET("/:id/export", overflightHandler.ExportOverflightReport)
+
 	// Audit trail routes
 	audit := r.Echo.Group("/audit-trails")
 	audit.GET("/", auditTrailHandler.GetAuditTrails)
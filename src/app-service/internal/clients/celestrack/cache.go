@@ -0,0 +1,99 @@
+package celestrack
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// cacheEntry describes a cached group response.
+type cacheEntry struct {
+	Validators
+	FetchedAt time.Time `json:"fetchedAt"`
+}
+
+// diskCache keeps the last response of each group on disk along with its validators,
+// so that a 304 Not Modified answer can be served without downloading the group again.
+type diskCache struct {
+	dir string
+}
+
+func newDiskCache(dir string) *diskCache {
+	if dir == "" {
+		return nil
+	}
+	return &diskCache{dir: dir}
+}
+
+// Load returns the cached response of a group. A nil cache always misses.
+func (c *diskCache) Load(group string) (cacheEntry, []byte, bool) {
+	if c == nil {
+		return cacheEntry{}, nil, false
+	}
+	body, err := os.ReadFile(c.bodyPath(group))
+	if err != nil {
+		return cacheEntry{}, nil, false
+	}
+	meta, err := os.ReadFile(c.metaPath(group))
+	if err != nil {
+		return cacheEntry{}, nil, false
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(meta, &entry); err != nil {
+		return cacheEntry{}, nil, false
+	}
+	return entry, body, true
+}
+
+// Store replaces the cached response of a group. The body is written before its metadata so that
+// an interrupted write never pairs new validators with a stale body.
+func (c *diskCache) Store(group string, entry cacheEntry, body []byte) error {
+	if c == nil {
+		return nil
+	}
+	if err := os.MkdirAll(c.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create cache directory %s: %v", c.dir, err)
+	}
+	meta, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(c.bodyPath(group), body); err != nil {
+		return err
+	}
+	return writeFileAtomic(c.metaPath(group), meta)
+}
+
+func (c *diskCache) bodyPath(group string) string {
+	return filepath.Join(c.dir, cacheKey(group)+".tle")
+}
+
+func (c *diskCache) metaPath(group string) string {
+	return filepath.Join(c.dir, cacheKey(group)+".json")
+}
+
+// cacheKey maps a group name to a safe file name.
+func cacheKey(group string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, strings.ToLower(group))
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %v", tmp, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to replace %s: %v", path, err)
+	}
+	return nil
+}
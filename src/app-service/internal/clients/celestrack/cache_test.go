@@ -0,0 +1,62 @@
+package celestrack
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDiskCache(t *testing.T) {
+	cache := newDiskCache(filepath.Join(t.TempDir(), "celestrack"))
+
+	if _, _, hit := cache.Load("stations"); hit {
+		t.Fatal("Expected an empty cache to miss")
+	}
+
+	fetchedAt := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	entry := cacheEntry{Validators: Validators{ETag: `"v1"`, LastModified: "Fri, 01 Mar 2024 12:00:00 GMT"}, FetchedAt: fetchedAt}
+	if err := cache.Store("stations", entry, []byte(stationsBody)); err != nil {
+		t.Fatalf("Store returned an error: %v", err)
+	}
+	loaded, body, hit := cache.Load("stations")
+	if !hit || string(body) != stationsBody || loaded.Validators != entry.Validators || !loaded.FetchedAt.Equal(fetchedAt) {
+		t.Errorf("Unexpected cached entry %+v with body %q", loaded, body)
+	}
+
+	// A body without its metadata is not served
+	if err := os.Remove(cache.metaPath("stations")); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, hit := cache.Load("stations"); hit {
+		t.Error("Expected a body without metadata to miss")
+	}
+
+	disabled := newDiskCache("")
+	if err := disabled.Store("stations", entry, []byte(stationsBody)); err != nil {
+		t.Errorf("Store on a disabled cache returned an error: %v", err)
+	}
+	if _, _, hit := disabled.Load("stations"); hit {
+		t.Error("Expected a disabled cache to miss")
+	}
+}
+
+func TestCacheKey(t *testing.T) {
+	tests := []struct {
+		group    string
+		expected string
+	}{
+		{group: "stations", expected: "stations"},
+		{group: "GPS-OPS", expected: "gps-ops"},
+		{group: "../../etc/passwd", expected: "______etc_passwd"},
+		{group: "cubesat 2024", expected: "cubesat_2024"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.group, func(t *testing.T) {
+			if key := cacheKey(tt.group); key != tt.expected {
+				t.Errorf("Expected key %q, got %q", tt.expected, key)
+			}
+		})
+	}
+}
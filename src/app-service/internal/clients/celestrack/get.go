@@ -6,59 +6,151 @@ import (
 	"encoding/csv"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/Elbujito/2112/src/app-service/internal/api/mappers"
+	"github.com/Elbujito/2112/src/app-service/internal/clients/ratelimit"
 	"github.com/Elbujito/2112/src/app-service/internal/config"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xconstants"
 	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xutils"
 )
 
+const requestTimeout = 2 * time.Minute
+
+// Validators are the HTTP cache validators of a group response.
+type Validators struct {
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+}
+
+// GroupFetch is the result of a conditional group fetch.
+type GroupFetch struct {
+	TLEs        []*mappers.RawTLE
+	Validators  Validators // Validators to send on the next fetch
+	NotModified bool       // The group did not change since the validators sent, TLEs come from the disk cache
+	FetchedAt   time.Time
+}
+
 // CelestrackClient definition
 type CelestrackClient struct {
-	env *config.SEnv
+	env        *config.SEnv
+	httpClient *http.Client
+	limiter    *ratelimit.Limiter
+	cache      *diskCache
+	maxRetries int
 }
 
 // NewCelestrackClient constructor
 func NewCelestrackClient(env *config.SEnv) *CelestrackClient {
+	settings := env.EnvVars.Celestrack
+	perMinute := xconstants.DEFAULT_CELESTRACK_REQUESTS_PER_MINUTE
+	if settings.RequestsPerMinute != "" {
+		perMinute = xutils.IntFromStr(settings.RequestsPerMinute)
+	}
+	maxRetries := xconstants.DEFAULT_CELESTRACK_MAX_RETRIES
+	if settings.MaxRetries != "" {
+		maxRetries = xutils.IntFromStr(settings.MaxRetries)
+	}
 	return &CelestrackClient{
-		env: env,
+		env:        env,
+		httpClient: &http.Client{Timeout: requestTimeout},
+		limiter:    ratelimit.New(ratelimit.Limit{Count: perMinute, Window: time.Minute}),
+		cache:      newDiskCache(settings.CacheDir),
+		maxRetries: maxRetries,
 	}
 }
 
-// FetchTLEFromSatCatByCategory fetches TLE from satcat catalog
+// FetchTLEFromSatCatByCategory fetches TLE from satcat catalog. The validators of the disk cache are sent
+// so that an unchanged group is served from the cache.
 func (client *CelestrackClient) FetchTLEFromSatCatByCategory(ctx context.Context, category string) ([]*mappers.RawTLE, error) {
-	if category == "" {
-		return nil, fmt.Errorf("category is required")
+	entry, _, _ := client.cache.Load(category)
+	fetch, err := client.FetchGroup(ctx, category, entry.Validators)
+	if err != nil {
+		return nil, err
+	}
+	return fetch.TLEs, nil
+}
+
+// FetchGroup fetches the TLE of a group with If-None-Match and If-Modified-Since built from validators.
+// A 304 Not Modified answer is served from the disk cache, or triggers a full download when the cache misses.
+func (client *CelestrackClient) FetchGroup(ctx context.Context, group string, validators Validators) (GroupFetch, error) {
+	if group == "" {
+		return GroupFetch{}, fmt.Errorf("category is required")
 	}
 
 	baseUrl := client.env.EnvVars.Celestrack.BaseUrl
 	// Construct the URL for the category
-	url := fmt.Sprintf("%s?GROUP=%s", baseUrl, category)
+	groupUrl := fmt.Sprintf("%s?GROUP=%s", baseUrl, url.QueryEscape(group))
 
-	// Fetch the TLE data
-	resp, err := http.Get(url)
+	entry, cached, hit := client.cache.Load(group)
+	if !hit {
+		validators = Validators{} // Nothing to serve a 304 from
+	}
+
+	resp, err := client.do(ctx, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, groupUrl, nil)
+		if err != nil {
+			return nil, err
+		}
+		if validators.ETag != "" {
+			req.Header.Set("If-None-Match", validators.ETag)
+		}
+		if validators.LastModified != "" {
+			req.Header.Set("If-Modified-Since", validators.LastModified)
+		}
+		return req, nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch TLE data: %v", err)
+		return GroupFetch{}, fmt.Errorf("failed to fetch TLE data: %v", err)
 	}
 	defer resp.Body.Close()
 
+	nowUtc := time.Now().UTC()
+	if resp.StatusCode == http.StatusNotModified {
+		return GroupFetch{
+			TLEs:        parseTLEs(cached),
+			Validators:  validators,
+			NotModified: true,
+			FetchedAt:   entry.FetchedAt,
+		}, nil
+	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to fetch TLE data: HTTP status %d", resp.StatusCode)
+		return GroupFetch{}, fmt.Errorf("failed to fetch TLE data: HTTP status %d", resp.StatusCode)
 	}
 
 	// Parse the TLE data
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read TLE data: %v", err)
+		return GroupFetch{}, fmt.Errorf("failed to read TLE data: %v", err)
 	}
 
+	fetched := Validators{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	}
+	if err := client.cache.Store(group, cacheEntry{Validators: fetched, FetchedAt: nowUtc}, body); err != nil {
+		log.Printf("Failed to cache TLE data of group %s: %v", group, err)
+	}
+
+	return GroupFetch{
+		TLEs:       parseTLEs(body),
+		Validators: fetched,
+		FetchedAt:  nowUtc,
+	}, nil
+}
+
+// parseTLEs reads the three-line element sets of a group response, keeping line 0 as the object name.
+func parseTLEs(body []byte) []*mappers.RawTLE {
 	lines := bytes.Split(body, []byte("\n"))
 	var tles []*mappers.RawTLE
 	for i := 0; i < len(lines)-1; i += 3 {
-		if len(lines[i]) == 0 || len(lines[i+1]) == 0 || len(lines[i+2]) == 0 {
+		if i+2 >= len(lines) || len(lines[i]) == 0 || len(lines[i+1]) == 0 || len(lines[i+2]) == 0 {
 			continue
 		}
 
@@ -71,27 +163,26 @@ func (client *CelestrackClient) FetchTLEFromSatCatByCategory(ctx context.Context
 
 		line2 := strings.TrimSpace(string(lines[i+2]))
 
+		// Line 0 holds the object name, optionally prefixed with "0 "
+		name := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(string(lines[i])), "0 "))
+
 		tles = append(tles, &mappers.RawTLE{
 			NoradID: noradID,
+			Name:    name,
 			Line1:   line1,
 			Line2:   line2,
 		})
 	}
-
-	return tles, nil
+	return tles
 }
 
 // FetchSatelliteMetadata fetches metadata for satellites from CelesTrak's SATCAT.
 func (client *CelestrackClient) FetchSatelliteMetadata(ctx context.Context) ([]*mappers.SatelliteMetadata, error) {
-	// Create an HTTP request with the provided context
 	satcatUrl := client.env.EnvVars.Celestrack.Satcat
-	req, err := http.NewRequestWithContext(ctx, "GET", satcatUrl, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %v", err)
-	}
-
-	// Execute the HTTP request
-	resp, err := http.DefaultClient.Do(req)
+	// Execute the HTTP request with the provided context
+	resp, err := client.do(ctx, func() (*http.Request, error) {
+		return http.NewRequestWithContext(ctx, http.MethodGet, satcatUrl, nil)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch SATCAT data: %v", err)
 	}
@@ -111,65 +202,70 @@ func (client *CelestrackClient) FetchSatelliteMetadata(ctx context.Context) ([]*
 		return nil, fmt.Errorf("failed to read SATCAT data: %v", err)
 	}
 
+	if len(records) == 0 {
+		return nil, fmt.Errorf("empty SATCAT data")
+	}
+
 	// Extract header indices
 	header := records[0]
 	indices := make(map[string]int)
 	for i, field := range header {
 		indices[strings.TrimSpace(field)] = i
 	}
+	// column returns the trimmed value of a column, empty when the column or the value is missing
+	column := func(record []string, name string) string {
+		i, ok := indices[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+	// optionalFloat returns the value of a numeric column, nil when missing or invalid
+	optionalFloat := func(record []string, name string) *float64 {
+		value, err := parseFloat(column(record, name))
+		if err != nil {
+			return nil
+		}
+		return &value
+	}
 
 	// Parse records into SatelliteMetadata
 	var satellites []*mappers.SatelliteMetadata
 	for _, record := range records[1:] {
-		launchDate, err := time.Parse("2006-01-02", record[indices["LAUNCH_DATE"]])
+		launchDate, err := time.Parse("2006-01-02", column(record, "LAUNCH_DATE"))
 		if err != nil {
 			continue // Skip records with invalid launch dates
 		}
 
 		var decayDate *time.Time
-		if dateStr := record[indices["DECAY_DATE"]]; dateStr != "" {
+		if dateStr := column(record, "DECAY_DATE"); dateStr != "" {
 			d, err := time.Parse("2006-01-02", dateStr)
 			if err == nil {
 				decayDate = &d
 			}
 		}
 
-		var apogee, perigee float64
-		if apogeeStr := record[indices["APOGEE"]]; apogeeStr != "" {
-			apogee, _ = parseFloat(apogeeStr)
-		}
-		if perigeeStr := record[indices["PERIGEE"]]; perigeeStr != "" {
-			perigee, _ = parseFloat(perigeeStr)
-		}
-
-		altitude := xspace.ComputeAverageAltitude(apogee, perigee)
-
 		satellite := &mappers.SatelliteMetadata{
-			NoradID:        record[indices["NORAD_CAT_ID"]],
-			Name:           record[indices["OBJECT_NAME"]],
-			IntlDesignator: record[indices["OBJECT_ID"]],
+			NoradID:        column(record, "NORAD_CAT_ID"),
+			Name:           column(record, "OBJECT_NAME"),
+			IntlDesignator: column(record, "OBJECT_ID"),
 			LaunchDate:     launchDate,
 			DecayDate:      decayDate,
-			ObjectType:     record[indices["OBJECT_TYPE"]],
-			Owner:          record[indices["OWNER"]],
-			Altitude:       &altitude,
+			ObjectType:     column(record, "OBJECT_TYPE"),
+			Owner:          column(record, "OWNER"),
+			OpsStatus:      column(record, "OPS_STATUS_CODE"),
+			LaunchSite:     column(record, "LAUNCH_SITE"),
+			OrbitCenter:    column(record, "ORBIT_CENTER"),
+			OrbitType:      column(record, "ORBIT_TYPE"),
+			Period:         optionalFloat(record, "PERIOD"),
+			Inclination:    optionalFloat(record, "INCLINATION"),
+			Apogee:         optionalFloat(record, "APOGEE"),
+			Perigee:        optionalFloat(record, "PERIGEE"),
+			RCS:            optionalFloat(record, "RCS"),
 		}
-
-		// Optional fields
-		if periodStr := record[indices["PERIOD"]]; periodStr != "" {
-			if period, err := parseFloat(periodStr); err == nil {
-				satellite.Period = &period
-			}
-		}
-		if inclinationStr := record[indices["INCLINATION"]]; inclinationStr != "" {
-			if inclination, err := parseFloat(inclinationStr); err == nil {
-				satellite.Inclination = &inclination
-			}
-		}
-		if rcsStr := record[indices["RCS"]]; rcsStr != "" {
-			if rcs, err := parseFloat(rcsStr); err == nil {
-				satellite.RCS = &rcs
-			}
+		if satellite.Apogee != nil && satellite.Perigee != nil {
+			altitude := xspace.ComputeAverageAltitude(*satellite.Apogee, *satellite.Perigee)
+			satellite.Altitude = &altitude
 		}
 
 		satellites = append(satellites, satellite)
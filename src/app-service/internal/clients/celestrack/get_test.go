@@ -0,0 +1,95 @@
+package celestrack
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Elbujito/2112/src/app-service/internal/clients/ratelimit"
+	"github.com/Elbujito/2112/src/app-service/internal/config"
+	"github.com/Elbujito/2112/src/app-service/internal/config/features"
+)
+
+const stationsBody = "ISS (ZARYA)\n" +
+	"1 25544U 98067A   24061.50000000  .00016717  00000-0  30270-3 0  9993\n" +
+	"2 25544  51.6416 247.4627 0006703 130.5360 325.0288 15.49815508441330\n"
+
+const stationsETag = `"stations-v1"`
+
+// newTestClient returns a client of a stand-in CelesTrak that answers 304 when sent the current ETag.
+func newTestClient(t *testing.T, cacheDir string) (*CelestrackClient, *[]http.Header) {
+	var requests []http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Header.Clone())
+		if r.URL.Query().Get("GROUP") != "stations" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("If-None-Match") == stationsETag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", stationsETag)
+		w.Write([]byte(stationsBody))
+	}))
+	t.Cleanup(server.Close)
+
+	env := &config.SEnv{EnvVars: &config.EnvVars{Celestrack: features.CelestrackConfig{BaseUrl: server.URL}}}
+	return &CelestrackClient{
+		env:        env,
+		httpClient: server.Client(),
+		limiter:    ratelimit.New(),
+		cache:      newDiskCache(cacheDir),
+	}, &requests
+}
+
+func TestFetchGroupConditional(t *testing.T) {
+	client, requests := newTestClient(t, t.TempDir())
+
+	first, err := client.FetchGroup(context.Background(), "stations", Validators{})
+	if err != nil {
+		t.Fatalf("FetchGroup returned an error: %v", err)
+	}
+	if first.NotModified || first.Validators.ETag != stationsETag || len(first.TLEs) != 1 {
+		t.Fatalf("Unexpected first fetch %+v", first)
+	}
+	if tle := first.TLEs[0]; tle.NoradID != "25544" || tle.Name != "ISS (ZARYA)" {
+		t.Errorf("Unexpected element set %+v", tle)
+	}
+
+	second, err := client.FetchGroup(context.Background(), "stations", first.Validators)
+	if err != nil {
+		t.Fatalf("FetchGroup returned an error: %v", err)
+	}
+	if (*requests)[1].Get("If-None-Match") != stationsETag {
+		t.Errorf("Expected the ETag to be sent, got headers %v", (*requests)[1])
+	}
+	if !second.NotModified || second.Validators != first.Validators || !second.FetchedAt.Equal(first.FetchedAt) {
+		t.Errorf("Expected a 304 keeping the first validators, got %+v", second)
+	}
+	if len(second.TLEs) != 1 || second.TLEs[0].Line1 != first.TLEs[0].Line1 {
+		t.Errorf("Expected the element sets to be served from the cache, got %v", second.TLEs)
+	}
+
+	// Without a cached body a 304 could not be served, so no validator is sent
+	uncached, requests := newTestClient(t, "")
+	fetch, err := uncached.FetchGroup(context.Background(), "stations", first.Validators)
+	if err != nil {
+		t.Fatalf("FetchGroup returned an error: %v", err)
+	}
+	if (*requests)[0].Get("If-None-Match") != "" || fetch.NotModified || len(fetch.TLEs) != 1 {
+		t.Errorf("Expected an unconditional download, got %+v with headers %v", fetch, (*requests)[0])
+	}
+}
+
+func TestFetchGroupErrors(t *testing.T) {
+	client, _ := newTestClient(t, t.TempDir())
+
+	if _, err := client.FetchGroup(context.Background(), "", Validators{}); err == nil {
+		t.Error("Expected an error without a group")
+	}
+	if _, err := client.FetchGroup(context.Background(), "unknown", Validators{}); err == nil {
+		t.Error("Expected an error for an unknown group")
+	}
+}
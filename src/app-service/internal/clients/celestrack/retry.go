@@ -0,0 +1,78 @@
+package celestrack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	retryBaseDelay = time.Second
+	retryMaxDelay  = time.Minute
+)
+
+// do sends a request built by newRequest, retrying network errors, 429 Too Many Requests and 5xx answers
+// with an exponential backoff. A Retry-After header in seconds overrides the backoff delay, up to retryMaxDelay.
+// Every attempt waits on the rate limiter.
+func (client *CelestrackClient) do(ctx context.Context, newRequest func() (*http.Request, error)) (*http.Response, error) {
+	var lastErr error
+	for attempt := 0; attempt <= client.maxRetries; attempt++ {
+		if err := client.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		req, err := newRequest()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %v", err)
+		}
+
+		delay := backoffDelay(attempt)
+		resp, err := client.httpClient.Do(req)
+		switch {
+		case err != nil:
+			lastErr = err
+		case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError:
+			lastErr = fmt.Errorf("HTTP status %d", resp.StatusCode)
+			delay = retryAfterDelay(resp.Header.Get("Retry-After"), delay)
+			resp.Body.Close()
+		default:
+			return resp, nil
+		}
+
+		if attempt == client.maxRetries {
+			break
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+	return nil, fmt.Errorf("giving up after %d attempts: %v", client.maxRetries+1, lastErr)
+}
+
+// backoffDelay doubles the delay at each attempt, up to retryMaxDelay.
+func backoffDelay(attempt int) time.Duration {
+	delay := retryBaseDelay << attempt
+	if delay <= 0 || delay > retryMaxDelay {
+		return retryMaxDelay
+	}
+	return delay
+}
+
+// retryAfterDelay returns the delay of a Retry-After header in seconds capped at retryMaxDelay,
+// or fallback when the header is missing or not a number of seconds.
+func retryAfterDelay(header string, fallback time.Duration) time.Duration {
+	retryAfter, err := strconv.Atoi(header)
+	if err != nil || retryAfter < 0 {
+		return fallback
+	}
+	if retryAfter >= int(retryMaxDelay/time.Second) {
+		return retryMaxDelay
+	}
+	return time.Duration(retryAfter) * time.Second
+}
@@ -0,0 +1,102 @@
+package celestrack
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/clients/ratelimit"
+)
+
+func TestBackoffDelay(t *testing.T) {
+	tests := []struct {
+		attempt  int
+		expected time.Duration
+	}{
+		{attempt: 0, expected: time.Second},
+		{attempt: 1, expected: 2 * time.Second},
+		{attempt: 5, expected: 32 * time.Second},
+		{attempt: 6, expected: retryMaxDelay},
+		{attempt: 70, expected: retryMaxDelay}, // The shift overflows
+	}
+
+	for _, tt := range tests {
+		if delay := backoffDelay(tt.attempt); delay != tt.expected {
+			t.Errorf("Attempt %d: expected %v, got %v", tt.attempt, tt.expected, delay)
+		}
+	}
+}
+
+func TestRetryAfterDelay(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected time.Duration
+	}{
+		{name: "missing", header: "", expected: 4 * time.Second},
+		{name: "http date", header: "Fri, 01 Mar 2024 12:00:00 GMT", expected: 4 * time.Second},
+		{name: "negative", header: "-5", expected: 4 * time.Second},
+		{name: "zero", header: "0", expected: 0},
+		{name: "seconds", header: "30", expected: 30 * time.Second},
+		{name: "capped", header: "3600", expected: retryMaxDelay},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if delay := retryAfterDelay(tt.header, 4*time.Second); delay != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, delay)
+			}
+		})
+	}
+}
+
+func TestDoRetries(t *testing.T) {
+	tests := []struct {
+		name             string
+		statuses         []int
+		maxRetries       int
+		expectedAttempts int
+		expectError      bool
+	}{
+		{name: "success", statuses: []int{http.StatusOK}, maxRetries: 2, expectedAttempts: 1},
+		{name: "retried until success", statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK}, maxRetries: 2, expectedAttempts: 3},
+		{name: "retries exhausted", statuses: []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusOK}, maxRetries: 1, expectedAttempts: 2, expectError: true},
+		{name: "client error not retried", statuses: []int{http.StatusNotFound, http.StatusOK}, maxRetries: 2, expectedAttempts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				status := tt.statuses[attempts]
+				attempts++
+				w.Header().Set("Retry-After", "0") // Keeps the test fast
+				w.WriteHeader(status)
+			}))
+			defer server.Close()
+
+			client := &CelestrackClient{httpClient: server.Client(), limiter: ratelimit.New(), maxRetries: tt.maxRetries}
+			resp, err := client.do(context.Background(), func() (*http.Request, error) {
+				return http.NewRequest(http.MethodGet, server.URL, nil)
+			})
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("Expected an error, got HTTP status %d", resp.StatusCode)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("do returned an error: %v", err)
+				}
+				resp.Body.Close()
+				if resp.StatusCode != tt.statuses[tt.expectedAttempts-1] {
+					t.Errorf("Expected HTTP status %d, got %d", tt.statuses[tt.expectedAttempts-1], resp.StatusCode)
+				}
+			}
+			if attempts != tt.expectedAttempts {
+				t.Errorf("Expected %d attempts, got %d", tt.expectedAttempts, attempts)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package elementsets
+
+import (
+	"context"
+
+	"github.com/Elbujito/2112/src/app-service/internal/api/mappers"
+	"github.com/Elbujito/2112/src/app-service/internal/clients/celestrack"
+)
+
+type celestrackClient interface {
+	FetchTLEFromSatCatByCategory(ctx context.Context, category string) ([]*mappers.RawTLE, error)
+	FetchGroup(ctx context.Context, group string, validators celestrack.Validators) (celestrack.GroupFetch, error)
+}
+
+// CelestrakSource fetches the element sets of a CelesTrak group.
+type CelestrakSource struct {
+	client celestrackClient
+}
+
+// NewCelestrakSource constructor
+func NewCelestrakSource(client celestrackClient) *CelestrakSource {
+	return &CelestrakSource{client: client}
+}
+
+// Name identifies the source.
+func (s *CelestrakSource) Name() string {
+	return "celestrak"
+}
+
+// Fetch returns the element sets of a CelesTrak group.
+func (s *CelestrakSource) Fetch(ctx context.Context, group string) ([]*mappers.RawTLE, error) {
+	return s.client.FetchTLEFromSatCatByCategory(ctx, group)
+}
+
+// FetchGroup returns the element sets of a CelesTrak group, or reports it unchanged since the validators.
+func (s *CelestrakSource) FetchGroup(ctx context.Context, group string, validators celestrack.Validators) (celestrack.GroupFetch, error) {
+	return s.client.FetchGroup(ctx, group, validators)
+}
@@ -0,0 +1,93 @@
+package elementsets
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Elbujito/2112/src/app-service/internal/api/mappers"
+)
+
+// processedDirName is the sub-directory where ingested files are moved.
+const processedDirName = "processed"
+
+// elementSetExtensions lists the file extensions picked up from a drop directory.
+var elementSetExtensions = map[string]bool{".tle": true, ".txt": true, ".2le": true, ".3le": true}
+
+// DirectorySource ingests the element set files dropped in a directory. Each file is read until it is
+// committed: once its element sets are stored it is moved to the processed sub-directory, so polling the
+// source only returns the files dropped since the previous ingestion.
+type DirectorySource struct {
+	dir string
+}
+
+// NewDirectorySource constructor
+func NewDirectorySource(dir string) *DirectorySource {
+	return &DirectorySource{dir: dir}
+}
+
+// Name identifies the source.
+func (s *DirectorySource) Name() string {
+	return "dir:" + s.dir
+}
+
+// Fetch reads the files dropped in the directory, or in its group sub-directory when the group is set,
+// without consuming them.
+func (s *DirectorySource) Fetch(ctx context.Context, group string) ([]*mappers.RawTLE, error) {
+	tles, _, err := s.FetchFiles(ctx, group)
+	return tles, err
+}
+
+// FetchFiles reads the files dropped in the directory, or in its group sub-directory when the group is set,
+// and returns their paths for Commit. Files are read in name order, so a later file overrides an element set
+// of an earlier one.
+func (s *DirectorySource) FetchFiles(ctx context.Context, group string) ([]*mappers.RawTLE, []string, error) {
+	dir := s.dir
+	if group != "" {
+		dir = filepath.Join(s.dir, group)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list drop directory %s: %w", dir, err)
+	}
+	var files []string
+	for _, entry := range entries {
+		if entry.IsDir() || !elementSetExtensions[strings.ToLower(filepath.Ext(entry.Name()))] {
+			continue
+		}
+		files = append(files, filepath.Join(dir, entry.Name()))
+	}
+	sort.Strings(files)
+
+	var tles []*mappers.RawTLE
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read dropped file %s: %w", file, err)
+		}
+		parsed, err := ParseElementSets(data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid dropped file %s: %w", file, err)
+		}
+		tles = append(tles, parsed...)
+	}
+	return tles, files, nil
+}
+
+// Commit moves the given dropped files to the processed sub-directory of their directory.
+func (s *DirectorySource) Commit(ctx context.Context, files []string) error {
+	for _, file := range files {
+		processedDir := filepath.Join(filepath.Dir(file), processedDirName)
+		if err := os.MkdirAll(processedDir, 0o755); err != nil {
+			return fmt.Errorf("failed to create %s: %w", processedDir, err)
+		}
+		if err := os.Rename(file, filepath.Join(processedDir, filepath.Base(file))); err != nil {
+			return fmt.Errorf("failed to move processed file %s: %w", file, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package elementsets
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/Elbujito/2112/src/app-service/internal/api/mappers"
+)
+
+// FileSource reads the element sets of a local 2LE or 3LE file.
+type FileSource struct {
+	path string
+}
+
+// NewFileSource constructor
+func NewFileSource(path string) *FileSource {
+	return &FileSource{path: path}
+}
+
+// Name identifies the source.
+func (s *FileSource) Name() string {
+	return "file:" + s.path
+}
+
+// Fetch reads the whole file, the group is ignored.
+func (s *FileSource) Fetch(ctx context.Context, group string) ([]*mappers.RawTLE, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read element set file %s: %w", s.path, err)
+	}
+	return ParseElementSets(data)
+}
@@ -0,0 +1,59 @@
+package elementsets
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Elbujito/2112/src/app-service/internal/api/mappers"
+)
+
+// groupPlaceholder is replaced by the requested group in the URL of an HTTPSource.
+const groupPlaceholder = "{group}"
+
+// HTTPSource downloads 2LE or 3LE element sets from a URL, for example a mirror inside an air-gapped network.
+type HTTPSource struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPSource constructor. The URL may contain a {group} placeholder.
+func NewHTTPSource(url string, client *http.Client) *HTTPSource {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &HTTPSource{url: url, client: client}
+}
+
+// Name identifies the source.
+func (s *HTTPSource) Name() string {
+	return "http:" + s.url
+}
+
+// Fetch downloads the element sets of a group.
+func (s *HTTPSource) Fetch(ctx context.Context, group string) ([]*mappers.RawTLE, error) {
+	target := strings.ReplaceAll(s.url, groupPlaceholder, url.QueryEscape(group))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch element sets: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch element sets: HTTP status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read element sets: %v", err)
+	}
+	return ParseElementSets(body)
+}
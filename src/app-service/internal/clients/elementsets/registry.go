@@ -0,0 +1,75 @@
+package elementsets
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Elbujito/2112/src/app-service/internal/clients/celestrack"
+	"github.com/Elbujito/2112/src/app-service/internal/config"
+)
+
+// DefaultContext is the entry of the source configuration applied to contexts without their own entry.
+const DefaultContext = "default"
+
+const (
+	SourceKindCelestrak = "celestrak"
+	SourceKindFile      = "file"
+	SourceKindDirectory = "dir"
+	SourceKindHTTP      = "http"
+)
+
+// Registry holds the element set source of each GameContext.
+type Registry struct {
+	sources map[string]ElementSetSource
+}
+
+// NewRegistry builds the sources configured in ELEMENT_SET_SOURCES.
+func NewRegistry(env *config.SEnv) (*Registry, error) {
+	celestrackClient := celestrack.NewCelestrackClient(env)
+
+	registry := &Registry{sources: make(map[string]ElementSetSource)}
+	for _, entry := range strings.Split(env.EnvVars.ElementSets.Sources, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		contextName, spec, ok := strings.Cut(entry, "=")
+		contextName = strings.TrimSpace(contextName)
+		if !ok || contextName == "" {
+			return nil, fmt.Errorf("invalid element set source entry %q, expected context=kind[:target]", entry)
+		}
+
+		kind, target, _ := strings.Cut(strings.TrimSpace(spec), ":")
+		var source ElementSetSource
+		switch kind {
+		case SourceKindCelestrak:
+			source = NewCelestrakSource(celestrackClient)
+		case SourceKindFile:
+			source = NewFileSource(target)
+		case SourceKindDirectory:
+			source = NewDirectorySource(target)
+		case SourceKindHTTP:
+			source = NewHTTPSource(target, nil)
+		default:
+			return nil, fmt.Errorf("unknown element set source kind %q for context %s", kind, contextName)
+		}
+		if kind != SourceKindCelestrak && target == "" {
+			return nil, fmt.Errorf("element set source %s of context %s requires a target", kind, contextName)
+		}
+		registry.sources[contextName] = source
+	}
+
+	if _, ok := registry.sources[DefaultContext]; !ok {
+		registry.sources[DefaultContext] = NewCelestrakSource(celestrackClient)
+	}
+	return registry, nil
+}
+
+// ForContext returns the source of a context, or the default source when the context has none.
+func (r *Registry) ForContext(contextName string) ElementSetSource {
+	if source, ok := r.sources[contextName]; ok {
+		return source
+	}
+	return r.sources[DefaultContext]
+}
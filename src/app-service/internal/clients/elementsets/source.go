@@ -0,0 +1,80 @@
+package elementsets
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Elbujito/2112/src/app-service/internal/api/mappers"
+	"github.com/Elbujito/2112/src/app-service/internal/clients/celestrack"
+)
+
+// ElementSetSource provides the element sets of a catalog provider.
+type ElementSetSource interface {
+	// Name identifies the source in logs, for example "file:/data/catalog.tle".
+	Name() string
+	// Fetch returns the element sets of a group. Sources without groups ignore it.
+	Fetch(ctx context.Context, group string) ([]*mappers.RawTLE, error)
+}
+
+// ConditionalSource is implemented by sources supporting HTTP conditional requests, so that an unchanged
+// group is neither downloaded nor processed again.
+type ConditionalSource interface {
+	ElementSetSource
+	FetchGroup(ctx context.Context, group string, validators celestrack.Validators) (celestrack.GroupFetch, error)
+}
+
+// ConsumingSource is implemented by sources whose input is consumed once ingested, such as a drop directory.
+// The files read by FetchFiles are only consumed by Commit, once their element sets are stored, so that a
+// failed ingestion is retried on the next fetch.
+type ConsumingSource interface {
+	ElementSetSource
+	FetchFiles(ctx context.Context, group string) ([]*mappers.RawTLE, []string, error)
+	Commit(ctx context.Context, files []string) error
+}
+
+// ParseElementSets reads the 2LE or 3LE element sets of a text catalog. A line preceding a line 1
+// that is not itself a TLE line is kept as the name of the element set. Blank lines are ignored and
+// line pairs whose catalog numbers differ are rejected.
+func ParseElementSets(data []byte) ([]*mappers.RawTLE, error) {
+	var tles []*mappers.RawTLE
+	var name, line1 string
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimRight(scanner.Text(), " \r\t")
+		switch {
+		case strings.TrimSpace(line) == "":
+			continue
+		case strings.HasPrefix(line, "1 ") && len(line) >= 7:
+			line1 = line
+		case strings.HasPrefix(line, "2 ") && len(line) >= 7:
+			if line1 == "" {
+				return nil, fmt.Errorf("line %d: line 2 without line 1", lineNumber)
+			}
+			noradID := strings.TrimSpace(line1[2:7])
+			if strings.TrimSpace(line[2:7]) != noradID {
+				return nil, fmt.Errorf("line %d: catalog number mismatch between line 1 and line 2", lineNumber)
+			}
+			tles = append(tles, &mappers.RawTLE{
+				NoradID: noradID,
+				Name:    name,
+				Line1:   line1,
+				Line2:   line,
+			})
+			name, line1 = "", ""
+		default:
+			// Line 0 of a 3LE, optionally prefixed with "0 " as in Space-Track exports
+			name = strings.TrimSpace(strings.TrimPrefix(line, "0 "))
+			line1 = ""
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read element sets: %w", err)
+	}
+	return tles, nil
+}
@@ -0,0 +1,125 @@
+package elementsets
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const (
+	issLine1 = "1 25544U 98067A   24061.50000000  .00016717  00000-0  30270-3 0  9993"
+	issLine2 = "2 25544  51.6416 247.4627 0006703 130.5360 325.0288 15.49815508441330"
+	hstLine1 = "1 20580U 90037B   24061.50000000  .00001264  00000-0  64328-4 0  9997"
+	hstLine2 = "2 20580  28.4699 288.8102 0002526 321.7771 171.5855 15.24467449 61123"
+)
+
+func TestParseElementSets(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          string
+		expectedIDs   []string
+		expectedNames []string
+		expectError   bool
+	}{
+		{
+			name:          "2LE",
+			data:          issLine1 + "\n" + issLine2 + "\n" + hstLine1 + "\n" + hstLine2 + "\n",
+			expectedIDs:   []string{"25544", "20580"},
+			expectedNames: []string{"", ""},
+		},
+		{
+			name:          "3LE",
+			data:          "ISS (ZARYA)\n" + issLine1 + "\n" + issLine2 + "\nHST\n" + hstLine1 + "\n" + hstLine2 + "\n",
+			expectedIDs:   []string{"25544", "20580"},
+			expectedNames: []string{"ISS (ZARYA)", "HST"},
+		},
+		{
+			name:          "3LE with line 0 prefix, CRLF and blank lines",
+			data:          "0 ISS (ZARYA)\r\n" + issLine1 + "\r\n" + issLine2 + "\r\n\r\n" + hstLine1 + "\r\n" + hstLine2,
+			expectedIDs:   []string{"25544", "20580"},
+			expectedNames: []string{"ISS (ZARYA)", ""},
+		},
+		{
+			name: "empty catalog",
+			data: "\n\n",
+		},
+		{
+			name:        "line 2 without line 1",
+			data:        "ISS (ZARYA)\n" + issLine2 + "\n",
+			expectError: true,
+		},
+		{
+			name:        "catalog number mismatch",
+			data:        issLine1 + "\n" + hstLine2 + "\n",
+			expectError: true,
+		},
+		{
+			name:        "name between line 1 and line 2",
+			data:        issLine1 + "\nISS (ZARYA)\n" + issLine2 + "\n",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tles, err := ParseElementSets([]byte(tt.data))
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("Expected an error, got %d element sets", len(tles))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseElementSets returned an error: %v", err)
+			}
+
+			if len(tles) != len(tt.expectedIDs) {
+				t.Fatalf("Expected %d element sets, got %d", len(tt.expectedIDs), len(tles))
+			}
+			for i, tle := range tles {
+				if tle.NoradID != tt.expectedIDs[i] || tle.Name != tt.expectedNames[i] {
+					t.Errorf("Element set %d is %s %q, expected %s %q", i, tle.NoradID, tle.Name, tt.expectedIDs[i], tt.expectedNames[i])
+				}
+				if len(tle.Line1) != 69 || len(tle.Line2) != 69 {
+					t.Errorf("Element set %d lines not trimmed: %q %q", i, tle.Line1, tle.Line2)
+				}
+			}
+		})
+	}
+}
+
+func TestDirectorySourceCommit(t *testing.T) {
+	dir := t.TempDir()
+	dropped := filepath.Join(dir, "iss.tle")
+	if err := os.WriteFile(dropped, []byte(issLine1+"\n"+issLine2+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "notes.md"), []byte("ignored"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	source := NewDirectorySource(dir)
+
+	tles, files, err := source.FetchFiles(context.Background(), "")
+	if err != nil {
+		t.Fatalf("FetchFiles returned an error: %v", err)
+	}
+	if len(tles) != 1 || len(files) != 1 || files[0] != dropped {
+		t.Fatalf("Expected the dropped file only, got %d element sets from %v", len(tles), files)
+	}
+
+	// Until committed, the file is fetched again
+	if again, err := source.Fetch(context.Background(), ""); err != nil || len(again) != 1 {
+		t.Fatalf("Expected the uncommitted file to be fetched again, got %d element sets, %v", len(again), err)
+	}
+
+	if err := source.Commit(context.Background(), files); err != nil {
+		t.Fatalf("Commit returned an error: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, processedDirName, "iss.tle")); err != nil {
+		t.Errorf("Committed file not moved to %s: %v", processedDirName, err)
+	}
+	if tles, files, err := source.FetchFiles(context.Background(), ""); err != nil || len(tles) != 0 || len(files) != 0 {
+		t.Errorf("Expected nothing left to fetch, got %d element sets from %v, %v", len(tles), files, err)
+	}
+}
@@ -0,0 +1,88 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limit allows at most Count requests within any Window. A limit with a zero count or window is ignored.
+type Limit struct {
+	Count  int
+	Window time.Duration
+}
+
+// Limiter delays requests so that none of its sliding-window limits is exceeded.
+type Limiter struct {
+	mu       sync.Mutex
+	limits   []Limit
+	requests []time.Time // Request times within the longest window, oldest first
+	now      func() time.Time
+}
+
+// New creates a limiter enforcing every limit.
+func New(limits ...Limit) *Limiter {
+	var active []Limit
+	for _, limit := range limits {
+		if limit.Count > 0 && limit.Window > 0 {
+			active = append(active, limit)
+		}
+	}
+	return &Limiter{limits: active, now: time.Now}
+}
+
+// Wait blocks until a request can be made without exceeding the limits, then records it.
+func (l *Limiter) Wait(ctx context.Context) error {
+	for {
+		delay := l.reserve()
+		if delay <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// reserve records a request and returns zero when it is allowed, or the delay before retrying otherwise.
+func (l *Limiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	var longest time.Duration
+	for _, limit := range l.limits {
+		if limit.Window > longest {
+			longest = limit.Window
+		}
+	}
+	for len(l.requests) > 0 && now.Sub(l.requests[0]) >= longest {
+		l.requests = l.requests[1:]
+	}
+
+	var delay time.Duration
+	for _, limit := range l.limits {
+		// Requests within the window of this limit are the most recent ones
+		inWindow := 0
+		for i := len(l.requests) - 1; i >= 0 && now.Sub(l.requests[i]) < limit.Window; i-- {
+			inWindow++
+		}
+		if inWindow >= limit.Count {
+			oldest := l.requests[len(l.requests)-limit.Count]
+			if wait := limit.Window - now.Sub(oldest); wait > delay {
+				delay = wait
+			}
+		}
+	}
+	if delay > 0 {
+		return delay
+	}
+
+	l.requests = append(l.requests, now)
+	return 0
+}
@@ -0,0 +1,86 @@
+package satnogs
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+// TransmitterRecord is an entry of a SatNOGS DB transmitters dump, as served by /api/transmitters/.
+// Frequencies are in Hz, single frequencies leave the high bound null.
+type TransmitterRecord struct {
+	UUID         string   `json:"uuid"`
+	Description  string   `json:"description"`
+	Alive        bool     `json:"alive"`
+	Type         string   `json:"type"`
+	UplinkLow    *int64   `json:"uplink_low"`
+	UplinkHigh   *int64   `json:"uplink_high"`
+	DownlinkLow  *int64   `json:"downlink_low"`
+	DownlinkHigh *int64   `json:"downlink_high"`
+	Mode         *string  `json:"mode"`
+	UplinkMode   *string  `json:"uplink_mode"`
+	Invert       bool     `json:"invert"`
+	Baud         *float64 `json:"baud"`
+	NoradCatID   *int64   `json:"norad_cat_id"`
+	Status       string   `json:"status"`
+	Service      *string  `json:"service"`
+}
+
+// LoadTransmitters reads a SatNOGS DB transmitters dump, a JSON array of transmitter records.
+func LoadTransmitters(path string) ([]TransmitterRecord, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read transmitters dump: %w", err)
+	}
+
+	var records []TransmitterRecord
+	if err := json.Unmarshal(content, &records); err != nil {
+		return nil, fmt.Errorf("failed to parse transmitters dump %s: %w", path, err)
+	}
+	return records, nil
+}
+
+// NoradID returns the NORAD ID of the transmitter satellite, empty when the satellite has none.
+func (r TransmitterRecord) NoradID() string {
+	if r.NoradCatID == nil {
+		return ""
+	}
+	return strconv.FormatInt(*r.NoradCatID, 10)
+}
+
+// ToTransmitter maps the record to a Transmitter. Dumps without status fall back on the alive flag.
+func (r TransmitterRecord) ToTransmitter() (domain.Transmitter, error) {
+	status := domain.TransmitterStatus(strings.ToLower(r.Status))
+	if status == "" {
+		status = domain.TransmitterInactive
+		if r.Alive {
+			status = domain.TransmitterActive
+		}
+	}
+
+	return domain.NewTransmitter(
+		r.NoradID(),
+		r.UUID,
+		r.Description,
+		r.Type,
+		domain.NewFrequencyRange(r.UplinkLow, r.UplinkHigh),
+		domain.NewFrequencyRange(r.DownlinkLow, r.DownlinkHigh),
+		stringValue(r.Mode),
+		stringValue(r.UplinkMode),
+		r.Baud,
+		r.Invert,
+		status,
+		stringValue(r.Service),
+	)
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,125 @@
+package satnogs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+const transmittersDump = `[{
+	"uuid": "UzPz6ADdRaWntRZcH7n7Kd",
+	"description": "Mode V/U FM",
+	"alive": true,
+	"type": "Transceiver",
+	"uplink_low": 145990000,
+	"uplink_high": null,
+	"downlink_low": 437800000,
+	"downlink_high": 437800000,
+	"mode": "FM",
+	"uplink_mode": "FM",
+	"invert": false,
+	"baud": null,
+	"norad_cat_id": 25544,
+	"status": "active",
+	"service": "Amateur"
+}, {
+	"uuid": "7Fg5q2n8sLhZ3f9Xb4mW2a",
+	"description": "Linear transponder",
+	"alive": false,
+	"type": "Transponder",
+	"uplink_low": 435150000,
+	"uplink_high": 435180000,
+	"downlink_low": 145930000,
+	"downlink_high": 145960000,
+	"mode": null,
+	"invert": true,
+	"baud": 1200,
+	"norad_cat_id": 43017,
+	"status": "",
+	"service": null
+}, {
+	"uuid": "Hr6tYkV5hYpN3Ua9QwCq4d",
+	"description": "Beacon",
+	"alive": true,
+	"type": "Transmitter",
+	"downlink_low": 0,
+	"norad_cat_id": null,
+	"status": "Invalid"
+}]`
+
+func TestLoadTransmitters(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "transmitters.json")
+	if err := os.WriteFile(path, []byte(transmittersDump), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := LoadTransmitters(path)
+	if err != nil {
+		t.Fatalf("LoadTransmitters returned an error: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("Expected 3 records, got %d", len(records))
+	}
+	if records[0].NoradID() != "25544" || records[2].NoradID() != "" {
+		t.Errorf("Unexpected NORAD IDs %q and %q", records[0].NoradID(), records[2].NoradID())
+	}
+
+	if err := os.WriteFile(path, []byte(`{"uuid": "not an array"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadTransmitters(path); err == nil {
+		t.Error("Expected an error for a dump that is not an array")
+	}
+	if _, err := LoadTransmitters(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("Expected an error for a missing dump")
+	}
+}
+
+func TestTransmitterRecordToTransmitter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "transmitters.json")
+	if err := os.WriteFile(path, []byte(transmittersDump), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	records, err := LoadTransmitters(path)
+	if err != nil {
+		t.Fatalf("LoadTransmitters returned an error: %v", err)
+	}
+
+	transceiver, err := records[0].ToTransmitter()
+	if err != nil {
+		t.Fatalf("ToTransmitter returned an error: %v", err)
+	}
+	if transceiver.NoradID != "25544" || transceiver.ExternalID != "UzPz6ADdRaWntRZcH7n7Kd" || transceiver.Status != domain.TransmitterActive || !transceiver.IsActive {
+		t.Errorf("Unexpected transmitter %+v", transceiver)
+	}
+	// A high bound equal to the low bound is a single frequency
+	if transceiver.Uplink == nil || *transceiver.Uplink != (domain.FrequencyRange{Low: 145990000}) ||
+		transceiver.Downlink == nil || *transceiver.Downlink != (domain.FrequencyRange{Low: 437800000}) {
+		t.Errorf("Unexpected frequencies %v and %v", transceiver.Uplink, transceiver.Downlink)
+	}
+	if transceiver.Mode != "FM" || transceiver.Service != "Amateur" || transceiver.Baud != nil {
+		t.Errorf("Unexpected modes %+v", transceiver)
+	}
+
+	// Without status, the alive flag decides
+	transponder, err := records[1].ToTransmitter()
+	if err != nil {
+		t.Fatalf("ToTransmitter returned an error: %v", err)
+	}
+	if transponder.Status != domain.TransmitterInactive || transponder.IsActive {
+		t.Errorf("Expected an inactive transponder, got %s", transponder.Status)
+	}
+	if transponder.Downlink == nil || *transponder.Downlink != (domain.FrequencyRange{Low: 145930000, High: 145960000}) || !transponder.Invert {
+		t.Errorf("Unexpected transponder %+v", transponder)
+	}
+	if transponder.Mode != "" || transponder.Service != "" || transponder.Baud == nil || *transponder.Baud != 1200 {
+		t.Errorf("Unexpected null fields %+v", transponder)
+	}
+
+	// No satellite and no usable frequency
+	if _, err := records[2].ToTransmitter(); err == nil {
+		t.Error("Expected an error for a transmitter without satellite or frequency")
+	}
+}
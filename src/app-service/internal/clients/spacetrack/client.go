@@ -0,0 +1,242 @@
+package spacetrack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/clients/ratelimit"
+	"github.com/Elbujito/2112/src/app-service/internal/config"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xconstants"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xutils"
+)
+
+const (
+	loginPath      = "/ajaxauth/login"
+	requestTimeout = 2 * time.Minute
+)
+
+// loginFailed is the Login value of a rejected login.
+const loginFailed = "Failed"
+
+// loginResponse is the JSON body of a rejected login, for example {"Login":"Failed"}.
+type loginResponse struct {
+	Login string `json:"Login"`
+	Error string `json:"error"`
+}
+
+// ErrUnauthorized is returned when Space-Track rejects the configured credentials.
+var ErrUnauthorized = errors.New("space-track login failed")
+
+// Config holds the account and the request limits of a SpaceTrackClient.
+type Config struct {
+	BaseUrl           string
+	Identity          string
+	Password          string
+	RequestsPerMinute int
+	RequestsPerHour   int
+}
+
+// SpaceTrackClient queries the Space-Track.org REST API. It logs in lazily, keeps the session cookie
+// and spaces its requests so that the published per-minute and per-hour limits are never exceeded.
+type SpaceTrackClient struct {
+	config     Config
+	httpClient *http.Client
+	limiter    *ratelimit.Limiter
+
+	mu       sync.Mutex
+	loggedIn bool
+}
+
+// NewSpaceTrackClient constructor
+func NewSpaceTrackClient(env *config.SEnv) *SpaceTrackClient {
+	settings := env.EnvVars.SpaceTrack
+	perMinute := xconstants.DEFAULT_SPACETRACK_REQUESTS_PER_MINUTE
+	if settings.RequestsPerMinute != "" {
+		perMinute = xutils.IntFromStr(settings.RequestsPerMinute)
+	}
+	perHour := xconstants.DEFAULT_SPACETRACK_REQUESTS_PER_HOUR
+	if settings.RequestsPerHour != "" {
+		perHour = xutils.IntFromStr(settings.RequestsPerHour)
+	}
+	return NewSpaceTrackClientWithConfig(Config{
+		BaseUrl:           settings.BaseUrl,
+		Identity:          settings.Identity,
+		Password:          settings.Password,
+		RequestsPerMinute: perMinute,
+		RequestsPerHour:   perHour,
+	})
+}
+
+// NewSpaceTrackClientWithConfig creates a client from an explicit configuration, for example to target a local stand-in.
+func NewSpaceTrackClientWithConfig(cfg Config) *SpaceTrackClient {
+	cfg.BaseUrl = strings.TrimRight(cfg.BaseUrl, "/")
+	if cfg.BaseUrl == "" {
+		cfg.BaseUrl = xconstants.DEFAULT_PUBLIC_SPACETRACK_URL
+	}
+	jar, _ := cookiejar.New(nil) // Never fails without options
+	return &SpaceTrackClient{
+		config:     cfg,
+		httpClient: &http.Client{Jar: jar, Timeout: requestTimeout},
+		limiter: ratelimit.New(
+			ratelimit.Limit{Count: cfg.RequestsPerMinute, Window: time.Minute},
+			ratelimit.Limit{Count: cfg.RequestsPerHour, Window: time.Hour},
+		),
+	}
+}
+
+// Login opens a session, the session cookie is kept by the client for the following queries.
+func (client *SpaceTrackClient) Login(ctx context.Context) error {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	return client.login(ctx)
+}
+
+func (client *SpaceTrackClient) login(ctx context.Context) error {
+	if client.config.Identity == "" || client.config.Password == "" {
+		return fmt.Errorf("%w: identity and password are required", ErrUnauthorized)
+	}
+	if err := client.limiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	form := url.Values{}
+	form.Set("identity", client.config.Identity)
+	form.Set("password", client.config.Password)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, client.config.BaseUrl+loginPath, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create login request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := client.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to log in to space-track: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read login response: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: HTTP status %d", ErrUnauthorized, resp.StatusCode)
+	}
+	// Space-Track answers 200 with a JSON failure message on bad credentials, and an empty body on success
+	var failure loginResponse
+	if json.Unmarshal(body, &failure) == nil {
+		if failure.Error != "" {
+			return fmt.Errorf("%w: %s", ErrUnauthorized, failure.Error)
+		}
+		if failure.Login == loginFailed {
+			return fmt.Errorf("%w: invalid identity or password", ErrUnauthorized)
+		}
+	}
+
+	client.loggedIn = true
+	return nil
+}
+
+// Query runs a query and decodes the JSON response into out. An expired session is renewed once.
+func (client *SpaceTrackClient) Query(ctx context.Context, query *Query, out interface{}) error {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+
+	if !client.loggedIn {
+		if err := client.login(ctx); err != nil {
+			return err
+		}
+	}
+
+	status, err := client.get(ctx, query.Path(), out)
+	if status == http.StatusUnauthorized {
+		client.loggedIn = false
+		if err := client.login(ctx); err != nil {
+			return err
+		}
+		_, err = client.get(ctx, query.Path(), out)
+	}
+	return err
+}
+
+// get performs a rate limited GET and returns the HTTP status along with any error.
+func (client *SpaceTrackClient) get(ctx context.Context, path string, out interface{}) (int, error) {
+	if err := client.limiter.Wait(ctx); err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, client.config.BaseUrl+path, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %v", err)
+	}
+
+	resp, err := client.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query space-track: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode, fmt.Errorf("failed to query space-track: HTTP status %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return resp.StatusCode, fmt.Errorf("failed to decode space-track response: %v", err)
+	}
+	return resp.StatusCode, nil
+}
+
+// FetchGP returns the latest element sets, restricted to noradIDs when not empty and to GP_ID values above afterGPID
+// when positive, in ascending GP_ID order.
+func (client *SpaceTrackClient) FetchGP(ctx context.Context, noradIDs []string, afterGPID int64, limit int) ([]GPRecord, error) {
+	query := NewQuery(ClassGP)
+	if len(noradIDs) > 0 {
+		query.In("NORAD_CAT_ID", noradIDs...)
+	}
+	if afterGPID > 0 {
+		query.GreaterThan("GP_ID", fmt.Sprintf("%d", afterGPID))
+	}
+	query.OrderBy("GP_ID", false).Limit(limit)
+
+	var records []GPRecord
+	if err := client.Query(ctx, query, &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// FetchGPHistory returns every element set of an object with an epoch in [start, end], oldest first.
+func (client *SpaceTrackClient) FetchGPHistory(ctx context.Context, noradID string, start time.Time, end time.Time) ([]GPRecord, error) {
+	query := NewQuery(ClassGPHistory).
+		Equals("NORAD_CAT_ID", noradID).
+		Between("EPOCH", start, end).
+		OrderBy("EPOCH", false)
+
+	var records []GPRecord
+	if err := client.Query(ctx, query, &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// FetchSatcat returns the catalog entries with a NORAD ID above afterNoradID, in ascending NORAD ID order.
+func (client *SpaceTrackClient) FetchSatcat(ctx context.Context, afterNoradID int64, limit int) ([]SatcatRecord, error) {
+	query := NewQuery(ClassSatcat)
+	if afterNoradID > 0 {
+		query.GreaterThan("NORAD_CAT_ID", fmt.Sprintf("%d", afterNoradID))
+	}
+	query.OrderBy("NORAD_CAT_ID", false).Limit(limit)
+
+	var records []SatcatRecord
+	if err := client.Query(ctx, query, &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
@@ -0,0 +1,293 @@
+package spacetrack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+)
+
+const (
+	sessionCookie = "chocolatechip"
+	issLine1      = "1 25544U 98067A   24061.50000000  .00016717  00000-0  30270-3 0  9993"
+	issLine2      = "2 25544  51.6416 247.4627 0006703 130.5360 325.0288 15.49815508441330"
+)
+
+// fakeSpaceTrack stands in for Space-Track: it hands out a session cookie on login and rejects queries without it.
+type fakeSpaceTrack struct {
+	mu       sync.Mutex
+	session  string
+	logins   int
+	queries  []string
+	response string
+}
+
+func (f *fakeSpaceTrack) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.URL.Path == loginPath {
+		f.logins++
+		if r.FormValue("identity") != "user" || r.FormValue("password") != "secret" {
+			w.Write([]byte(`{"Login":"Failed"}`))
+			return
+		}
+		f.session = time.Now().String()
+		http.SetCookie(w, &http.Cookie{Name: sessionCookie, Value: f.session, Path: "/"})
+		w.Write([]byte(`""`))
+		return
+	}
+
+	cookie, err := r.Cookie(sessionCookie)
+	if err != nil || f.session == "" || cookie.Value != f.session {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	f.queries = append(f.queries, r.URL.Path)
+	w.Write([]byte(f.response))
+}
+
+// expire drops the session, as Space-Track does after two hours.
+func (f *fakeSpaceTrack) expire() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.session = ""
+}
+
+func newTestClient(t *testing.T, handler http.Handler, perMinute int) *SpaceTrackClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return NewSpaceTrackClientWithConfig(Config{
+		BaseUrl:           server.URL + "/",
+		Identity:          "user",
+		Password:          "secret",
+		RequestsPerMinute: perMinute,
+	})
+}
+
+func TestLogin(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		password    string
+		expectError bool
+	}{
+		{name: "accepted", status: http.StatusOK, body: `""`, password: "secret"},
+		{name: "empty body", status: http.StatusOK, password: "secret"},
+		{name: "rejected credentials", status: http.StatusOK, body: `{"Login":"Failed"}`, password: "secret", expectError: true},
+		{name: "error field", status: http.StatusOK, body: `{"error":"account locked"}`, password: "secret", expectError: true},
+		{name: "error status", status: http.StatusInternalServerError, password: "secret", expectError: true},
+		{name: "missing password", status: http.StatusOK, expectError: true},
+		// A failure word elsewhere in an accepted response is not a rejection
+		{name: "unrelated failure text", status: http.StatusOK, body: `{"Login":"Success","notice":"Failed jobs are retried"}`, password: "secret"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := NewSpaceTrackClientWithConfig(Config{BaseUrl: server.URL, Identity: "user", Password: tt.password})
+			err := client.Login(context.Background())
+			if tt.expectError {
+				if !errors.Is(err, ErrUnauthorized) {
+					t.Fatalf("Expected ErrUnauthorized, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Login returned an error: %v", err)
+			}
+		})
+	}
+}
+
+func TestQueryKeepsSession(t *testing.T) {
+	fake := &fakeSpaceTrack{response: `[]`}
+	client := newTestClient(t, fake, 0)
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.FetchGP(context.Background(), nil, 0, 0); err != nil {
+			t.Fatalf("Query %d returned an error: %v", i, err)
+		}
+	}
+	if fake.logins != 1 || len(fake.queries) != 2 {
+		t.Fatalf("Expected 1 login for 2 queries, got %d logins and %d queries", fake.logins, len(fake.queries))
+	}
+
+	// An expired session is renewed once
+	fake.expire()
+	if _, err := client.FetchGP(context.Background(), nil, 0, 0); err != nil {
+		t.Fatalf("Query after expiry returned an error: %v", err)
+	}
+	if fake.logins != 2 || len(fake.queries) != 3 {
+		t.Errorf("Expected a second login, got %d logins and %d queries", fake.logins, len(fake.queries))
+	}
+}
+
+func TestQueryRejectedCredentials(t *testing.T) {
+	fake := &fakeSpaceTrack{response: `[]`}
+	client := newTestClient(t, fake, 0)
+	client.config.Password = "wrong"
+
+	if _, err := client.FetchGP(context.Background(), nil, 0, 0); !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("Expected ErrUnauthorized, got %v", err)
+	}
+	if len(fake.queries) != 0 {
+		t.Errorf("Expected no query without a session, got %v", fake.queries)
+	}
+}
+
+func TestQueryRateLimited(t *testing.T) {
+	fake := &fakeSpaceTrack{response: `[]`}
+	// The login and the first query use the whole per-minute budget
+	client := newTestClient(t, fake, 2)
+
+	if _, err := client.FetchGP(context.Background(), nil, 0, 0); err != nil {
+		t.Fatalf("First query returned an error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := client.FetchGP(ctx, nil, 0, 0); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the second query to wait for the limit, got %v", err)
+	}
+	if fake.logins != 1 || len(fake.queries) != 1 {
+		t.Errorf("Expected the limit to hold back the second query, got %d logins and %d queries", fake.logins, len(fake.queries))
+	}
+}
+
+func TestFetchGP(t *testing.T) {
+	fake := &fakeSpaceTrack{response: `[{
+		"GP_ID": "245000001",
+		"NORAD_CAT_ID": 25544,
+		"OBJECT_NAME": "ISS (ZARYA)",
+		"OBJECT_ID": "1998-067A",
+		"EPOCH": "2024-03-01T12:00:00.000000",
+		"DECAY_DATE": null,
+		"TLE_LINE0": "0 ISS (ZARYA)",
+		"TLE_LINE1": "` + issLine1 + `",
+		"TLE_LINE2": "` + issLine2 + `"
+	}, {
+		"GP_ID": "245000002",
+		"NORAD_CAT_ID": "99999",
+		"TLE_LINE1": null,
+		"TLE_LINE2": null
+	}]`}
+	client := newTestClient(t, fake, 0)
+
+	records, err := client.FetchGP(context.Background(), []string{"25544", "99999"}, 245000000, 10)
+	if err != nil {
+		t.Fatalf("FetchGP returned an error: %v", err)
+	}
+	expectedPath := "/basicspacedata/query/class/gp/NORAD_CAT_ID/25544,99999/GP_ID/>245000000/orderby/GP_ID asc/limit/10/format/json"
+	if len(fake.queries) != 1 || fake.queries[0] != expectedPath {
+		t.Errorf("Expected query %s, got %v", expectedPath, fake.queries)
+	}
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 records, got %d", len(records))
+	}
+
+	if records[0].NoradCatID != "25544" || records[0].GPID.Int() != 245000001 || records[0].DecayDate.Date() != nil {
+		t.Errorf("Unexpected record %+v", records[0])
+	}
+	tle, err := records[0].ToTLE()
+	if err != nil {
+		t.Fatalf("ToTLE returned an error: %v", err)
+	}
+	if tle.NoradID != "25544" || tle.Name != "ISS (ZARYA)" || tle.Line1 != issLine1 || tle.Source != ElementSetSource {
+		t.Errorf("Unexpected element set %+v", tle)
+	}
+	if _, err := records[1].ToTLE(); err == nil {
+		t.Error("Expected an error for a record without TLE lines")
+	}
+}
+
+func TestFetchSatcat(t *testing.T) {
+	fake := &fakeSpaceTrack{response: `[{
+		"INTLDES": "1998-067A",
+		"NORAD_CAT_ID": "25544",
+		"OBJECT_TYPE": "PAYLOAD",
+		"SATNAME": "ISS (ZARYA)",
+		"COUNTRY": "ISS",
+		"LAUNCH": "1998-11-20",
+		"SITE": "TTMTR",
+		"DECAY": null,
+		"PERIOD": "92.80",
+		"INCLINATION": 51.64,
+		"APOGEE": "421",
+		"PERIGEE": "415",
+		"RCSVALUE": "0"
+	}, {
+		"INTLDES": "1990-037B",
+		"NORAD_CAT_ID": "20580",
+		"SATNAME": "OLD DEBRIS",
+		"DECAY": "2001-05-03",
+		"APOGEE": "",
+		"PERIGEE": "415"
+	}, {
+		"INTLDES": "2099-001A",
+		"NORAD_CAT_ID": ""
+	}]`}
+	client := newTestClient(t, fake, 0)
+
+	records, err := client.FetchSatcat(context.Background(), 20000, 0)
+	if err != nil {
+		t.Fatalf("FetchSatcat returned an error: %v", err)
+	}
+	expectedPath := "/basicspacedata/query/class/satcat/NORAD_CAT_ID/>20000/orderby/NORAD_CAT_ID asc/format/json"
+	if len(fake.queries) != 1 || fake.queries[0] != expectedPath {
+		t.Errorf("Expected query %s, got %v", expectedPath, fake.queries)
+	}
+	if len(records) != 3 {
+		t.Fatalf("Expected 3 records, got %d", len(records))
+	}
+
+	iss, err := records[0].ToSatellite()
+	if err != nil {
+		t.Fatalf("ToSatellite returned an error: %v", err)
+	}
+	if iss.NoradID != "25544" || iss.LaunchSite != "TTMTR" || !iss.IsActive || iss.DecayDate != nil {
+		t.Errorf("Unexpected satellite %+v", iss)
+	}
+	if iss.LaunchDate == nil || !iss.LaunchDate.Equal(time.Date(1998, time.November, 20, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected launch date %v", iss.LaunchDate)
+	}
+	if iss.Inclination == nil || *iss.Inclination != 51.64 || iss.Altitude == nil || *iss.Altitude != xspace.ComputeAverageAltitude(421, 415) {
+		t.Errorf("Unexpected orbit of %+v", iss)
+	}
+
+	debris, err := records[1].ToSatellite()
+	if err != nil {
+		t.Fatalf("ToSatellite returned an error: %v", err)
+	}
+	if debris.IsActive || debris.DecayDate == nil || debris.Apogee != nil || debris.Altitude != nil {
+		t.Errorf("Unexpected decayed object %+v", debris)
+	}
+
+	if _, err := records[2].ToSatellite(); err == nil {
+		t.Error("Expected an error for an entry without a NORAD ID")
+	}
+}
+
+func TestFieldUnmarshal(t *testing.T) {
+	var record GPRecord
+	if err := record.GPID.UnmarshalJSON([]byte(`" 42 "`)); err != nil || record.GPID != "42" {
+		t.Errorf("Expected a trimmed string, got %q, %v", record.GPID, err)
+	}
+	if err := record.Period.UnmarshalJSON([]byte(`92.8`)); err != nil || *record.Period.Float() != 92.8 {
+		t.Errorf("Expected a number, got %q, %v", record.Period, err)
+	}
+	if err := record.DecayDate.UnmarshalJSON([]byte(`null`)); err != nil || record.DecayDate != "" {
+		t.Errorf("Expected null to be empty, got %q, %v", record.DecayDate, err)
+	}
+}
@@ -0,0 +1,104 @@
+package spacetrack
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Request classes of the basicspacedata controller.
+const (
+	ClassGP        = "gp"         // Latest general perturbations element set of each object
+	ClassGPHistory = "gp_history" // Every element set ever published
+	ClassSatcat    = "satcat"     // Satellite catalog
+)
+
+// queryDateFormat is the date format of Space-Track predicates.
+const queryDateFormat = "2006-01-02T15:04:05"
+
+// Query builds the path of a basicspacedata request, for example
+// /basicspacedata/query/class/gp/NORAD_CAT_ID/25544,25545/orderby/EPOCH desc/limit/10/format/json.
+type Query struct {
+	class      string
+	predicates []string
+	orderBy    []string
+	limit      int
+}
+
+// NewQuery starts a query on a request class.
+func NewQuery(class string) *Query {
+	return &Query{class: class}
+}
+
+// Equals restricts a field to a value.
+func (q *Query) Equals(field string, value string) *Query {
+	return q.predicate(field, value)
+}
+
+// In restricts a field to a list of values.
+func (q *Query) In(field string, values ...string) *Query {
+	return q.predicate(field, strings.Join(values, ","))
+}
+
+// GreaterThan restricts a field to values strictly greater than value.
+func (q *Query) GreaterThan(field string, value string) *Query {
+	return q.predicate(field, ">"+value)
+}
+
+// LessThan restricts a field to values strictly less than value.
+func (q *Query) LessThan(field string, value string) *Query {
+	return q.predicate(field, "<"+value)
+}
+
+// Between restricts a time field to the inclusive range [start, end].
+func (q *Query) Between(field string, start time.Time, end time.Time) *Query {
+	return q.predicate(field, FormatTime(start)+"--"+FormatTime(end))
+}
+
+// OrderBy sorts the results on a field.
+func (q *Query) OrderBy(field string, descending bool) *Query {
+	if descending {
+		field += " desc"
+	} else {
+		field += " asc"
+	}
+	q.orderBy = append(q.orderBy, field)
+	return q
+}
+
+// Limit caps the number of returned records, zero means no limit.
+func (q *Query) Limit(limit int) *Query {
+	q.limit = limit
+	return q
+}
+
+// Path returns the escaped request path, always requesting JSON.
+func (q *Query) Path() string {
+	var builder strings.Builder
+	builder.WriteString("/basicspacedata/query/class/")
+	builder.WriteString(url.PathEscape(q.class))
+	for _, predicate := range q.predicates {
+		builder.WriteString("/")
+		builder.WriteString(predicate)
+	}
+	if len(q.orderBy) > 0 {
+		builder.WriteString("/orderby/")
+		builder.WriteString(url.PathEscape(strings.Join(q.orderBy, ",")))
+	}
+	if q.limit > 0 {
+		builder.WriteString(fmt.Sprintf("/limit/%d", q.limit))
+	}
+	builder.WriteString("/format/json")
+	return builder.String()
+}
+
+func (q *Query) predicate(field string, value string) *Query {
+	q.predicates = append(q.predicates, url.PathEscape(field)+"/"+url.PathEscape(value))
+	return q
+}
+
+// FormatTime formats a time for a Space-Track predicate.
+func FormatTime(t time.Time) string {
+	return t.UTC().Format(queryDateFormat)
+}
@@ -0,0 +1,153 @@
+package spacetrack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+)
+
+// Field is a Space-Track value. The API returns most numbers as strings, some as numbers and missing values as null.
+type Field string
+
+// UnmarshalJSON accepts strings, numbers and null.
+func (f *Field) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*f = ""
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*f = Field(strings.TrimSpace(s))
+		return nil
+	}
+	*f = Field(data)
+	return nil
+}
+
+// Float returns the value as a float, or nil when it is empty or not a number.
+func (f Field) Float() *float64 {
+	value, err := strconv.ParseFloat(string(f), 64)
+	if err != nil {
+		return nil
+	}
+	return &value
+}
+
+// Int returns the value as an integer, or zero when it is empty or not a number.
+func (f Field) Int() int64 {
+	value, _ := strconv.ParseInt(string(f), 10, 64)
+	return value
+}
+
+// Date returns the value as a date, or nil when it is empty or malformed.
+func (f Field) Date() *time.Time {
+	for _, layout := range []string{"2006-01-02", "2006-01-02T15:04:05.999999", "2006-01-02 15:04:05"} {
+		if t, err := time.Parse(layout, string(f)); err == nil {
+			return &t
+		}
+	}
+	return nil
+}
+
+// ElementSetSource is the provenance recorded on the element sets fetched from Space-Track.
+const ElementSetSource = "spacetrack"
+
+// GPRecord is an element set of the gp and gp_history classes.
+type GPRecord struct {
+	GPID        Field `json:"GP_ID"`
+	NoradCatID  Field `json:"NORAD_CAT_ID"`
+	ObjectName  Field `json:"OBJECT_NAME"`
+	ObjectID    Field `json:"OBJECT_ID"`
+	ObjectType  Field `json:"OBJECT_TYPE"`
+	CountryCode Field `json:"COUNTRY_CODE"`
+	Epoch       Field `json:"EPOCH"`
+	LaunchDate  Field `json:"LAUNCH_DATE"`
+	DecayDate   Field `json:"DECAY_DATE"`
+	Period      Field `json:"PERIOD"`
+	Inclination Field `json:"INCLINATION"`
+	Apoapsis    Field `json:"APOAPSIS"`
+	Periapsis   Field `json:"PERIAPSIS"`
+	RCSSize     Field `json:"RCS_SIZE"`
+	TLELine0    Field `json:"TLE_LINE0"`
+	TLELine1    Field `json:"TLE_LINE1"`
+	TLELine2    Field `json:"TLE_LINE2"`
+}
+
+// ToTLE maps the record to a TLE.
+func (r GPRecord) ToTLE() (domain.TLE, error) {
+	if r.NoradCatID == "" || r.TLELine1 == "" || r.TLELine2 == "" {
+		return domain.TLE{}, fmt.Errorf("element set %s has no TLE lines", r.GPID)
+	}
+	tle, err := domain.NewTLE(string(r.NoradCatID), string(r.TLELine1), string(r.TLELine2), time.Now().UTC(), string(r.ObjectName), true, false)
+	if err != nil {
+		return domain.TLE{}, err
+	}
+	tle.Name = string(r.ObjectName)
+	tle.SetProvenance(ElementSetSource, tle.CreatedAt)
+	return tle, nil
+}
+
+// SatcatRecord is an entry of the satcat class.
+type SatcatRecord struct {
+	IntlDes     Field `json:"INTLDES"`
+	NoradCatID  Field `json:"NORAD_CAT_ID"`
+	ObjectType  Field `json:"OBJECT_TYPE"`
+	SatName     Field `json:"SATNAME"`
+	Country     Field `json:"COUNTRY"`
+	Launch      Field `json:"LAUNCH"`
+	Site        Field `json:"SITE"`
+	Decay       Field `json:"DECAY"`
+	Period      Field `json:"PERIOD"`
+	Inclination Field `json:"INCLINATION"`
+	Apogee      Field `json:"APOGEE"`
+	Perigee     Field `json:"PERIGEE"`
+	RCSValue    Field `json:"RCSVALUE"`
+	Current     Field `json:"CURRENT"`
+}
+
+// ToSatellite maps the record to a catalog satellite.
+func (r SatcatRecord) ToSatellite() (domain.Satellite, error) {
+	if r.NoradCatID == "" {
+		return domain.Satellite{}, fmt.Errorf("catalog entry %s has no NORAD ID", r.IntlDes)
+	}
+
+	apogee := r.Apogee.Float()
+	perigee := r.Perigee.Float()
+	var altitude *float64
+	if apogee != nil && perigee != nil {
+		average := xspace.ComputeAverageAltitude(*apogee, *perigee)
+		altitude = &average
+	}
+
+	satellite, err := domain.NewSatelliteFromStatCat(
+		string(r.SatName),
+		string(r.NoradCatID),
+		domain.Other,
+		r.Launch.Date(),
+		r.Decay.Date(),
+		string(r.IntlDes),
+		string(r.Country),
+		string(r.ObjectType),
+		r.Period.Float(),
+		r.Inclination.Float(),
+		apogee,
+		perigee,
+		r.RCSValue.Float(),
+		altitude,
+	)
+	if err != nil {
+		return domain.Satellite{}, err
+	}
+	satellite.LaunchSite = string(r.Site)
+	satellite.IsActive = satellite.DecayDate == nil
+	return satellite, nil
+}
@@ -27,6 +27,7 @@ This command is a helper to get you started in your debugging journey.`,
 	infoCmd.AddCommand(info.EnvCmd(app))
 	infoCmd.AddCommand(info.FeaturesCmd(app))
 	infoCmd.AddCommand(info.VersionCmd(app))
+	infoCmd.AddCommand(info.DataQualityCmd(app))
 
 	return infoCmd
 }
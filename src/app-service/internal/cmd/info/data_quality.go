@@ -0,0 +1,53 @@
+package info
+
+import (
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/app"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"github.com/Elbujito/2112/src/app-service/internal/proc"
+	logger "github.com/Elbujito/2112/src/app-service/pkg/log"
+	"github.com/spf13/cobra"
+)
+
+// DataQualityCmd creates the `data-quality` subcommand
+func DataQualityCmd(app *app.App) *cobra.Command {
+	var contextName string
+	var staleDays int
+	var fix bool
+	var prune bool
+
+	dataQualityCmd := &cobra.Command{
+		Use:   "data-quality",
+		Short: "Check the catalog consistency",
+		Long: `Check the consistency of the catalog and print the findings per context:
+- orphan_tles: TLEs without a satellite, checked on the whole catalog
+- satellites_without_tle: satellites of a context without any TLE
+- stale_tles: satellites of a context whose current TLE is older than the stale limit
+- dangling_mappings: tile mappings of a context pointing to deleted tiles
+
+Use --fix to create the satellites of orphan TLEs and --prune to delete the orphan TLEs,
+remove the satellites without TLE from their context and delete the dangling mappings.`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			proc.InitClients()
+			proc.ConfigureClients()
+			proc.InitDbConnection()
+			proc.InitModels()
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			logger.Debug("Running data quality checks...")
+			proc.DataQuality(cmd.Context(), contextName, domain.DataQualityOptions{
+				StaleAfter: time.Duration(staleDays) * 24 * time.Hour,
+				Fix:        fix,
+				Prune:      prune,
+			})
+		},
+	}
+
+	dataQualityCmd.Flags().StringVarP(&contextName, "context", "c", "", "Context to check, all contexts when empty")
+	dataQualityCmd.Flags().IntVar(&staleDays, "stale-days", int(domain.DefaultDataQualityStaleAfter/(24*time.Hour)), "Age in days after which a current TLE is stale")
+	dataQualityCmd.Flags().BoolVar(&fix, "fix", false, "Create the satellites of orphan TLEs, only without --context")
+	dataQualityCmd.Flags().BoolVar(&prune, "prune", false, "Delete orphan TLEs (only without --context) and dangling mappings, remove satellites without TLE from their context")
+
+	return dataQualityCmd
+}
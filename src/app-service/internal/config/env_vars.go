@@ -16,13 +16,16 @@ import (
 )
 
 type EnvVars struct {
-	DisableFeatures []string                  `mapstructure:"DISABLE_FEATURES"`
-	Service         features.ServiceConfig    `mapstructure:",squash"`
-	Database        features.DatabaseConfig   `mapstructure:",squash"`
-	Redis           features.RedisConfig      `mapstructure:",squash"`
-	Celestrack      features.CelestrackConfig `mapstructure:",squash"`
-	Propagator      features.PropagatorConfig `mapstructure:",squash"`
-	Clerk           features.ClerkConfig      `mapstructure:",squash"`
+	DisableFeatures []string                   `mapstructure:"DISABLE_FEATURES"`
+	Service         features.ServiceConfig     `mapstructure:",squash"`
+	Database        features.DatabaseConfig    `mapstructure:",squash"`
+	Redis           features.RedisConfig       `mapstructure:",squash"`
+	Celestrack      features.CelestrackConfig  `mapstructure:",squash"`
+	Propagator      features.PropagatorConfig  `mapstructure:",squash"`
+	Clerk           features.ClerkConfig       `mapstructure:",squash"`
+	Boundaries      features.BoundariesConfig  `mapstructure:",squash"`
+	ElementSets     features.ElementSetsConfig `mapstructure:",squash"`
+	SpaceTrack      features.SpaceTrackConfig  `mapstructure:",squash"`
 }
 
 func (c *EnvVars) Init() {
@@ -86,6 +89,15 @@ func (c *EnvVars) setDefaults() {
 	viper.SetDefault("CELESTRACK_URL", xconstants.DEFAULT_PUBLIC_CESLESTRACK_URL)
 	viper.SetDefault("PROPAGATOR_URL", xconstants.DEFAULT_PRIVATE_PROPAGATOR_URL)
 	viper.SetDefault("CELESTRACK_SATCAT_URL", xconstants.DEFAULT_PUBLIC_CESLESTRACK_SATCAT_URL)
+	viper.SetDefault("CELESTRACK_CACHE_DIR", xconstants.DEFAULT_CELESTRACK_CACHE_DIR)
+	viper.SetDefault("CELESTRACK_REQUESTS_PER_MINUTE", strconv.Itoa(xconstants.DEFAULT_CELESTRACK_REQUESTS_PER_MINUTE))
+	viper.SetDefault("CELESTRACK_MAX_RETRIES", strconv.Itoa(xconstants.DEFAULT_CELESTRACK_MAX_RETRIES))
+	viper.SetDefault("BOUNDARIES_DIR", xconstants.DEFAULT_BOUNDARIES_DIR)
+	viper.SetDefault("ELEMENT_SET_SOURCES", xconstants.DEFAULT_ELEMENT_SET_SOURCES)
+	viper.SetDefault("ELEMENT_SET_PRECEDENCE", xconstants.DEFAULT_ELEMENT_SET_PRECEDENCE)
+	viper.SetDefault("SPACETRACK_URL", xconstants.DEFAULT_PUBLIC_SPACETRACK_URL)
+	viper.SetDefault("SPACETRACK_REQUESTS_PER_MINUTE", strconv.Itoa(xconstants.DEFAULT_SPACETRACK_REQUESTS_PER_MINUTE))
+	viper.SetDefault("SPACETRACK_REQUESTS_PER_HOUR", strconv.Itoa(xconstants.DEFAULT_SPACETRACK_REQUESTS_PER_HOUR))
 }
 
 func (c *EnvVars) OverrideUsingFlags() {
@@ -0,0 +1,23 @@
+package features
+
+import xconstants "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xconstants"
+
+// BoundariesConfig locates the offline GeoJSON admin area datasets imported by the db seed.
+type BoundariesConfig struct {
+	Dir string `mapstructure:"BOUNDARIES_DIR"`
+}
+
+var boundaries = &Feature{
+	Name:       xconstants.FEATURE_BOUNDARIES,
+	Config:     &BoundariesConfig{},
+	enabled:    true,
+	configured: false,
+	ready:      false,
+	requirements: []string{
+		"Dir",
+	},
+}
+
+func init() {
+	Features.Add(boundaries)
+}
@@ -3,8 +3,11 @@ package features
 import xconstants "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xconstants"
 
 type CelestrackConfig struct {
-	BaseUrl string `mapstructure:"CELESTRACK_URL"`
-	Satcat  string `mapstructure:"CELESTRACK_SATCAT_URL"`
+	BaseUrl           string `mapstructure:"CELESTRACK_URL"`
+	Satcat            string `mapstructure:"CELESTRACK_SATCAT_URL"`
+	CacheDir          string `mapstructure:"CELESTRACK_CACHE_DIR"`           // On-disk cache of the group responses, empty to disable
+	RequestsPerMinute string `mapstructure:"CELESTRACK_REQUESTS_PER_MINUTE"` // Client-side request limit
+	MaxRetries        string `mapstructure:"CELESTRACK_MAX_RETRIES"`         // Retries of failed or throttled requests
 }
 
 var celestrack = &Feature{
@@ -0,0 +1,29 @@
+package features
+
+import xconstants "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xconstants"
+
+// ElementSetsConfig selects the element set source of each GameContext.
+// Sources is a semicolon separated list of context=kind[:target] entries, where kind is one of
+// celestrak, file, dir or http, for example "default=celestrak;airgap=dir:/var/2112/drop".
+// The "default" entry applies to contexts without their own entry.
+// Precedence decides which stored element set of a satellite is propagated, either "newest" or
+// a preferred source list such as "source:spacetrack,celestrak".
+type ElementSetsConfig struct {
+	Sources    string `mapstructure:"ELEMENT_SET_SOURCES"`
+	Precedence string `mapstructure:"ELEMENT_SET_PRECEDENCE"`
+}
+
+var elementSets = &Feature{
+	Name:       xconstants.FEATURE_ELEMENT_SETS,
+	Config:     &ElementSetsConfig{},
+	enabled:    true,
+	configured: false,
+	ready:      false,
+	requirements: []string{
+		"Sources",
+	},
+}
+
+func init() {
+	Features.Add(elementSets)
+}
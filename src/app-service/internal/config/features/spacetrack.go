@@ -0,0 +1,29 @@
+package features
+
+import xconstants "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xconstants"
+
+// SpaceTrackConfig holds the Space-Track.org account and the request limits applied by the client.
+type SpaceTrackConfig struct {
+	BaseUrl           string `mapstructure:"SPACETRACK_URL"`
+	Identity          string `mapstructure:"SPACETRACK_IDENTITY"`
+	Password          string `mapstructure:"SPACETRACK_PASSWORD"`
+	RequestsPerMinute string `mapstructure:"SPACETRACK_REQUESTS_PER_MINUTE"`
+	RequestsPerHour   string `mapstructure:"SPACETRACK_REQUESTS_PER_HOUR"`
+}
+
+var spaceTrack = &Feature{
+	Name:       xconstants.FEATURE_SPACETRACK,
+	Config:     &SpaceTrackConfig{},
+	enabled:    true,
+	configured: false,
+	ready:      false,
+	requirements: []string{
+		"BaseUrl",
+		"Identity",
+		"Password",
+	},
+}
+
+func init() {
+	Features.Add(spaceTrack)
+}
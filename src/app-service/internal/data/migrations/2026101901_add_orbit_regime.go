@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026101901_add_orbit_regime",
+		Migrate: func(db *gorm.DB) error {
+			type Satellite struct {
+				OrbitRegime string `gorm:"size:32;index"`
+			}
+
+			// AutoMigrate only adds the missing column and its index
+			return db.AutoMigrate(&Satellite{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			type Satellite struct {
+				OrbitRegime string `gorm:"size:32;index"`
+			}
+
+			return db.Migrator().DropColumn(&Satellite{}, "OrbitRegime")
+		},
+	}
+
+	AddMigration(m)
+}
@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102001_create_geo_tables",
+		Migrate: func(db *gorm.DB) error {
+			// Define the GeoObject table
+			type GeoObject struct {
+				models.ModelBase
+				NoradID       string    `gorm:"size:255;unique;not null"`
+				Name          string    `gorm:"size:255"`
+				Longitude     float64   `gorm:"type:double precision;not null;index"`
+				DriftRate     float64   `gorm:"type:double precision;not null"`
+				Inclination   float64   `gorm:"type:double precision;not null"`
+				LongitudeSpan float64   `gorm:"type:double precision;not null"`
+				Status        string    `gorm:"size:32;not null;index"`
+				Epoch         time.Time `gorm:"not null"`
+			}
+
+			// Define the GeoObservation table
+			type GeoObservation struct {
+				models.ModelBase
+				NoradID     string    `gorm:"size:255;not null;uniqueIndex:unique_geo_observation"`
+				Epoch       time.Time `gorm:"not null;uniqueIndex:unique_geo_observation"`
+				Longitude   float64   `gorm:"type:double precision;not null"`
+				DriftRate   float64   `gorm:"type:double precision;not null"`
+				Inclination float64   `gorm:"type:double precision;not null"`
+			}
+
+			return db.AutoMigrate(&GeoObject{}, &GeoObservation{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropTable("geo_observations", "geo_objects")
+		},
+	}
+
+	AddMigration(m)
+}
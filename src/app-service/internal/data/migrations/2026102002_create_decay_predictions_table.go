@@ -0,0 +1,38 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102002_create_decay_predictions_table",
+		Migrate: func(db *gorm.DB) error {
+			// Define the DecayPrediction table
+			type DecayPrediction struct {
+				models.ModelBase
+				NoradID     string    `gorm:"size:255;unique;not null"`
+				Name        string    `gorm:"size:255"`
+				Perigee     float64   `gorm:"type:double precision;not null"`
+				TleEpoch    time.Time `gorm:"not null"`
+				DecayAt     time.Time `gorm:"not null;index"`
+				WindowStart time.Time `gorm:"not null"`
+				WindowEnd   time.Time `gorm:"not null;index"`
+				Ballistic   float64   `gorm:"type:double precision;not null"`
+				F107        float64   `gorm:"type:double precision;not null"`
+				Ap          float64   `gorm:"type:double precision;not null"`
+			}
+
+			return db.AutoMigrate(&DecayPrediction{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropTable("decay_predictions")
+		},
+	}
+
+	AddMigration(m)
+}
@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102003_create_ground_stations_table",
+		Migrate: func(db *gorm.DB) error {
+			// Define the GroundStation table
+			type GroundStation struct {
+				models.ModelBase
+				ContextID       string  `gorm:"size:255;not null;uniqueIndex:idx_ground_station_context_name"`
+				TenantID        string  `gorm:"size:255;not null;index"`
+				Name            string  `gorm:"size:255;not null;uniqueIndex:idx_ground_station_context_name"`
+				Latitude        float64 `gorm:"type:double precision;not null"`
+				Longitude       float64 `gorm:"type:double precision;not null"`
+				Altitude        float64 `gorm:"type:double precision;not null"`
+				MinElevation    float64 `gorm:"type:double precision;not null"`
+				HorizonMaskJSON string  `gorm:"type:json"`
+				BandsJSON       string  `gorm:"type:json"`
+			}
+
+			return db.AutoMigrate(&GroundStation{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropTable("ground_stations")
+		},
+	}
+
+	AddMigration(m)
+}
@@ -0,0 +1,51 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102004_create_contact_plan_tables",
+		Migrate: func(db *gorm.DB) error {
+			// Define the ContactPlan table
+			type ContactPlan struct {
+				models.ModelBase
+				ContextID       string    `gorm:"size:255;not null;index"`
+				TenantID        string    `gorm:"size:255;not null;index"`
+				Start           time.Time `gorm:"not null"`
+				End             time.Time `gorm:"not null"`
+				SetupSeconds    int64     `gorm:"not null"`
+				TeardownSeconds int64     `gorm:"not null"`
+				Rejected        int       `gorm:"not null"`
+			}
+
+			// Define the Contact table
+			type Contact struct {
+				ID           string    `gorm:"primaryKey;size:255"`
+				PlanID       string    `gorm:"size:255;not null;index"`
+				StationID    string    `gorm:"size:255;not null;index"`
+				StationName  string    `gorm:"size:255"`
+				NoradID      string    `gorm:"size:255;not null;index"`
+				Priority     int       `gorm:"not null"`
+				SetupStart   time.Time `gorm:"not null"`
+				AOS          time.Time `gorm:"column:aos;not null"`
+				TCA          time.Time `gorm:"column:tca;not null"`
+				LOS          time.Time `gorm:"column:los;not null"`
+				TeardownEnd  time.Time `gorm:"not null"`
+				MaxElevation float64   `gorm:"type:double precision;not null"`
+			}
+
+			return db.AutoMigrate(&ContactPlan{}, &Contact{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropTable("contacts", "contact_plans")
+		},
+	}
+
+	AddMigration(m)
+}
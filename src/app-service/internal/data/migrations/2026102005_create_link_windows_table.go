@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102005_create_link_windows_table",
+		Migrate: func(db *gorm.DB) error {
+			// Define the LinkWindow table
+			type LinkWindow struct {
+				models.ModelBase
+				ContextID  string    `gorm:"size:255;not null;index"`
+				NoradIDA   string    `gorm:"column:norad_id_a;size:255;not null;index"`
+				NoradIDB   string    `gorm:"column:norad_id_b;size:255;not null;index"`
+				Start      time.Time `gorm:"column:window_start;not null;index"`
+				End        time.Time `gorm:"column:window_end;not null;index"`
+				MinRangeKm float64   `gorm:"type:double precision;not null"`
+				MaxRangeKm float64   `gorm:"type:double precision;not null"`
+			}
+
+			return db.AutoMigrate(&LinkWindow{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropTable("link_windows")
+		},
+	}
+
+	AddMigration(m)
+}
@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102006_create_satellite_sensors_table",
+		Migrate: func(db *gorm.DB) error {
+			// Define the SatelliteSensor table
+			type SatelliteSensor struct {
+				models.ModelBase
+				NoradID      string  `gorm:"size:255;unique;not null"`
+				Type         string  `gorm:"size:32;not null"`
+				SwathWidthKm float64 `gorm:"type:double precision"`
+				HalfAngle    float64 `gorm:"type:double precision"`
+				MaxOffNadir  float64 `gorm:"type:double precision;not null"`
+			}
+
+			return db.AutoMigrate(&SatelliteSensor{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropTable("satellite_sensors")
+		},
+	}
+
+	AddMigration(m)
+}
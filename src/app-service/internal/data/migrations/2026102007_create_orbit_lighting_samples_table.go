@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102007_create_orbit_lighting_samples_table",
+		Migrate: func(db *gorm.DB) error {
+			// Define the OrbitLightingSample table
+			type OrbitLightingSample struct {
+				models.ModelBase
+				NoradID         string    `gorm:"size:255;not null;uniqueIndex:idx_orbit_lighting_norad_time"`
+				SampledAt       time.Time `gorm:"not null;uniqueIndex:idx_orbit_lighting_norad_time"`
+				TleEpoch        time.Time `gorm:"not null"`
+				RAAN            float64   `gorm:"column:raan;type:double precision;not null"`
+				LTAN            float64   `gorm:"column:ltan;type:double precision;not null"`
+				LTDN            float64   `gorm:"column:ltdn;type:double precision;not null"`
+				BetaAngle       float64   `gorm:"type:double precision;not null"`
+				EclipseFraction float64   `gorm:"type:double precision;not null"`
+				EclipseMinutes  float64   `gorm:"type:double precision;not null"`
+			}
+
+			return db.AutoMigrate(&OrbitLightingSample{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropTable("orbit_lighting_samples")
+		},
+	}
+
+	AddMigration(m)
+}
@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102008_add_satellite_synthetic_flag",
+		Migrate: func(db *gorm.DB) error {
+			type Satellite struct {
+				Synthetic bool `gorm:"not null;default:false;index"`
+			}
+
+			// AutoMigrate only adds the missing column and its index
+			return db.AutoMigrate(&Satellite{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			type Satellite struct {
+				Synthetic bool `gorm:"not null;default:false;index"`
+			}
+
+			return db.Migrator().DropColumn(&Satellite{}, "Synthetic")
+		},
+	}
+
+	AddMigration(m)
+}
@@ -0,0 +1,56 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102009_create_constellation_tables",
+		Migrate: func(db *gorm.DB) error {
+			// Define the Constellation table
+			type Constellation struct {
+				models.ModelBase
+				ContextID  string    `gorm:"size:255;not null;uniqueIndex:idx_constellation_context_name"`
+				TenantID   string    `gorm:"size:255;not null;index"`
+				Name       string    `gorm:"size:255;not null;uniqueIndex:idx_constellation_context_name"`
+				Kind       string    `gorm:"size:32;not null"`
+				WalkerJSON string    `gorm:"type:json"`
+				PlanesJSON string    `gorm:"type:json"`
+				Epoch      time.Time `gorm:"not null"`
+			}
+
+			// Define the ConstellationSatellite table
+			type ConstellationSatellite struct {
+				ConstellationID string `gorm:"primaryKey;size:255"`
+				NoradID         string `gorm:"primaryKey;size:255"`
+			}
+
+			// Define the ConstellationCoverageReport table
+			type ConstellationCoverageReport struct {
+				models.ModelBase
+				ConstellationID    string    `gorm:"size:255;not null;index"`
+				WindowStart        time.Time `gorm:"not null"`
+				WindowEnd          time.Time `gorm:"not null"`
+				StepSeconds        int       `gorm:"not null"`
+				MinElevation       float64   `gorm:"type:double precision;not null"`
+				Tiles              int       `gorm:"not null"`
+				CoverageFraction   float64   `gorm:"type:double precision;not null"`
+				MeanRevisitMinutes float64   `gorm:"type:double precision;not null"`
+				MaxGapMinutes      float64   `gorm:"type:double precision;not null"`
+				BandsJSON          string    `gorm:"type:json"`
+			}
+
+			return db.AutoMigrate(&Constellation{}, &ConstellationSatellite{}, &ConstellationCoverageReport{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropTable("constellation_coverage_reports", "constellation_satellites", "constellations")
+		},
+	}
+
+	AddMigration(m)
+}
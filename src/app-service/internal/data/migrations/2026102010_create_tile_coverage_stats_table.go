@@ -0,0 +1,41 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102010_create_tile_coverage_stats_table",
+		Migrate: func(db *gorm.DB) error {
+			// Define the TileCoverageStat table
+			type TileCoverageStat struct {
+				models.ModelBase
+				ContextID          string    `gorm:"type:char(36);not null;uniqueIndex:idx_tile_coverage_context_tile"`
+				TileID             string    `gorm:"type:char(36);not null;uniqueIndex:idx_tile_coverage_context_tile"`
+				Quadkey            string    `gorm:"size:256;not null"`
+				ZoomLevel          int       `gorm:"not null"`
+				CenterLat          float64   `gorm:"type:double precision;not null"`
+				CenterLon          float64   `gorm:"type:double precision;not null"`
+				WindowStart        time.Time `gorm:"not null"`
+				WindowEnd          time.Time `gorm:"not null"`
+				Passes             int       `gorm:"not null"`
+				DistinctSatellites int       `gorm:"not null"`
+				MeanRevisitSeconds float64   `gorm:"type:double precision;not null"`
+				MaxGapSeconds      float64   `gorm:"type:double precision;not null"`
+				DwellSeconds       float64   `gorm:"type:double precision;not null"`
+			}
+
+			return db.AutoMigrate(&TileCoverageStat{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropTable("tile_coverage_stats")
+		},
+	}
+
+	AddMigration(m)
+}
@@ -0,0 +1,50 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102011_create_geofence_tables",
+		Migrate: func(db *gorm.DB) error {
+			// Define the Geofence table
+			type Geofence struct {
+				models.ModelBase
+				ContextID   string `gorm:"size:255;not null;uniqueIndex:idx_geofence_context_name"`
+				TenantID    string `gorm:"size:255;not null;index"`
+				Name        string `gorm:"size:255;not null;uniqueIndex:idx_geofence_context_name"`
+				PolygonJSON string `gorm:"type:json"`
+			}
+
+			// Define the GeofenceSatellite table
+			type GeofenceSatellite struct {
+				GeofenceID string `gorm:"primaryKey;size:255"`
+				NoradID    string `gorm:"primaryKey;size:255"`
+			}
+
+			// Define the GeofenceEvent table
+			type GeofenceEvent struct {
+				models.ModelBase
+				GeofenceID string    `gorm:"size:255;not null;index:idx_geofence_event_time"`
+				ContextID  string    `gorm:"size:255;not null"`
+				NoradID    string    `gorm:"size:255;not null;index"`
+				Kind       string    `gorm:"size:16;not null"`
+				OccurredAt time.Time `gorm:"not null;index:idx_geofence_event_time"`
+				Latitude   float64   `gorm:"type:double precision;not null"`
+				Longitude  float64   `gorm:"type:double precision;not null"`
+			}
+
+			return db.AutoMigrate(&Geofence{}, &GeofenceSatellite{}, &GeofenceEvent{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropTable("geofence_events", "geofence_satellites", "geofences")
+		},
+	}
+
+	AddMigration(m)
+}
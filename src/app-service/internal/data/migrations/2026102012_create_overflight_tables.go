@@ -0,0 +1,62 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102012_create_overflight_tables",
+		Migrate: func(db *gorm.DB) error {
+			// Define the AdminArea table
+			type AdminArea struct {
+				models.ModelBase
+				Code         string  `gorm:"size:64;not null;uniqueIndex"`
+				Name         string  `gorm:"size:255;not null"`
+				Level        int     `gorm:"not null;index"`
+				ParentCode   string  `gorm:"size:64;index"`
+				PolygonsJSON string  `gorm:"type:json"`
+				MinLat       float64 `gorm:"type:double precision;not null"`
+				MinLon       float64 `gorm:"type:double precision;not null"`
+				MaxLat       float64 `gorm:"type:double precision;not null"`
+				MaxLon       float64 `gorm:"type:double precision;not null"`
+			}
+
+			// Define the OverflightReport table
+			type OverflightReport struct {
+				models.ModelBase
+				ContextID     string    `gorm:"size:255;not null;index"`
+				TenantID      string    `gorm:"size:255;not null;index"`
+				AreaCodesJSON string    `gorm:"type:json"`
+				WindowStart   time.Time `gorm:"not null"`
+				WindowEnd     time.Time `gorm:"not null"`
+				StepSeconds   int       `gorm:"not null"`
+			}
+
+			// Define the OverflightRecord table
+			type OverflightRecord struct {
+				models.ModelBase
+				ReportID        string    `gorm:"size:255;not null;index"`
+				NoradID         string    `gorm:"size:255;not null"`
+				SatelliteName   string    `gorm:"size:255"`
+				AreaCode        string    `gorm:"size:64;not null"`
+				AreaName        string    `gorm:"size:255;not null"`
+				EntryAt         time.Time `gorm:"not null"`
+				ExitAt          time.Time `gorm:"not null"`
+				DurationSeconds float64   `gorm:"type:double precision;not null"`
+				Truncated       bool      `gorm:"not null;default:false"`
+			}
+
+			return db.AutoMigrate(&AdminArea{}, &OverflightReport{}, &OverflightRecord{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropTable("overflight_records", "overflight_reports", "admin_areas")
+		},
+	}
+
+	AddMigration(m)
+}
@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102013_add_ground_station_refraction",
+		Migrate: func(db *gorm.DB) error {
+			type GroundStation struct {
+				PressureMbar *float64 `gorm:"type:double precision"`
+				TemperatureC *float64 `gorm:"type:double precision"`
+			}
+
+			// AutoMigrate only adds the missing nullable columns
+			return db.AutoMigrate(&GroundStation{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			type GroundStation struct {
+				PressureMbar *float64 `gorm:"type:double precision"`
+				TemperatureC *float64 `gorm:"type:double precision"`
+			}
+
+			if err := db.Migrator().DropColumn(&GroundStation{}, "PressureMbar"); err != nil {
+				return err
+			}
+			return db.Migrator().DropColumn(&GroundStation{}, "TemperatureC")
+		},
+	}
+
+	AddMigration(m)
+}
@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102014_create_sync_states_table",
+		Migrate: func(db *gorm.DB) error {
+			// Define the SyncState table
+			type SyncState struct {
+				models.ModelBase
+				Source   string    `gorm:"size:64;not null;uniqueIndex:idx_sync_state_source_scope"`
+				Scope    string    `gorm:"size:255;not null;uniqueIndex:idx_sync_state_source_scope"`
+				Cursor   string    `gorm:"size:255"`
+				SyncedAt time.Time `gorm:"not null"`
+			}
+
+			return db.AutoMigrate(&SyncState{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropTable("sync_states")
+		},
+	}
+
+	AddMigration(m)
+}
@@ -0,0 +1,38 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102015_add_sync_state_validators",
+		Migrate: func(db *gorm.DB) error {
+			type SyncState struct {
+				ETag         string `gorm:"size:255"`
+				LastModified string `gorm:"size:64"`
+				PayloadHash  string `gorm:"size:64"`
+			}
+
+			// AutoMigrate only adds the missing columns
+			return db.AutoMigrate(&SyncState{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			type SyncState struct {
+				ETag         string `gorm:"size:255"`
+				LastModified string `gorm:"size:64"`
+				PayloadHash  string `gorm:"size:64"`
+			}
+
+			for _, column := range []string{"ETag", "LastModified", "PayloadHash"} {
+				if err := db.Migrator().DropColumn(&SyncState{}, column); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	AddMigration(m)
+}
@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102016_add_tle_name",
+		Migrate: func(db *gorm.DB) error {
+			type TLE struct {
+				Name string `gorm:"size:255"`
+			}
+
+			// AutoMigrate only adds the missing column
+			return db.AutoMigrate(&TLE{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			type TLE struct {
+				Name string `gorm:"size:255"`
+			}
+
+			return db.Migrator().DropColumn(&TLE{}, "Name")
+		},
+	}
+
+	AddMigration(m)
+}
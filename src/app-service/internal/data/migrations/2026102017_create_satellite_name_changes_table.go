@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102017_create_satellite_name_changes_table",
+		Migrate: func(db *gorm.DB) error {
+			// Define the SatelliteNameChange table
+			type SatelliteNameChange struct {
+				models.ModelBase
+				NoradID   string    `gorm:"size:255;not null;index"`
+				OldName   string    `gorm:"size:255"`
+				NewName   string    `gorm:"size:255;not null"`
+				ChangedAt time.Time `gorm:"not null"`
+			}
+
+			return db.AutoMigrate(&SatelliteNameChange{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropTable("satellite_name_changes")
+		},
+	}
+
+	AddMigration(m)
+}
@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102018_add_satellite_catalog_fields",
+		Migrate: func(db *gorm.DB) error {
+			type Satellite struct {
+				OpsStatus   string `gorm:"size:8"`
+				LaunchSite  string `gorm:"size:16"`
+				OrbitCenter string `gorm:"size:16"`
+				OrbitType   string `gorm:"size:8"`
+			}
+
+			// AutoMigrate only adds the missing columns
+			return db.AutoMigrate(&Satellite{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			type Satellite struct {
+				OpsStatus   string `gorm:"size:8"`
+				LaunchSite  string `gorm:"size:16"`
+				OrbitCenter string `gorm:"size:16"`
+				OrbitType   string `gorm:"size:8"`
+			}
+
+			for _, column := range []string{"OpsStatus", "LaunchSite", "OrbitCenter", "OrbitType"} {
+				if err := db.Migrator().DropColumn(&Satellite{}, column); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	AddMigration(m)
+}
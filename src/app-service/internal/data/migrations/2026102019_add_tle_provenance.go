@@ -0,0 +1,55 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102019_add_tle_provenance",
+		Migrate: func(db *gorm.DB) error {
+			type TLE struct {
+				Source      string `gorm:"size:255;index"`
+				FetchedAt   *time.Time
+				PayloadHash string `gorm:"size:64"`
+				Current     bool   `gorm:"not null;default:false;index"`
+			}
+
+			// AutoMigrate only adds the missing columns and their indexes
+			if err := db.AutoMigrate(&TLE{}); err != nil {
+				return err
+			}
+
+			// Element sets stored before provenance was tracked were all ingested from CelesTrak
+			if err := db.Exec(`UPDATE tles SET source = 'celestrak', fetched_at = COALESCE(fetched_at, created_at)
+				WHERE source IS NULL OR source = ''`).Error; err != nil {
+				return err
+			}
+
+			// The newest stored element set of each satellite stays current
+			return db.Exec(`UPDATE tles SET current = true WHERE id IN (
+				SELECT DISTINCT ON (norad_id) id FROM tles ORDER BY norad_id, epoch DESC
+			)`).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			type TLE struct {
+				Source      string `gorm:"size:255;index"`
+				FetchedAt   *time.Time
+				PayloadHash string `gorm:"size:64"`
+				Current     bool   `gorm:"not null;default:false;index"`
+			}
+
+			for _, column := range []string{"Source", "FetchedAt", "PayloadHash", "Current"} {
+				if err := db.Migrator().DropColumn(&TLE{}, column); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	AddMigration(m)
+}
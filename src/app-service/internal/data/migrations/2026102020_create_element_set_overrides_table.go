@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102020_create_element_set_overrides_table",
+		Migrate: func(db *gorm.DB) error {
+			// Define the ElementSetOverride table, one override per satellite
+			type ElementSetOverride struct {
+				models.ModelBase
+				NoradID string `gorm:"size:255;not null;uniqueIndex"`
+				TleID   string `gorm:"type:char(36);not null"`
+				Reason  string `gorm:"type:text"`
+			}
+
+			return db.AutoMigrate(&ElementSetOverride{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropTable("element_set_overrides")
+		},
+	}
+
+	AddMigration(m)
+}
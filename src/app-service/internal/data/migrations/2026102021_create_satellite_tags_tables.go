@@ -0,0 +1,42 @@
+package migrations
+
+import (
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102021_create_satellite_tags_tables",
+		Migrate: func(db *gorm.DB) error {
+			// Referenced by the foreign key only, the satellites table already exists
+			type Satellite struct {
+				models.ModelBase
+			}
+
+			// Define the SatelliteTag table
+			type SatelliteTag struct {
+				models.ModelBase
+				Name        string `gorm:"size:255;unique;not null"`
+				Description string `gorm:"size:1024"`
+			}
+
+			// Define the many-to-many relationship between SatelliteTag and Satellite
+			type SatelliteTagAssignment struct {
+				SatelliteID string       `gorm:"not null;uniqueIndex:idx_satellite_tag"`
+				TagID       string       `gorm:"not null;uniqueIndex:idx_satellite_tag;index"`
+				Origin      string       `gorm:"size:32;not null"`
+				Satellite   Satellite    `gorm:"constraint:OnDelete:CASCADE;foreignKey:SatelliteID;references:ID"`
+				Tag         SatelliteTag `gorm:"constraint:OnDelete:CASCADE;foreignKey:TagID;references:ID"`
+			}
+
+			return db.AutoMigrate(&SatelliteTag{}, &SatelliteTagAssignment{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropTable("satellite_tag_assignments", "satellite_tags")
+		},
+	}
+
+	AddMigration(m)
+}
@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102022_create_transmitters_table",
+		Migrate: func(db *gorm.DB) error {
+			// Define the Transmitter table, frequencies are in Hz
+			type Transmitter struct {
+				models.ModelBase
+				NoradID      string  `gorm:"size:255;not null;index"`
+				ExternalID   *string `gorm:"size:64;uniqueIndex"`
+				Description  string  `gorm:"size:255;not null"`
+				Type         string  `gorm:"size:32"`
+				UplinkLow    *int64
+				UplinkHigh   *int64
+				DownlinkLow  *int64
+				DownlinkHigh *int64
+				Mode         string `gorm:"size:64"`
+				UplinkMode   string `gorm:"size:64"`
+				Baud         *float64
+				Invert       bool   `gorm:"not null;default:false"`
+				Status       string `gorm:"size:32;not null;index"`
+				Service      string `gorm:"size:64"`
+			}
+
+			return db.AutoMigrate(&Transmitter{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropTable("transmitters")
+		},
+	}
+
+	AddMigration(m)
+}
@@ -0,0 +1,47 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102023_add_satellite_launch_columns",
+		Migrate: func(db *gorm.DB) error {
+			type Satellite struct {
+				LaunchYear   int    `gorm:"index:idx_satellite_launch"`
+				LaunchNumber int    `gorm:"index:idx_satellite_launch"`
+				LaunchPiece  string `gorm:"size:8"`
+			}
+
+			// AutoMigrate only adds the missing columns and their indexes
+			if err := db.AutoMigrate(&Satellite{}); err != nil {
+				return err
+			}
+
+			// Parse the stored international designators in the catalog form, for example "1999-025A"
+			return db.Exec(`UPDATE satellites SET
+				launch_year = CAST(SUBSTRING(intl_designator FROM 1 FOR 4) AS INTEGER),
+				launch_number = CAST(SUBSTRING(intl_designator FROM 6 FOR 3) AS INTEGER),
+				launch_piece = SUBSTRING(intl_designator FROM 9)
+				WHERE intl_designator ~ '^[0-9]{4}-[0-9]{3}[A-Z]{0,3}$'`).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			type Satellite struct {
+				LaunchYear   int    `gorm:"index:idx_satellite_launch"`
+				LaunchNumber int    `gorm:"index:idx_satellite_launch"`
+				LaunchPiece  string `gorm:"size:8"`
+			}
+
+			for _, column := range []string{"LaunchYear", "LaunchNumber", "LaunchPiece"} {
+				if err := db.Migrator().DropColumn(&Satellite{}, column); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	AddMigration(m)
+}
@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func init() {
+	m := &gormigrate.Migration{
+		ID: "2026102024_create_fragmentation_events_table",
+		Migrate: func(db *gorm.DB) error {
+			// Define the FragmentationEvent table
+			type FragmentationEvent struct {
+				models.ModelBase
+				Launch       string    `gorm:"size:16;not null;index"`
+				LaunchYear   int       `gorm:"not null"`
+				LaunchNumber int       `gorm:"not null"`
+				NewPieces    int       `gorm:"not null"`
+				NoradIDsJSON string    `gorm:"type:json"`
+				WindowStart  time.Time `gorm:"not null"`
+				WindowEnd    time.Time `gorm:"not null;index"`
+				DetectedAt   time.Time `gorm:"not null;index"`
+			}
+
+			return db.AutoMigrate(&FragmentationEvent{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropTable("fragmentation_events")
+		},
+	}
+
+	AddMigration(m)
+}
@@ -0,0 +1,178 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+)
+
+// Constellation represents a constellation of synthetic satellites within a context.
+type Constellation struct {
+	ModelBase
+	ContextID  string    `gorm:"size:255;not null;uniqueIndex:idx_constellation_context_name"` // Owning context
+	TenantID   string    `gorm:"size:255;not null;index"`                                      // Tenant identifier
+	Name       string    `gorm:"size:255;not null;uniqueIndex:idx_constellation_context_name"` // Constellation name, unique per context
+	Kind       string    `gorm:"size:32;not null"`                                             // WALKER_DELTA, WALKER_STAR or CUSTOM
+	WalkerJSON string    `gorm:"type:json"`                                                    // Serialized Walker definition
+	PlanesJSON string    `gorm:"type:json"`                                                    // Serialized custom planes
+	Epoch      time.Time `gorm:"not null"`                                                     // Epoch of the generated element sets
+}
+
+// ConstellationSatellite represents the membership of a satellite in a constellation.
+type ConstellationSatellite struct {
+	ConstellationID string `gorm:"primaryKey;size:255"` // Owning constellation
+	NoradID         string `gorm:"primaryKey;size:255"` // Member satellite
+}
+
+// ConstellationCoverageReport represents the stored coverage statistics of a constellation.
+type ConstellationCoverageReport struct {
+	ModelBase
+	ConstellationID    string    `gorm:"size:255;not null;index"`        // Evaluated constellation
+	WindowStart        time.Time `gorm:"not null"`                       // Start of the analysis window
+	WindowEnd          time.Time `gorm:"not null"`                       // End of the analysis window
+	StepSeconds        int       `gorm:"not null"`                       // Sampling step
+	MinElevation       float64   `gorm:"type:double precision;not null"` // Minimum elevation in degrees
+	Tiles              int       `gorm:"not null"`                       // Number of evaluated tiles
+	CoverageFraction   float64   `gorm:"type:double precision;not null"` // Mean coverage fraction
+	MeanRevisitMinutes float64   `gorm:"type:double precision;not null"` // Mean revisit in minutes
+	MaxGapMinutes      float64   `gorm:"type:double precision;not null"` // Longest gap in minutes
+	BandsJSON          string    `gorm:"type:json"`                      // Serialized per latitude band statistics
+}
+
+// MapToConstellationDomain converts a Constellation and its members to a domain model.
+func MapToConstellationDomain(c Constellation, members []ConstellationSatellite) domain.Constellation {
+	var walker *xspace.WalkerConstellation
+	if err := json.Unmarshal([]byte(c.WalkerJSON), &walker); err != nil {
+		walker = nil
+	}
+	var planes []domain.ConstellationPlane
+	if err := json.Unmarshal([]byte(c.PlanesJSON), &planes); err != nil {
+		planes = nil
+	}
+	noradIDs := make([]string, len(members))
+	for i, member := range members {
+		noradIDs[i] = member.NoradID
+	}
+
+	return domain.Constellation{
+		ModelBase: domain.ModelBase{
+			ID:          c.ID,
+			CreatedAt:   c.CreatedAt,
+			UpdatedAt:   &c.UpdatedAt,
+			DeleteAt:    c.DeleteAt,
+			ProcessedAt: c.ProcessedAt,
+			IsActive:    c.IsActive,
+			IsFavourite: c.IsFavourite,
+			DisplayName: c.DisplayName,
+		},
+		ContextID: c.ContextID,
+		TenantID:  domain.TenantID(c.TenantID),
+		Name:      c.Name,
+		Kind:      domain.ConstellationKind(c.Kind),
+		Walker:    walker,
+		Planes:    planes,
+		Epoch:     c.Epoch,
+		NoradIDs:  noradIDs,
+	}
+}
+
+// MapToConstellationModel converts a Constellation domain model to database models.
+func MapToConstellationModel(c domain.Constellation) (Constellation, []ConstellationSatellite) {
+	walkerJSON, err := json.Marshal(c.Walker)
+	if err != nil {
+		walkerJSON = []byte("null")
+	}
+	planesJSON, err := json.Marshal(c.Planes)
+	if err != nil || c.Planes == nil {
+		planesJSON = []byte("[]")
+	}
+
+	members := make([]ConstellationSatellite, len(c.NoradIDs))
+	for i, noradID := range c.NoradIDs {
+		members[i] = ConstellationSatellite{ConstellationID: c.ID, NoradID: noradID}
+	}
+
+	return Constellation{
+		ModelBase: ModelBase{
+			ID:          c.ID,
+			CreatedAt:   c.CreatedAt,
+			UpdatedAt:   *c.UpdatedAt,
+			DeleteAt:    c.DeleteAt,
+			ProcessedAt: c.ProcessedAt,
+			IsActive:    c.IsActive,
+			IsFavourite: c.IsFavourite,
+			DisplayName: c.DisplayName,
+		},
+		ContextID:  c.ContextID,
+		TenantID:   string(c.TenantID),
+		Name:       c.Name,
+		Kind:       string(c.Kind),
+		WalkerJSON: string(walkerJSON),
+		PlanesJSON: string(planesJSON),
+		Epoch:      c.Epoch,
+	}, members
+}
+
+// MapToConstellationCoverageReportDomain converts a coverage report database model to a domain model.
+func MapToConstellationCoverageReportDomain(r ConstellationCoverageReport) domain.ConstellationCoverageReport {
+	var bands []domain.CoverageBand
+	if err := json.Unmarshal([]byte(r.BandsJSON), &bands); err != nil {
+		bands = nil
+	}
+
+	return domain.ConstellationCoverageReport{
+		ModelBase: domain.ModelBase{
+			ID:          r.ID,
+			CreatedAt:   r.CreatedAt,
+			UpdatedAt:   &r.UpdatedAt,
+			DeleteAt:    r.DeleteAt,
+			ProcessedAt: r.ProcessedAt,
+			IsActive:    r.IsActive,
+			IsFavourite: r.IsFavourite,
+			DisplayName: r.DisplayName,
+		},
+		ConstellationID:    r.ConstellationID,
+		WindowStart:        r.WindowStart,
+		WindowEnd:          r.WindowEnd,
+		StepSeconds:        r.StepSeconds,
+		MinElevation:       r.MinElevation,
+		Tiles:              r.Tiles,
+		CoverageFraction:   r.CoverageFraction,
+		MeanRevisitMinutes: r.MeanRevisitMinutes,
+		MaxGapMinutes:      r.MaxGapMinutes,
+		Bands:              bands,
+	}
+}
+
+// MapToConstellationCoverageReportModel converts a coverage report domain model to a database model.
+func MapToConstellationCoverageReportModel(r domain.ConstellationCoverageReport) ConstellationCoverageReport {
+	bandsJSON, err := json.Marshal(r.Bands)
+	if err != nil || r.Bands == nil {
+		bandsJSON = []byte("[]")
+	}
+
+	return ConstellationCoverageReport{
+		ModelBase: ModelBase{
+			ID:          r.ID,
+			CreatedAt:   r.CreatedAt,
+			UpdatedAt:   *r.UpdatedAt,
+			DeleteAt:    r.DeleteAt,
+			ProcessedAt: r.ProcessedAt,
+			IsActive:    r.IsActive,
+			IsFavourite: r.IsFavourite,
+			DisplayName: r.DisplayName,
+		},
+		ConstellationID:    r.ConstellationID,
+		WindowStart:        r.WindowStart,
+		WindowEnd:          r.WindowEnd,
+		StepSeconds:        r.StepSeconds,
+		MinElevation:       r.MinElevation,
+		Tiles:              r.Tiles,
+		CoverageFraction:   r.CoverageFraction,
+		MeanRevisitMinutes: r.MeanRevisitMinutes,
+		MaxGapMinutes:      r.MaxGapMinutes,
+		BandsJSON:          string(bandsJSON),
+	}
+}
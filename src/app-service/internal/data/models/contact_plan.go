@@ -0,0 +1,117 @@
+package models
+
+import (
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+// ContactPlan represents a stored contact schedule of a context.
+type ContactPlan struct {
+	ModelBase
+	ContextID       string    `gorm:"size:255;not null;index"` // Owning context
+	TenantID        string    `gorm:"size:255;not null;index"` // Tenant identifier
+	Start           time.Time `gorm:"not null"`                // Start of the planning window
+	End             time.Time `gorm:"not null"`                // End of the planning window
+	SetupSeconds    int64     `gorm:"not null"`                // Setup margin before AOS
+	TeardownSeconds int64     `gorm:"not null"`                // Teardown margin after LOS
+	Rejected        int       `gorm:"not null"`                // Candidate passes dropped because of conflicts
+}
+
+// Contact represents a scheduled station booking of a contact plan.
+type Contact struct {
+	ID           string    `gorm:"primaryKey;size:255"`
+	PlanID       string    `gorm:"size:255;not null;index"` // Owning contact plan
+	StationID    string    `gorm:"size:255;not null;index"` // Ground station
+	StationName  string    `gorm:"size:255"`
+	NoradID      string    `gorm:"size:255;not null;index"`
+	Priority     int       `gorm:"not null"`
+	SetupStart   time.Time `gorm:"not null"`
+	AOS          time.Time `gorm:"column:aos;not null"`
+	TCA          time.Time `gorm:"column:tca;not null"`
+	LOS          time.Time `gorm:"column:los;not null"`
+	TeardownEnd  time.Time `gorm:"not null"`
+	MaxElevation float64   `gorm:"type:double precision;not null"`
+}
+
+// MapToContactPlanDomain converts a ContactPlan and its contacts to a domain model.
+func MapToContactPlanDomain(p ContactPlan, contacts []Contact) domain.ContactPlan {
+	var domainContacts []domain.Contact
+	for _, c := range contacts {
+		domainContacts = append(domainContacts, domain.Contact{
+			ID:           c.ID,
+			StationID:    c.StationID,
+			StationName:  c.StationName,
+			NoradID:      c.NoradID,
+			Priority:     c.Priority,
+			SetupStart:   c.SetupStart,
+			AOS:          c.AOS,
+			TCA:          c.TCA,
+			LOS:          c.LOS,
+			TeardownEnd:  c.TeardownEnd,
+			MaxElevation: c.MaxElevation,
+		})
+	}
+
+	return domain.ContactPlan{
+		ModelBase: domain.ModelBase{
+			ID:          p.ID,
+			CreatedAt:   p.CreatedAt,
+			UpdatedAt:   &p.UpdatedAt,
+			DeleteAt:    p.DeleteAt,
+			ProcessedAt: p.ProcessedAt,
+			IsActive:    p.IsActive,
+			IsFavourite: p.IsFavourite,
+			DisplayName: p.DisplayName,
+		},
+		ContextID:      p.ContextID,
+		TenantID:       domain.TenantID(p.TenantID),
+		Start:          p.Start,
+		End:            p.End,
+		SetupMargin:    time.Duration(p.SetupSeconds) * time.Second,
+		TeardownMargin: time.Duration(p.TeardownSeconds) * time.Second,
+		Contacts:       domainContacts,
+		Rejected:       p.Rejected,
+	}
+}
+
+// MapToContactPlanModel converts a ContactPlan domain model to database models.
+func MapToContactPlanModel(p domain.ContactPlan) (ContactPlan, []Contact) {
+	var contacts []Contact
+	for _, c := range p.Contacts {
+		contacts = append(contacts, Contact{
+			ID:           c.ID,
+			PlanID:       p.ID,
+			StationID:    c.StationID,
+			StationName:  c.StationName,
+			NoradID:      c.NoradID,
+			Priority:     c.Priority,
+			SetupStart:   c.SetupStart,
+			AOS:          c.AOS,
+			TCA:          c.TCA,
+			LOS:          c.LOS,
+			TeardownEnd:  c.TeardownEnd,
+			MaxElevation: c.MaxElevation,
+		})
+	}
+
+	return ContactPlan{
+		ModelBase: ModelBase{
+			ID:          p.ID,
+			CreatedAt:   p.CreatedAt,
+			UpdatedAt:   *p.UpdatedAt,
+			DeleteAt:    p.DeleteAt,
+			ProcessedAt: p.ProcessedAt,
+			IsActive:    p.IsActive,
+			IsFavourite: p.IsFavourite,
+			DisplayName: p.DisplayName,
+		},
+		ContextID:       p.ContextID,
+		TenantID:        string(p.TenantID),
+		Start:           p.Start,
+		End:             p.End,
+		SetupSeconds:    int64(p.SetupMargin / time.Second),
+		TeardownSeconds: int64(p.TeardownMargin / time.Second),
+		Rejected:        p.Rejected,
+	}, contacts
+}
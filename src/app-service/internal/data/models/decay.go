@@ -0,0 +1,74 @@
+package models
+
+import (
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+// DecayPrediction represents the predicted reentry window of a satellite.
+type DecayPrediction struct {
+	ModelBase
+	NoradID     string    `gorm:"size:255;unique;not null"`       // NORAD ID
+	Name        string    `gorm:"size:255"`                       // Satellite name
+	Perigee     float64   `gorm:"type:double precision;not null"` // Perigee altitude in kilometers
+	TleEpoch    time.Time `gorm:"not null"`                       // Epoch of the TLE used for the prediction
+	DecayAt     time.Time `gorm:"not null;index"`                 // Most likely reentry time
+	WindowStart time.Time `gorm:"not null"`                       // Earliest expected reentry time
+	WindowEnd   time.Time `gorm:"not null;index"`                 // Latest expected reentry time
+	Ballistic   float64   `gorm:"type:double precision;not null"` // Effective Cd*A/m in m^2/kg
+	F107        float64   `gorm:"type:double precision;not null"` // Solar flux used for the prediction
+	Ap          float64   `gorm:"type:double precision;not null"` // Geomagnetic index used for the prediction
+}
+
+// MapToDecayPredictionDomain converts a DecayPrediction database model to a domain model.
+func MapToDecayPredictionDomain(d DecayPrediction) domain.DecayPrediction {
+	return domain.DecayPrediction{
+		ModelBase: domain.ModelBase{
+			ID:          d.ID,
+			CreatedAt:   d.CreatedAt,
+			UpdatedAt:   &d.UpdatedAt,
+			DeleteAt:    d.DeleteAt,
+			ProcessedAt: d.ProcessedAt,
+			IsActive:    d.IsActive,
+			IsFavourite: d.IsFavourite,
+			DisplayName: d.DisplayName,
+		},
+		NoradID:     d.NoradID,
+		Name:        d.Name,
+		Perigee:     d.Perigee,
+		TleEpoch:    d.TleEpoch,
+		DecayAt:     d.DecayAt,
+		WindowStart: d.WindowStart,
+		WindowEnd:   d.WindowEnd,
+		Ballistic:   d.Ballistic,
+		F107:        d.F107,
+		Ap:          d.Ap,
+	}
+}
+
+// MapToDecayPredictionModel converts a DecayPrediction domain model to a database model.
+func MapToDecayPredictionModel(d domain.DecayPrediction) DecayPrediction {
+	return DecayPrediction{
+		ModelBase: ModelBase{
+			ID:          d.ID,
+			CreatedAt:   d.CreatedAt,
+			UpdatedAt:   *d.UpdatedAt,
+			DeleteAt:    d.DeleteAt,
+			ProcessedAt: d.ProcessedAt,
+			IsActive:    d.IsActive,
+			IsFavourite: d.IsFavourite,
+			DisplayName: d.DisplayName,
+		},
+		NoradID:     d.NoradID,
+		Name:        d.Name,
+		Perigee:     d.Perigee,
+		TleEpoch:    d.TleEpoch,
+		DecayAt:     d.DecayAt,
+		WindowStart: d.WindowStart,
+		WindowEnd:   d.WindowEnd,
+		Ballistic:   d.Ballistic,
+		F107:        d.F107,
+		Ap:          d.Ap,
+	}
+}
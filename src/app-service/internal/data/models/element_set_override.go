@@ -0,0 +1,51 @@
+package models
+
+import (
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+// ElementSetOverride pins the element set used for propagation of a satellite.
+type ElementSetOverride struct {
+	ModelBase
+	NoradID string `gorm:"size:255;not null;uniqueIndex"`
+	TleID   string `gorm:"type:char(36);not null"`
+	Reason  string `gorm:"type:text"`
+}
+
+// MapToElementSetOverrideDomain converts an ElementSetOverride database model to a domain model.
+func MapToElementSetOverrideDomain(o ElementSetOverride) domain.ElementSetOverride {
+	return domain.ElementSetOverride{
+		ModelBase: domain.ModelBase{
+			ID:          o.ID,
+			CreatedAt:   o.CreatedAt,
+			UpdatedAt:   &o.UpdatedAt,
+			DeleteAt:    o.DeleteAt,
+			ProcessedAt: o.ProcessedAt,
+			IsActive:    o.IsActive,
+			IsFavourite: o.IsFavourite,
+			DisplayName: o.DisplayName,
+		},
+		NoradID: o.NoradID,
+		TleID:   o.TleID,
+		Reason:  o.Reason,
+	}
+}
+
+// MapToElementSetOverrideModel converts an ElementSetOverride domain model to a database model.
+func MapToElementSetOverrideModel(o domain.ElementSetOverride) ElementSetOverride {
+	return ElementSetOverride{
+		ModelBase: ModelBase{
+			ID:          o.ID,
+			CreatedAt:   o.CreatedAt,
+			UpdatedAt:   *o.UpdatedAt,
+			DeleteAt:    o.DeleteAt,
+			ProcessedAt: o.ProcessedAt,
+			IsActive:    o.IsActive,
+			IsFavourite: o.IsFavourite,
+			DisplayName: o.DisplayName,
+		},
+		NoradID: o.NoradID,
+		TleID:   o.TleID,
+		Reason:  o.Reason,
+	}
+}
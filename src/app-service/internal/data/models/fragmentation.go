@@ -0,0 +1,79 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+// FragmentationEvent represents a burst of new pieces cataloged for a launch.
+type FragmentationEvent struct {
+	ModelBase
+	Launch       string    `gorm:"size:16;not null;index"` // Launch designator, for example "1999-025"
+	LaunchYear   int       `gorm:"not null"`               // Year of the launch
+	LaunchNumber int       `gorm:"not null"`               // Number of the launch in its year
+	NewPieces    int       `gorm:"not null"`               // Number of pieces cataloged in the window
+	NoradIDsJSON string    `gorm:"type:json"`              // Serialized NORAD IDs of the new pieces
+	WindowStart  time.Time `gorm:"not null"`               // Creation time of the first new piece
+	WindowEnd    time.Time `gorm:"not null;index"`         // Creation time of the last new piece
+	DetectedAt   time.Time `gorm:"not null;index"`         // Time of the last detection
+}
+
+// MapToFragmentationEventDomain converts a FragmentationEvent database model to a domain model.
+func MapToFragmentationEventDomain(e FragmentationEvent) domain.FragmentationEvent {
+	var noradIDs []string
+	if err := json.Unmarshal([]byte(e.NoradIDsJSON), &noradIDs); err != nil {
+		noradIDs = nil
+	}
+
+	return domain.FragmentationEvent{
+		ModelBase: domain.ModelBase{
+			ID:          e.ID,
+			CreatedAt:   e.CreatedAt,
+			UpdatedAt:   &e.UpdatedAt,
+			DeleteAt:    e.DeleteAt,
+			ProcessedAt: e.ProcessedAt,
+			IsActive:    e.IsActive,
+			IsFavourite: e.IsFavourite,
+			DisplayName: e.DisplayName,
+		},
+		Launch:       e.Launch,
+		LaunchYear:   e.LaunchYear,
+		LaunchNumber: e.LaunchNumber,
+		NewPieces:    e.NewPieces,
+		NoradIDs:     noradIDs,
+		WindowStart:  e.WindowStart,
+		WindowEnd:    e.WindowEnd,
+		DetectedAt:   e.DetectedAt,
+	}
+}
+
+// MapToFragmentationEventModel converts a FragmentationEvent domain model to a database model.
+func MapToFragmentationEventModel(e domain.FragmentationEvent) FragmentationEvent {
+	noradIDsJSON, err := json.Marshal(e.NoradIDs)
+	if err != nil || e.NoradIDs == nil {
+		noradIDsJSON = []byte("[]")
+	}
+
+	return FragmentationEvent{
+		ModelBase: ModelBase{
+			ID:          e.ID,
+			CreatedAt:   e.CreatedAt,
+			UpdatedAt:   *e.UpdatedAt,
+			DeleteAt:    e.DeleteAt,
+			ProcessedAt: e.ProcessedAt,
+			IsActive:    e.IsActive,
+			IsFavourite: e.IsFavourite,
+			DisplayName: e.DisplayName,
+		},
+		Launch:       e.Launch,
+		LaunchYear:   e.LaunchYear,
+		LaunchNumber: e.LaunchNumber,
+		NewPieces:    e.NewPieces,
+		NoradIDsJSON: string(noradIDsJSON),
+		WindowStart:  e.WindowStart,
+		WindowEnd:    e.WindowEnd,
+		DetectedAt:   e.DetectedAt,
+	}
+}
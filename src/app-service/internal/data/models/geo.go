@@ -0,0 +1,120 @@
+package models
+
+import (
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+// GeoObject represents the GEO catalog entry of a satellite.
+type GeoObject struct {
+	ModelBase
+	NoradID       string    `gorm:"size:255;unique;not null"` // NORAD ID
+	Name          string    `gorm:"size:255"`                 // Satellite name
+	Longitude     float64   `gorm:"type:double precision;not null;index"`
+	DriftRate     float64   `gorm:"type:double precision;not null"` // Degrees per day
+	Inclination   float64   `gorm:"type:double precision;not null"` // Degrees
+	LongitudeSpan float64   `gorm:"type:double precision;not null"` // Longitude excursion in degrees
+	Status        string    `gorm:"size:32;not null;index"`         // STATION_KEPT or DRIFTING
+	Epoch         time.Time `gorm:"not null"`                       // Epoch of the latest observation
+}
+
+// GeoObservation represents the GEO state of a satellite at a TLE epoch.
+type GeoObservation struct {
+	ModelBase
+	NoradID     string    `gorm:"size:255;not null;uniqueIndex:unique_geo_observation"`
+	Epoch       time.Time `gorm:"not null;uniqueIndex:unique_geo_observation"`
+	Longitude   float64   `gorm:"type:double precision;not null"`
+	DriftRate   float64   `gorm:"type:double precision;not null"`
+	Inclination float64   `gorm:"type:double precision;not null"`
+}
+
+// MapToGeoObjectDomain converts a GeoObject database model to a domain model.
+func MapToGeoObjectDomain(g GeoObject) domain.GeoObject {
+	return domain.GeoObject{
+		ModelBase: domain.ModelBase{
+			ID:          g.ID,
+			CreatedAt:   g.CreatedAt,
+			UpdatedAt:   &g.UpdatedAt,
+			DeleteAt:    g.DeleteAt,
+			ProcessedAt: g.ProcessedAt,
+			IsActive:    g.IsActive,
+			IsFavourite: g.IsFavourite,
+			DisplayName: g.DisplayName,
+		},
+		NoradID:       g.NoradID,
+		Name:          g.Name,
+		Longitude:     g.Longitude,
+		DriftRate:     g.DriftRate,
+		Inclination:   g.Inclination,
+		LongitudeSpan: g.LongitudeSpan,
+		Status:        domain.GeoStatus(g.Status),
+		Epoch:         g.Epoch,
+	}
+}
+
+// MapToGeoObjectModel converts a GeoObject domain model to a database model.
+func MapToGeoObjectModel(g domain.GeoObject) GeoObject {
+	return GeoObject{
+		ModelBase: ModelBase{
+			ID:          g.ID,
+			CreatedAt:   g.CreatedAt,
+			UpdatedAt:   *g.UpdatedAt,
+			DeleteAt:    g.DeleteAt,
+			ProcessedAt: g.ProcessedAt,
+			IsActive:    g.IsActive,
+			IsFavourite: g.IsFavourite,
+			DisplayName: g.DisplayName,
+		},
+		NoradID:       g.NoradID,
+		Name:          g.Name,
+		Longitude:     g.Longitude,
+		DriftRate:     g.DriftRate,
+		Inclination:   g.Inclination,
+		LongitudeSpan: g.LongitudeSpan,
+		Status:        string(g.Status),
+		Epoch:         g.Epoch,
+	}
+}
+
+// MapToGeoObservationDomain converts a GeoObservation database model to a domain model.
+func MapToGeoObservationDomain(g GeoObservation) domain.GeoObservation {
+	return domain.GeoObservation{
+		ModelBase: domain.ModelBase{
+			ID:          g.ID,
+			CreatedAt:   g.CreatedAt,
+			UpdatedAt:   &g.UpdatedAt,
+			DeleteAt:    g.DeleteAt,
+			ProcessedAt: g.ProcessedAt,
+			IsActive:    g.IsActive,
+			IsFavourite: g.IsFavourite,
+			DisplayName: g.DisplayName,
+		},
+		NoradID:     g.NoradID,
+		Epoch:       g.Epoch,
+		Longitude:   g.Longitude,
+		DriftRate:   g.DriftRate,
+		Inclination: g.Inclination,
+	}
+}
+
+// MapToGeoObservationModel converts a GeoObservation domain model to a database model.
+func MapToGeoObservationModel(g domain.GeoObservation) GeoObservation {
+	return GeoObservation{
+		ModelBase: ModelBase{
+			ID:          g.ID,
+			CreatedAt:   g.CreatedAt,
+			UpdatedAt:   *g.UpdatedAt,
+			DeleteAt:    g.DeleteAt,
+			ProcessedAt: g.ProcessedAt,
+			IsActive:    g.IsActive,
+			IsFavourite: g.IsFavourite,
+			DisplayName: g.DisplayName,
+		},
+		NoradID:     g.NoradID,
+		Epoch:       g.Epoch,
+		Longitude:   g.Longitude,
+		DriftRate:   g.DriftRate,
+		Inclination: g.Inclination,
+	}
+}
@@ -0,0 +1,141 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+// Geofence represents a user-defined polygon of a context watched for a set of satellites.
+type Geofence struct {
+	ModelBase
+	ContextID   string `gorm:"size:255;not null;uniqueIndex:idx_geofence_context_name"` // Owning context
+	TenantID    string `gorm:"size:255;not null;index"`                                 // Tenant identifier
+	Name        string `gorm:"size:255;not null;uniqueIndex:idx_geofence_context_name"` // Geofence name, unique per context
+	PolygonJSON string `gorm:"type:json"`                                               // Serialized GeoJSON polygon
+}
+
+// GeofenceSatellite represents a satellite watched by a geofence.
+type GeofenceSatellite struct {
+	GeofenceID string `gorm:"primaryKey;size:255"` // Owning geofence
+	NoradID    string `gorm:"primaryKey;size:255"` // Watched satellite
+}
+
+// GeofenceEvent represents a predicted boundary crossing of a geofence.
+type GeofenceEvent struct {
+	ModelBase
+	GeofenceID string    `gorm:"size:255;not null;index:idx_geofence_event_time"` // Crossed geofence
+	ContextID  string    `gorm:"size:255;not null"`                               // Owning context
+	NoradID    string    `gorm:"size:255;not null;index"`                         // Crossing satellite
+	Kind       string    `gorm:"size:16;not null"`                                // ENTER or EXIT
+	OccurredAt time.Time `gorm:"not null;index:idx_geofence_event_time"`          // Time of the crossing
+	Latitude   float64   `gorm:"type:double precision;not null"`                  // Sub-point latitude
+	Longitude  float64   `gorm:"type:double precision;not null"`                  // Sub-point longitude
+}
+
+// MapToGeofenceDomain converts a Geofence and its watched satellites to a domain model.
+func MapToGeofenceDomain(g Geofence, members []GeofenceSatellite) domain.Geofence {
+	var polygon domain.GeoJSONPolygon
+	if err := json.Unmarshal([]byte(g.PolygonJSON), &polygon); err != nil {
+		polygon = domain.GeoJSONPolygon{}
+	}
+	noradIDs := make([]string, len(members))
+	for i, member := range members {
+		noradIDs[i] = member.NoradID
+	}
+
+	return domain.Geofence{
+		ModelBase: domain.ModelBase{
+			ID:          g.ID,
+			CreatedAt:   g.CreatedAt,
+			UpdatedAt:   &g.UpdatedAt,
+			DeleteAt:    g.DeleteAt,
+			ProcessedAt: g.ProcessedAt,
+			IsActive:    g.IsActive,
+			IsFavourite: g.IsFavourite,
+			DisplayName: g.DisplayName,
+		},
+		ContextID: g.ContextID,
+		TenantID:  domain.TenantID(g.TenantID),
+		Name:      g.Name,
+		Polygon:   polygon,
+		NoradIDs:  noradIDs,
+	}
+}
+
+// MapToGeofenceModel converts a Geofence domain model to database models.
+func MapToGeofenceModel(g domain.Geofence) (Geofence, []GeofenceSatellite) {
+	polygonJSON, err := json.Marshal(g.Polygon)
+	if err != nil {
+		polygonJSON = []byte("null")
+	}
+
+	members := make([]GeofenceSatellite, len(g.NoradIDs))
+	for i, noradID := range g.NoradIDs {
+		members[i] = GeofenceSatellite{GeofenceID: g.ID, NoradID: noradID}
+	}
+
+	return Geofence{
+		ModelBase: ModelBase{
+			ID:          g.ID,
+			CreatedAt:   g.CreatedAt,
+			UpdatedAt:   *g.UpdatedAt,
+			DeleteAt:    g.DeleteAt,
+			ProcessedAt: g.ProcessedAt,
+			IsActive:    g.IsActive,
+			IsFavourite: g.IsFavourite,
+			DisplayName: g.DisplayName,
+		},
+		ContextID:   g.ContextID,
+		TenantID:    string(g.TenantID),
+		Name:        g.Name,
+		PolygonJSON: string(polygonJSON),
+	}, members
+}
+
+// MapToGeofenceEventDomain converts a GeofenceEvent database model to a domain model.
+func MapToGeofenceEventDomain(e GeofenceEvent) domain.GeofenceEvent {
+	return domain.GeofenceEvent{
+		ModelBase: domain.ModelBase{
+			ID:          e.ID,
+			CreatedAt:   e.CreatedAt,
+			UpdatedAt:   &e.UpdatedAt,
+			DeleteAt:    e.DeleteAt,
+			ProcessedAt: e.ProcessedAt,
+			IsActive:    e.IsActive,
+			IsFavourite: e.IsFavourite,
+			DisplayName: e.DisplayName,
+		},
+		GeofenceID: e.GeofenceID,
+		ContextID:  e.ContextID,
+		NoradID:    e.NoradID,
+		Kind:       domain.GeofenceEventKind(e.Kind),
+		OccurredAt: e.OccurredAt,
+		Latitude:   e.Latitude,
+		Longitude:  e.Longitude,
+	}
+}
+
+// MapToGeofenceEventModel converts a GeofenceEvent domain model to a database model.
+func MapToGeofenceEventModel(e domain.GeofenceEvent) GeofenceEvent {
+	return GeofenceEvent{
+		ModelBase: ModelBase{
+			ID:          e.ID,
+			CreatedAt:   e.CreatedAt,
+			UpdatedAt:   *e.UpdatedAt,
+			DeleteAt:    e.DeleteAt,
+			ProcessedAt: e.ProcessedAt,
+			IsActive:    e.IsActive,
+			IsFavourite: e.IsFavourite,
+			DisplayName: e.DisplayName,
+		},
+		GeofenceID: e.GeofenceID,
+		ContextID:  e.ContextID,
+		NoradID:    e.NoradID,
+		Kind:       string(e.Kind),
+		OccurredAt: e.OccurredAt,
+		Latitude:   e.Latitude,
+		Longitude:  e.Longitude,
+	}
+}
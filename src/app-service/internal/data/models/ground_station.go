@@ -0,0 +1,106 @@
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+)
+
+// GroundStation represents a ground station of a tenant within a context.
+type GroundStation struct {
+	ModelBase
+	ContextID       string   `gorm:"size:255;not null;uniqueIndex:idx_ground_station_context_name"` // Owning context
+	TenantID        string   `gorm:"size:255;not null;index"`                                       // Tenant identifier
+	Name            string   `gorm:"size:255;not null;uniqueIndex:idx_ground_station_context_name"` // Station name, unique per context
+	Latitude        float64  `gorm:"type:double precision;not null"`                                // Latitude in degrees
+	Longitude       float64  `gorm:"type:double precision;not null"`                                // Longitude in degrees
+	Altitude        float64  `gorm:"type:double precision;not null"`                                // Altitude in meters
+	MinElevation    float64  `gorm:"type:double precision;not null"`                                // Minimum elevation in degrees
+	HorizonMaskJSON string   `gorm:"type:json"`                                                     // Serialized azimuth/elevation terrain mask
+	BandsJSON       string   `gorm:"type:json"`                                                     // Serialized list of supported bands
+	PressureMbar    *float64 `gorm:"type:double precision"`                                         // Refraction pressure in millibars, null without refraction
+	TemperatureC    *float64 `gorm:"type:double precision"`                                         // Refraction temperature in degrees Celsius
+}
+
+// MapToGroundStationDomain converts a GroundStation database model to a domain model.
+func MapToGroundStationDomain(g GroundStation) domain.GroundStation {
+	var mask xspace.HorizonMask
+	if err := json.Unmarshal([]byte(g.HorizonMaskJSON), &mask); err != nil {
+		mask = nil // Default to a flat horizon if deserialization fails
+	}
+	var bands []domain.Band
+	if err := json.Unmarshal([]byte(g.BandsJSON), &bands); err != nil {
+		bands = nil
+	}
+
+	var refraction *xspace.Atmosphere
+	if g.PressureMbar != nil && g.TemperatureC != nil {
+		refraction = &xspace.Atmosphere{PressureMbar: *g.PressureMbar, TemperatureC: *g.TemperatureC}
+	}
+
+	return domain.GroundStation{
+		ModelBase: domain.ModelBase{
+			ID:          g.ID,
+			CreatedAt:   g.CreatedAt,
+			UpdatedAt:   &g.UpdatedAt,
+			DeleteAt:    g.DeleteAt,
+			ProcessedAt: g.ProcessedAt,
+			IsActive:    g.IsActive,
+			IsFavourite: g.IsFavourite,
+			DisplayName: g.DisplayName,
+		},
+		ContextID:    g.ContextID,
+		TenantID:     domain.TenantID(g.TenantID),
+		Name:         g.Name,
+		Latitude:     g.Latitude,
+		Longitude:    g.Longitude,
+		Altitude:     g.Altitude,
+		MinElevation: g.MinElevation,
+		HorizonMask:  mask,
+		Bands:        bands,
+		Refraction:   refraction,
+	}
+}
+
+// MapToGroundStationModel converts a GroundStation domain model to a database model.
+func MapToGroundStationModel(g domain.GroundStation) GroundStation {
+	maskJSON, err := json.Marshal(g.HorizonMask)
+	if err != nil || g.HorizonMask == nil {
+		maskJSON = []byte("[]") // Default to empty array on failure
+	}
+	bandsJSON, err := json.Marshal(g.Bands)
+	if err != nil || g.Bands == nil {
+		bandsJSON = []byte("[]")
+	}
+
+	var pressure, temperature *float64
+	if g.Refraction != nil {
+		pressure = &g.Refraction.PressureMbar
+		temperature = &g.Refraction.TemperatureC
+	}
+
+	return GroundStation{
+		ModelBase: ModelBase{
+			ID:          g.ID,
+			CreatedAt:   g.CreatedAt,
+			UpdatedAt:   *g.UpdatedAt,
+			DeleteAt:    g.DeleteAt,
+			ProcessedAt: g.ProcessedAt,
+			IsActive:    g.IsActive,
+			IsFavourite: g.IsFavourite,
+			DisplayName: g.DisplayName,
+		},
+		ContextID:       g.ContextID,
+		TenantID:        string(g.TenantID),
+		Name:            g.Name,
+		Latitude:        g.Latitude,
+		Longitude:       g.Longitude,
+		Altitude:        g.Altitude,
+		MinElevation:    g.MinElevation,
+		HorizonMaskJSON: string(maskJSON),
+		BandsJSON:       string(bandsJSON),
+		PressureMbar:    pressure,
+		TemperatureC:    temperature,
+	}
+}
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+// LinkWindow represents a stored inter-satellite visibility window.
+type LinkWindow struct {
+	ModelBase
+	ContextID  string    `gorm:"size:255;not null;index"`                   // Owning context
+	NoradIDA   string    `gorm:"column:norad_id_a;size:255;not null;index"` // Lowest NORAD ID of the pair
+	NoradIDB   string    `gorm:"column:norad_id_b;size:255;not null;index"` // Highest NORAD ID of the pair
+	Start      time.Time `gorm:"column:window_start;not null;index"`        // Start of the window
+	End        time.Time `gorm:"column:window_end;not null;index"`          // End of the window
+	MinRangeKm float64   `gorm:"type:double precision;not null"`            // Shortest distance in kilometers
+	MaxRangeKm float64   `gorm:"type:double precision;not null"`            // Longest distance in kilometers
+}
+
+// MapToLinkWindowDomain converts a LinkWindow database model to a domain model.
+func MapToLinkWindowDomain(l LinkWindow) domain.LinkWindow {
+	return domain.LinkWindow{
+		ModelBase: domain.ModelBase{
+			ID:          l.ID,
+			CreatedAt:   l.CreatedAt,
+			UpdatedAt:   &l.UpdatedAt,
+			DeleteAt:    l.DeleteAt,
+			ProcessedAt: l.ProcessedAt,
+			IsActive:    l.IsActive,
+			IsFavourite: l.IsFavourite,
+			DisplayName: l.DisplayName,
+		},
+		ContextID:  l.ContextID,
+		NoradIDA:   l.NoradIDA,
+		NoradIDB:   l.NoradIDB,
+		Start:      l.Start,
+		End:        l.End,
+		MinRangeKm: l.MinRangeKm,
+		MaxRangeKm: l.MaxRangeKm,
+	}
+}
+
+// MapToLinkWindowModel converts a LinkWindow domain model to a database model.
+func MapToLinkWindowModel(l domain.LinkWindow) LinkWindow {
+	return LinkWindow{
+		ModelBase: ModelBase{
+			ID:          l.ID,
+			CreatedAt:   l.CreatedAt,
+			UpdatedAt:   *l.UpdatedAt,
+			DeleteAt:    l.DeleteAt,
+			ProcessedAt: l.ProcessedAt,
+			IsActive:    l.IsActive,
+			IsFavourite: l.IsFavourite,
+			DisplayName: l.DisplayName,
+		},
+		ContextID:  l.ContextID,
+		NoradIDA:   l.NoradIDA,
+		NoradIDB:   l.NoradIDB,
+		Start:      l.Start,
+		End:        l.End,
+		MinRangeKm: l.MinRangeKm,
+		MaxRangeKm: l.MaxRangeKm,
+	}
+}
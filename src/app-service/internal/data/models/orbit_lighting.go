@@ -0,0 +1,71 @@
+package models
+
+import (
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+// OrbitLightingSample represents a stored sample of the orbit lighting time series.
+type OrbitLightingSample struct {
+	ModelBase
+	NoradID         string    `gorm:"size:255;not null;uniqueIndex:idx_orbit_lighting_norad_time"` // NORAD ID
+	SampledAt       time.Time `gorm:"not null;uniqueIndex:idx_orbit_lighting_norad_time"`          // Time of the sample
+	TleEpoch        time.Time `gorm:"not null"`                                                    // Epoch of the TLE used for the sample
+	RAAN            float64   `gorm:"column:raan;type:double precision;not null"`                  // Right ascension of the ascending node in degrees
+	LTAN            float64   `gorm:"column:ltan;type:double precision;not null"`                  // Local time of the ascending node in hours
+	LTDN            float64   `gorm:"column:ltdn;type:double precision;not null"`                  // Local time of the descending node in hours
+	BetaAngle       float64   `gorm:"type:double precision;not null"`                              // Beta angle in degrees
+	EclipseFraction float64   `gorm:"type:double precision;not null"`                              // Fraction of the orbit in eclipse
+	EclipseMinutes  float64   `gorm:"type:double precision;not null"`                              // Eclipse duration per orbit in minutes
+}
+
+// MapToOrbitLightingSampleDomain converts an OrbitLightingSample database model to a domain model.
+func MapToOrbitLightingSampleDomain(s OrbitLightingSample) domain.OrbitLightingSample {
+	return domain.OrbitLightingSample{
+		ModelBase: domain.ModelBase{
+			ID:          s.ID,
+			CreatedAt:   s.CreatedAt,
+			UpdatedAt:   &s.UpdatedAt,
+			DeleteAt:    s.DeleteAt,
+			ProcessedAt: s.ProcessedAt,
+			IsActive:    s.IsActive,
+			IsFavourite: s.IsFavourite,
+			DisplayName: s.DisplayName,
+		},
+		NoradID:         s.NoradID,
+		SampledAt:       s.SampledAt,
+		TleEpoch:        s.TleEpoch,
+		RAAN:            s.RAAN,
+		LTAN:            s.LTAN,
+		LTDN:            s.LTDN,
+		BetaAngle:       s.BetaAngle,
+		EclipseFraction: s.EclipseFraction,
+		EclipseMinutes:  s.EclipseMinutes,
+	}
+}
+
+// MapToOrbitLightingSampleModel converts an OrbitLightingSample domain model to a database model.
+func MapToOrbitLightingSampleModel(s domain.OrbitLightingSample) OrbitLightingSample {
+	return OrbitLightingSample{
+		ModelBase: ModelBase{
+			ID:          s.ID,
+			CreatedAt:   s.CreatedAt,
+			UpdatedAt:   *s.UpdatedAt,
+			DeleteAt:    s.DeleteAt,
+			ProcessedAt: s.ProcessedAt,
+			IsActive:    s.IsActive,
+			IsFavourite: s.IsFavourite,
+			DisplayName: s.DisplayName,
+		},
+		NoradID:         s.NoradID,
+		SampledAt:       s.SampledAt,
+		TleEpoch:        s.TleEpoch,
+		RAAN:            s.RAAN,
+		LTAN:            s.LTAN,
+		LTDN:            s.LTDN,
+		BetaAngle:       s.BetaAngle,
+		EclipseFraction: s.EclipseFraction,
+		EclipseMinutes:  s.EclipseMinutes,
+	}
+}
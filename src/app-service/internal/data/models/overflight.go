@@ -0,0 +1,197 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+// AdminArea represents an administrative area imported from an offline boundary dataset.
+type AdminArea struct {
+	ModelBase
+	Code         string  `gorm:"size:64;not null;uniqueIndex"`   // Unique code, ISO 3166 when available
+	Name         string  `gorm:"size:255;not null"`              // Area name
+	Level        int     `gorm:"not null;index"`                 // 0 for countries, 1 for regions
+	ParentCode   string  `gorm:"size:64;index"`                  // Code of the country of a region
+	PolygonsJSON string  `gorm:"type:json"`                      // Serialized GeoJSON polygons
+	MinLat       float64 `gorm:"type:double precision;not null"` // Bounding box
+	MinLon       float64 `gorm:"type:double precision;not null"`
+	MaxLat       float64 `gorm:"type:double precision;not null"`
+	MaxLon       float64 `gorm:"type:double precision;not null"`
+}
+
+// OverflightReport represents a stored overflight report of a context.
+type OverflightReport struct {
+	ModelBase
+	ContextID     string    `gorm:"size:255;not null;index"` // Owning context
+	TenantID      string    `gorm:"size:255;not null;index"` // Tenant identifier
+	AreaCodesJSON string    `gorm:"type:json"`               // Serialized codes of the reported areas
+	WindowStart   time.Time `gorm:"not null"`                // Start of the report window
+	WindowEnd     time.Time `gorm:"not null"`                // End of the report window
+	StepSeconds   int       `gorm:"not null"`                // Ground track sampling step
+}
+
+// OverflightRecord represents an overflight of an admin area by a satellite within a report.
+type OverflightRecord struct {
+	ModelBase
+	ReportID        string    `gorm:"size:255;not null;index"`        // Owning report
+	NoradID         string    `gorm:"size:255;not null"`              // Overflying satellite
+	SatelliteName   string    `gorm:"size:255"`                       // Satellite name at report time
+	AreaCode        string    `gorm:"size:64;not null"`               // Overflown area
+	AreaName        string    `gorm:"size:255;not null"`              // Area name at report time
+	EntryAt         time.Time `gorm:"not null"`                       // First sample inside the area
+	ExitAt          time.Time `gorm:"not null"`                       // First sample outside the area
+	DurationSeconds float64   `gorm:"type:double precision;not null"` // Overflight duration
+	Truncated       bool      `gorm:"not null;default:false"`         // Cut by the report window
+}
+
+// MapToAdminAreaDomain converts an AdminArea database model to a domain model.
+func MapToAdminAreaDomain(a AdminArea) domain.AdminArea {
+	var polygons []domain.GeoJSONPolygon
+	if err := json.Unmarshal([]byte(a.PolygonsJSON), &polygons); err != nil {
+		polygons = nil
+	}
+
+	return domain.AdminArea{
+		ModelBase: domain.ModelBase{
+			ID:          a.ID,
+			CreatedAt:   a.CreatedAt,
+			UpdatedAt:   &a.UpdatedAt,
+			DeleteAt:    a.DeleteAt,
+			ProcessedAt: a.ProcessedAt,
+			IsActive:    a.IsActive,
+			IsFavourite: a.IsFavourite,
+			DisplayName: a.DisplayName,
+		},
+		Code:       a.Code,
+		Name:       a.Name,
+		Level:      a.Level,
+		ParentCode: a.ParentCode,
+		Polygons:   polygons,
+		MinLat:     a.MinLat,
+		MinLon:     a.MinLon,
+		MaxLat:     a.MaxLat,
+		MaxLon:     a.MaxLon,
+	}
+}
+
+// MapToAdminAreaModel converts an AdminArea domain model to a database model.
+func MapToAdminAreaModel(a domain.AdminArea) AdminArea {
+	polygonsJSON, err := json.Marshal(a.Polygons)
+	if err != nil || a.Polygons == nil {
+		polygonsJSON = []byte("[]")
+	}
+
+	return AdminArea{
+		ModelBase: ModelBase{
+			ID:          a.ID,
+			CreatedAt:   a.CreatedAt,
+			UpdatedAt:   *a.UpdatedAt,
+			DeleteAt:    a.DeleteAt,
+			ProcessedAt: a.ProcessedAt,
+			IsActive:    a.IsActive,
+			IsFavourite: a.IsFavourite,
+			DisplayName: a.DisplayName,
+		},
+		Code:         a.Code,
+		Name:         a.Name,
+		Level:        a.Level,
+		ParentCode:   a.ParentCode,
+		PolygonsJSON: string(polygonsJSON),
+		MinLat:       a.MinLat,
+		MinLon:       a.MinLon,
+		MaxLat:       a.MaxLat,
+		MaxLon:       a.MaxLon,
+	}
+}
+
+// MapToOverflightReportDomain converts an OverflightReport and its records to a domain model.
+func MapToOverflightReportDomain(r OverflightReport, records []OverflightRecord) domain.OverflightReport {
+	var codes []string
+	if err := json.Unmarshal([]byte(r.AreaCodesJSON), &codes); err != nil {
+		codes = nil
+	}
+	domainRecords := make([]domain.OverflightRecord, len(records))
+	for i, record := range records {
+		domainRecords[i] = domain.OverflightRecord{
+			NoradID:         record.NoradID,
+			SatelliteName:   record.SatelliteName,
+			AreaCode:        record.AreaCode,
+			AreaName:        record.AreaName,
+			EntryAt:         record.EntryAt,
+			ExitAt:          record.ExitAt,
+			DurationSeconds: record.DurationSeconds,
+			Truncated:       record.Truncated,
+		}
+	}
+
+	return domain.OverflightReport{
+		ModelBase: domain.ModelBase{
+			ID:          r.ID,
+			CreatedAt:   r.CreatedAt,
+			UpdatedAt:   &r.UpdatedAt,
+			DeleteAt:    r.DeleteAt,
+			ProcessedAt: r.ProcessedAt,
+			IsActive:    r.IsActive,
+			IsFavourite: r.IsFavourite,
+			DisplayName: r.DisplayName,
+		},
+		ContextID:   r.ContextID,
+		TenantID:    domain.TenantID(r.TenantID),
+		AreaCodes:   codes,
+		WindowStart: r.WindowStart,
+		WindowEnd:   r.WindowEnd,
+		StepSeconds: r.StepSeconds,
+		Records:     domainRecords,
+	}
+}
+
+// MapToOverflightReportModel converts an OverflightReport domain model to database models.
+func MapToOverflightReportModel(r domain.OverflightReport) (OverflightReport, []OverflightRecord) {
+	codesJSON, err := json.Marshal(r.AreaCodes)
+	if err != nil || r.AreaCodes == nil {
+		codesJSON = []byte("[]")
+	}
+
+	records := make([]OverflightRecord, len(r.Records))
+	for i, record := range r.Records {
+		records[i] = OverflightRecord{
+			ModelBase: ModelBase{
+				CreatedAt:   r.CreatedAt,
+				UpdatedAt:   *r.UpdatedAt,
+				ProcessedAt: r.ProcessedAt,
+				IsActive:    true,
+				DisplayName: record.NoradID + " " + record.AreaCode,
+			},
+			ReportID:        r.ID,
+			NoradID:         record.NoradID,
+			SatelliteName:   record.SatelliteName,
+			AreaCode:        record.AreaCode,
+			AreaName:        record.AreaName,
+			EntryAt:         record.EntryAt,
+			ExitAt:          record.ExitAt,
+			DurationSeconds: record.DurationSeconds,
+			Truncated:       record.Truncated,
+		}
+	}
+
+	return OverflightReport{
+		ModelBase: ModelBase{
+			ID:          r.ID,
+			CreatedAt:   r.CreatedAt,
+			UpdatedAt:   *r.UpdatedAt,
+			DeleteAt:    r.DeleteAt,
+			ProcessedAt: r.ProcessedAt,
+			IsActive:    r.IsActive,
+			IsFavourite: r.IsFavourite,
+			DisplayName: r.DisplayName,
+		},
+		ContextID:     r.ContextID,
+		TenantID:      string(r.TenantID),
+		AreaCodesJSON: string(codesJSON),
+		WindowStart:   r.WindowStart,
+		WindowEnd:     r.WindowEnd,
+		StepSeconds:   r.StepSeconds,
+	}, records
+}
@@ -9,20 +9,29 @@ import (
 // Satellite represents a satellite database model.
 type Satellite struct {
 	ModelBase
-	Name           string     `gorm:"size:255;not null"`        // Satellite name
-	NoradID        string     `gorm:"size:255;unique;not null"` // NORAD ID
-	Type           string     `gorm:"size:255"`                 // Satellite type (e.g., telescope, communication)
-	LaunchDate     *time.Time `gorm:"type:date"`                // Launch date
-	DecayDate      *time.Time `gorm:"type:date"`                // Decay date (optional)
-	IntlDesignator string     `gorm:"size:255"`                 // International designator
-	Owner          string     `gorm:"size:255"`                 // Ownership information
-	ObjectType     string     `gorm:"size:255"`                 // Object type (e.g., "PAYLOAD")
-	Period         *float64   `gorm:"type:float"`               // Orbital period in minutes (optional)
-	Inclination    *float64   `gorm:"type:float"`               // Orbital inclination in degrees (optional)
-	Apogee         *float64   `gorm:"type:float"`               // Apogee altitude in kilometers (optional)
-	Perigee        *float64   `gorm:"type:float"`               // Perigee altitude in kilometers (optional)
-	RCS            *float64   `gorm:"type:float"`               // Radar cross-section in square meters (optional)
-	Altitude       *float64   `gorm:"type:float"`               // Altitude in kilometers (optional)
+	Name           string     `gorm:"size:255;not null"`            // Satellite name
+	NoradID        string     `gorm:"size:255;unique;not null"`     // NORAD ID
+	Type           string     `gorm:"size:255"`                     // Satellite type (e.g., telescope, communication)
+	LaunchDate     *time.Time `gorm:"type:date"`                    // Launch date
+	DecayDate      *time.Time `gorm:"type:date"`                    // Decay date (optional)
+	IntlDesignator string     `gorm:"size:255"`                     // International designator
+	Owner          string     `gorm:"size:255"`                     // Ownership information
+	ObjectType     string     `gorm:"size:255"`                     // Object type (e.g., "PAYLOAD")
+	Period         *float64   `gorm:"type:float"`                   // Orbital period in minutes (optional)
+	Inclination    *float64   `gorm:"type:float"`                   // Orbital inclination in degrees (optional)
+	Apogee         *float64   `gorm:"type:float"`                   // Apogee altitude in kilometers (optional)
+	Perigee        *float64   `gorm:"type:float"`                   // Perigee altitude in kilometers (optional)
+	RCS            *float64   `gorm:"type:float"`                   // Radar cross-section in square meters (optional)
+	Altitude       *float64   `gorm:"type:float"`                   // Altitude in kilometers (optional)
+	OrbitRegime    string     `gorm:"size:32;index"`                // Orbit regime (e.g., "LEO", "GEO")
+	Synthetic      bool       `gorm:"not null;default:false;index"` // User-defined satellite with a generated TLE
+	OpsStatus      string     `gorm:"size:8"`                       // SATCAT operational status code
+	LaunchSite     string     `gorm:"size:16"`                      // SATCAT launch site code
+	OrbitCenter    string     `gorm:"size:16"`                      // SATCAT orbit center
+	OrbitType      string     `gorm:"size:8"`                       // SATCAT orbit type
+	LaunchYear     int        `gorm:"index:idx_satellite_launch"`   // Launch year from the international designator
+	LaunchNumber   int        `gorm:"index:idx_satellite_launch"`   // Launch number from the international designator
+	LaunchPiece    string     `gorm:"size:8"`                       // Launch piece from the international designator
 }
 
 // MapToDomain converts a Satellite database model to a Satellite domain model.
@@ -52,6 +61,15 @@ func MapToSatelliteDomain(s Satellite) domain.Satellite {
 		Perigee:        s.Perigee,
 		RCS:            s.RCS,
 		Altitude:       s.Altitude,
+		OrbitRegime:    domain.OrbitRegime(s.OrbitRegime),
+		Synthetic:      s.Synthetic,
+		OpsStatus:      s.OpsStatus,
+		LaunchSite:     s.LaunchSite,
+		OrbitCenter:    s.OrbitCenter,
+		OrbitType:      s.OrbitType,
+		LaunchYear:     s.LaunchYear,
+		LaunchNumber:   s.LaunchNumber,
+		LaunchPiece:    s.LaunchPiece,
 	}
 }
 
@@ -82,5 +100,14 @@ func MapToSatelliteModel(d domain.Satellite) Satellite {
 		Perigee:        d.Perigee,
 		RCS:            d.RCS,
 		Altitude:       d.Altitude,
+		OrbitRegime:    string(d.OrbitRegime),
+		Synthetic:      d.Synthetic,
+		OpsStatus:      d.OpsStatus,
+		LaunchSite:     d.LaunchSite,
+		OrbitCenter:    d.OrbitCenter,
+		OrbitType:      d.OrbitType,
+		LaunchYear:     d.LaunchYear,
+		LaunchNumber:   d.LaunchNumber,
+		LaunchPiece:    d.LaunchPiece,
 	}
 }
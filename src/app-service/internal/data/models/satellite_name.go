@@ -0,0 +1,56 @@
+package models
+
+import (
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+// SatelliteNameChange represents a change of the name of a satellite.
+type SatelliteNameChange struct {
+	ModelBase
+	NoradID   string    `gorm:"size:255;not null;index"`
+	OldName   string    `gorm:"size:255"`
+	NewName   string    `gorm:"size:255;not null"`
+	ChangedAt time.Time `gorm:"not null"`
+}
+
+// MapToSatelliteNameChangeDomain converts a SatelliteNameChange database model to a domain model.
+func MapToSatelliteNameChangeDomain(c SatelliteNameChange) domain.SatelliteNameChange {
+	return domain.SatelliteNameChange{
+		ModelBase: domain.ModelBase{
+			ID:          c.ID,
+			CreatedAt:   c.CreatedAt,
+			UpdatedAt:   &c.UpdatedAt,
+			DeleteAt:    c.DeleteAt,
+			ProcessedAt: c.ProcessedAt,
+			IsActive:    c.IsActive,
+			IsFavourite: c.IsFavourite,
+			DisplayName: c.DisplayName,
+		},
+		NoradID:   c.NoradID,
+		OldName:   c.OldName,
+		NewName:   c.NewName,
+		ChangedAt: c.ChangedAt,
+	}
+}
+
+// MapToSatelliteNameChangeModel converts a SatelliteNameChange domain model to a database model.
+func MapToSatelliteNameChangeModel(c domain.SatelliteNameChange) SatelliteNameChange {
+	return SatelliteNameChange{
+		ModelBase: ModelBase{
+			ID:          c.ID,
+			CreatedAt:   c.CreatedAt,
+			UpdatedAt:   *c.UpdatedAt,
+			DeleteAt:    c.DeleteAt,
+			ProcessedAt: c.ProcessedAt,
+			IsActive:    c.IsActive,
+			IsFavourite: c.IsFavourite,
+			DisplayName: c.DisplayName,
+		},
+		NoradID:   c.NoradID,
+		OldName:   c.OldName,
+		NewName:   c.NewName,
+		ChangedAt: c.ChangedAt,
+	}
+}
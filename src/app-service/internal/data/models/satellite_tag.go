@@ -0,0 +1,57 @@
+package models
+
+import (
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+// SatelliteTag represents a named group of satellites.
+type SatelliteTag struct {
+	ModelBase
+	Name        string `gorm:"size:255;unique;not null"`
+	Description string `gorm:"size:1024"`
+}
+
+// SatelliteTagAssignment defines the many-to-many relationship between SatelliteTag and Satellite.
+type SatelliteTagAssignment struct {
+	SatelliteID string       `gorm:"not null;uniqueIndex:idx_satellite_tag"`       // Foreign key to Satellite
+	TagID       string       `gorm:"not null;uniqueIndex:idx_satellite_tag;index"` // Foreign key to SatelliteTag
+	Origin      string       `gorm:"size:32;not null"`                             // How the satellite got the tag
+	Satellite   Satellite    `gorm:"constraint:OnDelete:CASCADE;foreignKey:SatelliteID;references:ID"`
+	Tag         SatelliteTag `gorm:"constraint:OnDelete:CASCADE;foreignKey:TagID;references:ID"`
+}
+
+// MapToSatelliteTagDomain converts a SatelliteTag database model to a domain model.
+func MapToSatelliteTagDomain(t SatelliteTag) domain.SatelliteTag {
+	return domain.SatelliteTag{
+		ModelBase: domain.ModelBase{
+			ID:          t.ID,
+			CreatedAt:   t.CreatedAt,
+			UpdatedAt:   &t.UpdatedAt,
+			DeleteAt:    t.DeleteAt,
+			ProcessedAt: t.ProcessedAt,
+			IsActive:    t.IsActive,
+			IsFavourite: t.IsFavourite,
+			DisplayName: t.DisplayName,
+		},
+		Name:        t.Name,
+		Description: t.Description,
+	}
+}
+
+// MapToSatelliteTagModel converts a SatelliteTag domain model to a database model.
+func MapToSatelliteTagModel(t domain.SatelliteTag) SatelliteTag {
+	return SatelliteTag{
+		ModelBase: ModelBase{
+			ID:          t.ID,
+			CreatedAt:   t.CreatedAt,
+			UpdatedAt:   *t.UpdatedAt,
+			DeleteAt:    t.DeleteAt,
+			ProcessedAt: t.ProcessedAt,
+			IsActive:    t.IsActive,
+			IsFavourite: t.IsFavourite,
+			DisplayName: t.DisplayName,
+		},
+		Name:        t.Name,
+		Description: t.Description,
+	}
+}
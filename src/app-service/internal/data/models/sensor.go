@@ -0,0 +1,60 @@
+package models
+
+import (
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+)
+
+// SatelliteSensor represents the observation payload of a satellite.
+type SatelliteSensor struct {
+	ModelBase
+	NoradID      string  `gorm:"size:255;unique;not null"`       // NORAD ID
+	Type         string  `gorm:"size:32;not null"`               // Sensor type (e.g., "SWATH", "CONICAL")
+	SwathWidthKm float64 `gorm:"type:double precision"`          // Cross-track swath width in kilometers
+	HalfAngle    float64 `gorm:"type:double precision"`          // Half cone angle in degrees
+	MaxOffNadir  float64 `gorm:"type:double precision;not null"` // Maximum off-nadir pointing angle in degrees
+}
+
+// MapToSatelliteSensorDomain converts a SatelliteSensor database model to a domain model.
+func MapToSatelliteSensorDomain(s SatelliteSensor) domain.SatelliteSensor {
+	return domain.SatelliteSensor{
+		ModelBase: domain.ModelBase{
+			ID:          s.ID,
+			CreatedAt:   s.CreatedAt,
+			UpdatedAt:   &s.UpdatedAt,
+			DeleteAt:    s.DeleteAt,
+			ProcessedAt: s.ProcessedAt,
+			IsActive:    s.IsActive,
+			IsFavourite: s.IsFavourite,
+			DisplayName: s.DisplayName,
+		},
+		NoradID: s.NoradID,
+		Sensor: xspace.Sensor{
+			Type:         xspace.SensorType(s.Type),
+			SwathWidthKm: s.SwathWidthKm,
+			HalfAngle:    s.HalfAngle,
+			MaxOffNadir:  s.MaxOffNadir,
+		},
+	}
+}
+
+// MapToSatelliteSensorModel converts a SatelliteSensor domain model to a database model.
+func MapToSatelliteSensorModel(s domain.SatelliteSensor) SatelliteSensor {
+	return SatelliteSensor{
+		ModelBase: ModelBase{
+			ID:          s.ID,
+			CreatedAt:   s.CreatedAt,
+			UpdatedAt:   *s.UpdatedAt,
+			DeleteAt:    s.DeleteAt,
+			ProcessedAt: s.ProcessedAt,
+			IsActive:    s.IsActive,
+			IsFavourite: s.IsFavourite,
+			DisplayName: s.DisplayName,
+		},
+		NoradID:      s.NoradID,
+		Type:         string(s.Sensor.Type),
+		SwathWidthKm: s.Sensor.SwathWidthKm,
+		HalfAngle:    s.Sensor.HalfAngle,
+		MaxOffNadir:  s.Sensor.MaxOffNadir,
+	}
+}
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+// SyncState represents the cursor of an incremental synchronization with an external catalog.
+type SyncState struct {
+	ModelBase
+	Source       string    `gorm:"size:64;not null;uniqueIndex:idx_sync_state_source_scope"`  // External catalog
+	Scope        string    `gorm:"size:255;not null;uniqueIndex:idx_sync_state_source_scope"` // Stream within the source
+	Cursor       string    `gorm:"size:255"`                                                  // Position of the last synchronized record
+	SyncedAt     time.Time `gorm:"not null"`                                                  // Time of the last successful synchronization
+	ETag         string    `gorm:"size:255"`                                                  // HTTP validators of the last response
+	LastModified string    `gorm:"size:64"`
+	PayloadHash  string    `gorm:"size:64"` // SHA-256 of the last payload
+}
+
+// MapToSyncStateDomain converts a SyncState database model to a domain model.
+func MapToSyncStateDomain(s SyncState) domain.SyncState {
+	return domain.SyncState{
+		ModelBase: domain.ModelBase{
+			ID:          s.ID,
+			CreatedAt:   s.CreatedAt,
+			UpdatedAt:   &s.UpdatedAt,
+			DeleteAt:    s.DeleteAt,
+			ProcessedAt: s.ProcessedAt,
+			IsActive:    s.IsActive,
+			IsFavourite: s.IsFavourite,
+			DisplayName: s.DisplayName,
+		},
+		Source:       s.Source,
+		Scope:        s.Scope,
+		Cursor:       s.Cursor,
+		SyncedAt:     s.SyncedAt,
+		ETag:         s.ETag,
+		LastModified: s.LastModified,
+		PayloadHash:  s.PayloadHash,
+	}
+}
+
+// MapToSyncStateModel converts a SyncState domain model to a database model.
+func MapToSyncStateModel(s domain.SyncState) SyncState {
+	return SyncState{
+		ModelBase: ModelBase{
+			ID:          s.ID,
+			CreatedAt:   s.CreatedAt,
+			UpdatedAt:   *s.UpdatedAt,
+			DeleteAt:    s.DeleteAt,
+			ProcessedAt: s.ProcessedAt,
+			IsActive:    s.IsActive,
+			IsFavourite: s.IsFavourite,
+			DisplayName: s.DisplayName,
+		},
+		Source:       s.Source,
+		Scope:        s.Scope,
+		Cursor:       s.Cursor,
+		SyncedAt:     s.SyncedAt,
+		ETag:         s.ETag,
+		LastModified: s.LastModified,
+		PayloadHash:  s.PayloadHash,
+	}
+}
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+// TileCoverageStat represents the materialized revisit statistics of a tile within a context.
+type TileCoverageStat struct {
+	ModelBase
+	ContextID          string    `gorm:"type:char(36);not null;uniqueIndex:idx_tile_coverage_context_tile"` // Foreign key to GameContext table
+	TileID             string    `gorm:"type:char(36);not null;uniqueIndex:idx_tile_coverage_context_tile"` // Foreign key to Tile table
+	Quadkey            string    `gorm:"size:256;not null"`                                                 // Quadkey of the tile
+	ZoomLevel          int       `gorm:"not null"`                                                          // Zoom level of the tile
+	CenterLat          float64   `gorm:"type:double precision;not null"`                                    // Latitude of the tile center
+	CenterLon          float64   `gorm:"type:double precision;not null"`                                    // Longitude of the tile center
+	WindowStart        time.Time `gorm:"not null"`                                                          // Start of the analysed window
+	WindowEnd          time.Time `gorm:"not null"`                                                          // End of the analysed window
+	Passes             int       `gorm:"not null"`                                                          // Number of satellite passes
+	DistinctSatellites int       `gorm:"not null"`                                                          // Number of distinct satellites
+	MeanRevisitSeconds float64   `gorm:"type:double precision;not null"`                                    // Mean interval between passes
+	MaxGapSeconds      float64   `gorm:"type:double precision;not null"`                                    // Longest interval without pass
+	DwellSeconds       float64   `gorm:"type:double precision;not null"`                                    // Estimated total dwell time
+}
+
+// MapToTileCoverageStatDomain converts a TileCoverageStat database model to a domain model.
+func MapToTileCoverageStatDomain(s TileCoverageStat) domain.TileCoverageStats {
+	return domain.TileCoverageStats{
+		ModelBase: domain.ModelBase{
+			ID:          s.ID,
+			CreatedAt:   s.CreatedAt,
+			UpdatedAt:   &s.UpdatedAt,
+			DeleteAt:    s.DeleteAt,
+			ProcessedAt: s.ProcessedAt,
+			IsActive:    s.IsActive,
+			IsFavourite: s.IsFavourite,
+			DisplayName: s.DisplayName,
+		},
+		ContextID:          s.ContextID,
+		TileID:             s.TileID,
+		Quadkey:            s.Quadkey,
+		ZoomLevel:          s.ZoomLevel,
+		CenterLat:          s.CenterLat,
+		CenterLon:          s.CenterLon,
+		WindowStart:        s.WindowStart,
+		WindowEnd:          s.WindowEnd,
+		Passes:             s.Passes,
+		DistinctSatellites: s.DistinctSatellites,
+		MeanRevisitSeconds: s.MeanRevisitSeconds,
+		MaxGapSeconds:      s.MaxGapSeconds,
+		DwellSeconds:       s.DwellSeconds,
+	}
+}
+
+// MapToTileCoverageStatModel converts a TileCoverageStats domain model to a database model.
+func MapToTileCoverageStatModel(s domain.TileCoverageStats) TileCoverageStat {
+	return TileCoverageStat{
+		ModelBase: ModelBase{
+			ID:          s.ID,
+			CreatedAt:   s.CreatedAt,
+			UpdatedAt:   *s.UpdatedAt,
+			DeleteAt:    s.DeleteAt,
+			ProcessedAt: s.ProcessedAt,
+			IsActive:    s.IsActive,
+			IsFavourite: s.IsFavourite,
+			DisplayName: s.DisplayName,
+		},
+		ContextID:          s.ContextID,
+		TileID:             s.TileID,
+		Quadkey:            s.Quadkey,
+		ZoomLevel:          s.ZoomLevel,
+		CenterLat:          s.CenterLat,
+		CenterLon:          s.CenterLon,
+		WindowStart:        s.WindowStart,
+		WindowEnd:          s.WindowEnd,
+		Passes:             s.Passes,
+		DistinctSatellites: s.DistinctSatellites,
+		MeanRevisitSeconds: s.MeanRevisitSeconds,
+		MaxGapSeconds:      s.MaxGapSeconds,
+		DwellSeconds:       s.DwellSeconds,
+	}
+}
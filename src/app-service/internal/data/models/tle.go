@@ -10,13 +10,24 @@ import (
 type TLE struct {
 	ModelBase
 	NoradID string    `gorm:"size:255;not null;index"` // Foreign key to Satellite table via Norad ID
+	Name    string    `gorm:"size:255"`                // Object name from line 0 of a 3LE
 	Line1   string    `gorm:"size:255;not null"`
 	Line2   string    `gorm:"size:255;not null"`
 	Epoch   time.Time `gorm:"not null"` // Time associated with the TLE
+
+	Source      string     `gorm:"size:255;index"` // Element set source the TLE was fetched from
+	FetchedAt   *time.Time // Time the TLE was fetched from its source
+	PayloadHash string     `gorm:"size:64"`                      // SHA-256 of the raw lines
+	Current     bool       `gorm:"not null;default:false;index"` // Selected for propagation
 }
 
 // MapToDomain converts a models.Tile to a domain.Tile.
 func MapToTLEDomain(t TLE) domain.TLE {
+	var fetchedAt time.Time
+	if t.FetchedAt != nil {
+		fetchedAt = *t.FetchedAt
+	}
+
 	// Convert to domain
 	return domain.TLE{
 		ModelBase: domain.ModelBase{
@@ -30,14 +41,25 @@ func MapToTLEDomain(t TLE) domain.TLE {
 			DisplayName: t.DisplayName,
 		},
 		NoradID: t.NoradID,
+		Name:    t.Name,
 		Line1:   t.Line1,
 		Line2:   t.Line2,
 		Epoch:   t.Epoch,
+
+		Source:      t.Source,
+		FetchedAt:   fetchedAt,
+		PayloadHash: t.PayloadHash,
+		Current:     t.Current,
 	}
 }
 
 // MapToTLEModel converts a domain.TLE to a models.TLE.
 func MapToTLEModel(t domain.TLE) TLE {
+	var fetchedAt *time.Time
+	if !t.FetchedAt.IsZero() {
+		fetchedAt = &t.FetchedAt
+	}
+
 	return TLE{
 		ModelBase: ModelBase{
 			ID:          t.ModelBase.ID,
@@ -50,8 +72,14 @@ func MapToTLEModel(t domain.TLE) TLE {
 			DisplayName: t.ModelBase.DisplayName,
 		},
 		NoradID: t.NoradID,
+		Name:    t.Name,
 		Line1:   t.Line1,
 		Line2:   t.Line2,
 		Epoch:   t.Epoch,
+
+		Source:      t.Source,
+		FetchedAt:   fetchedAt,
+		PayloadHash: t.PayloadHash,
+		Current:     t.Current,
 	}
 }
@@ -0,0 +1,99 @@
+package models
+
+import (
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+// Transmitter represents a radio transmitter of a satellite. Frequencies are in Hz.
+type Transmitter struct {
+	ModelBase
+	NoradID      string  `gorm:"size:255;not null;index"` // Links the transmitter to its satellite
+	ExternalID   *string `gorm:"size:64;uniqueIndex"`     // Catalog identifier, NULL for transmitters created by users
+	Description  string  `gorm:"size:255;not null"`
+	Type         string  `gorm:"size:32"`
+	UplinkLow    *int64
+	UplinkHigh   *int64
+	DownlinkLow  *int64
+	DownlinkHigh *int64
+	Mode         string `gorm:"size:64"`
+	UplinkMode   string `gorm:"size:64"`
+	Baud         *float64
+	Invert       bool   `gorm:"not null;default:false"`
+	Status       string `gorm:"size:32;not null;index"`
+	Service      string `gorm:"size:64"`
+}
+
+// MapToTransmitterDomain converts a Transmitter database model to a domain model.
+func MapToTransmitterDomain(t Transmitter) domain.Transmitter {
+	transmitter := domain.Transmitter{
+		ModelBase: domain.ModelBase{
+			ID:          t.ID,
+			CreatedAt:   t.CreatedAt,
+			UpdatedAt:   &t.UpdatedAt,
+			DeleteAt:    t.DeleteAt,
+			ProcessedAt: t.ProcessedAt,
+			IsActive:    t.IsActive,
+			IsFavourite: t.IsFavourite,
+			DisplayName: t.DisplayName,
+		},
+		NoradID:     t.NoradID,
+		Description: t.Description,
+		Type:        t.Type,
+		Uplink:      domain.NewFrequencyRange(t.UplinkLow, t.UplinkHigh),
+		Downlink:    domain.NewFrequencyRange(t.DownlinkLow, t.DownlinkHigh),
+		Mode:        t.Mode,
+		UplinkMode:  t.UplinkMode,
+		Baud:        t.Baud,
+		Invert:      t.Invert,
+		Status:      domain.TransmitterStatus(t.Status),
+		Service:     t.Service,
+	}
+	if t.ExternalID != nil {
+		transmitter.ExternalID = *t.ExternalID
+	}
+	return transmitter
+}
+
+// MapToTransmitterModel converts a Transmitter domain model to a database model.
+func MapToTransmitterModel(t domain.Transmitter) Transmitter {
+	transmitter := Transmitter{
+		ModelBase: ModelBase{
+			ID:          t.ID,
+			CreatedAt:   t.CreatedAt,
+			UpdatedAt:   *t.UpdatedAt,
+			DeleteAt:    t.DeleteAt,
+			ProcessedAt: t.ProcessedAt,
+			IsActive:    t.IsActive,
+			IsFavourite: t.IsFavourite,
+			DisplayName: t.DisplayName,
+		},
+		NoradID:     t.NoradID,
+		Description: t.Description,
+		Type:        t.Type,
+		Mode:        t.Mode,
+		UplinkMode:  t.UplinkMode,
+		Baud:        t.Baud,
+		Invert:      t.Invert,
+		Status:      string(t.Status),
+		Service:     t.Service,
+	}
+	if t.ExternalID != "" {
+		externalID := t.ExternalID
+		transmitter.ExternalID = &externalID
+	}
+	transmitter.UplinkLow, transmitter.UplinkHigh = fromFrequencyRange(t.Uplink)
+	transmitter.DownlinkLow, transmitter.DownlinkHigh = fromFrequencyRange(t.Downlink)
+	return transmitter
+}
+
+func fromFrequencyRange(frequencies *domain.FrequencyRange) (*int64, *int64) {
+	if frequencies == nil {
+		return nil, nil
+	}
+	low := frequencies.Low
+	if frequencies.High == 0 {
+		return &low, nil
+	}
+	high := frequencies.High
+	return &low, &high
+}
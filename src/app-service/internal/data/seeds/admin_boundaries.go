@@ -0,0 +1,92 @@
+package seeds
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Elbujito/2112/src/app-service/internal/config"
+	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	repository "github.com/Elbujito/2112/src/app-service/internal/repositories"
+	logger "github.com/Elbujito/2112/src/app-service/pkg/log"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// adminRegionFilePrefix marks the dataset files holding first-level subdivisions rather than countries,
+// e.g. admin1_states_provinces.geojson. Other files default to countries unless their features set admin_level.
+const adminRegionFilePrefix = "admin1"
+
+func init() {
+	s := &gormigrate.Migration{
+		ID: "2026102012_seed_admin_boundaries",
+		Migrate: func(db *gorm.DB) error {
+			files, err := boundaryDatasets()
+			if err != nil {
+				return err
+			}
+
+			repo := repository.NewAdminAreaRepository(&data.Database{DbHandler: db})
+			for _, file := range files {
+				if err := loadAdminAreas(repo, file); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Where("1 = 1").Delete(&models.AdminArea{}).Error
+		},
+	}
+
+	// Without dataset the seed is left unrecorded, so that it runs once BOUNDARIES_DIR is filled
+	AddOptionalSeed(s, func() bool {
+		files, err := boundaryDatasets()
+		if err == nil && len(files) == 0 {
+			logger.Warnf("No boundary dataset found in %s", config.Env.EnvVars.Boundaries.Dir)
+		}
+		return err != nil || len(files) > 0
+	})
+}
+
+// boundaryDatasets lists the GeoJSON datasets of the boundaries directory.
+func boundaryDatasets() ([]string, error) {
+	dir := config.Env.EnvVars.Boundaries.Dir
+	files, err := filepath.Glob(filepath.Join(dir, "*.geojson"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list boundary datasets in %s: %w", dir, err)
+	}
+	return files, nil
+}
+
+// loadAdminAreas upserts the admin areas of a GeoJSON dataset by code.
+func loadAdminAreas(repo domain.AdminAreaRepository, file string) error {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("failed to read boundary dataset %s: %w", file, err)
+	}
+
+	level := domain.AdminLevelCountry
+	if strings.HasPrefix(strings.ToLower(filepath.Base(file)), adminRegionFilePrefix) {
+		level = domain.AdminLevelRegion
+	}
+
+	areas, warnings, err := domain.ParseAdminAreas(content, level)
+	if err != nil {
+		return fmt.Errorf("failed to parse boundary dataset %s: %w", file, err)
+	}
+	for _, warning := range warnings {
+		logger.Warnf("Skipping admin area in %s: %s", file, warning)
+	}
+
+	if err := repo.SaveBatch(context.Background(), areas); err != nil {
+		return fmt.Errorf("failed to save admin areas of %s: %w", file, err)
+	}
+
+	logger.Infof("Loaded %d admin areas from %s", len(areas), file)
+	return nil
+}
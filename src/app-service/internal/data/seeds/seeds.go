@@ -1,6 +1,7 @@
 package seeds
 
 import (
+	logger "github.com/Elbujito/2112/src/app-service/pkg/log"
 	"github.com/go-gormigrate/gormigrate/v2"
 	"gorm.io/gorm"
 )
@@ -8,14 +9,33 @@ import (
 var Seeds *gormigrate.Gormigrate
 var SeedsList = []*gormigrate.Migration{}
 
+// seedConditions holds the readiness checks of the optional seeds, by seed ID.
+var seedConditions = map[string]func() bool{}
+
+// Init selects the seeds to apply, leaving out the optional seeds whose input is not available yet.
+// A seed left out is not recorded, so that a later run applies it once its input is provided.
 func Init(db *gorm.DB) {
-	Seeds = gormigrate.New(db, gormigrate.DefaultOptions, SeedsList)
+	var seeds []*gormigrate.Migration
+	for _, seed := range SeedsList {
+		if ready, ok := seedConditions[seed.ID]; ok && !ready() {
+			logger.Warnf("Skipping seed %s until its input is available", seed.ID)
+			continue
+		}
+		seeds = append(seeds, seed)
+	}
+	Seeds = gormigrate.New(db, gormigrate.DefaultOptions, seeds)
 }
 
 func AddSeed(seed *gormigrate.Migration) {
 	SeedsList = append(SeedsList, seed)
 }
 
+// AddOptionalSeed registers a seed applied only once ready reports that its input is available.
+func AddOptionalSeed(seed *gormigrate.Migration, ready func() bool) {
+	AddSeed(seed)
+	seedConditions[seed.ID] = ready
+}
+
 func Apply() error {
 	return Seeds.Migrate()
 }
@@ -0,0 +1,209 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// AdminLevelCountry administrative level of countries.
+	AdminLevelCountry = 0
+	// AdminLevelRegion administrative level of the first subdivisions of countries (states, provinces, regions).
+	AdminLevelRegion = 1
+)
+
+// adminAreaCodeProperties lists the feature properties holding the area code, by order of preference.
+// They cover the Natural Earth, geoBoundaries and OSM exports.
+var adminAreaCodeProperties = []string{"code", "iso_3166_2", "ISO_A3", "iso_a3", "ADM0_A3", "adm0_a3", "ISO3166-1-Alpha-3", "shapeISO", "shapeID"}
+
+// adminAreaNameProperties lists the feature properties holding the area name, by order of preference.
+var adminAreaNameProperties = []string{"name", "NAME", "ADMIN", "admin", "name_en", "NAME_EN", "shapeName"}
+
+// adminAreaParentProperties lists the feature properties holding the code of the parent area, by order of preference.
+var adminAreaParentProperties = []string{"parent", "adm0_a3", "ADM0_A3", "iso_a2"}
+
+// AdminArea is an administrative area, a country or one of its regions, with its boundary.
+type AdminArea struct {
+	ModelBase
+	Code       string // Unique code, ISO 3166 when available
+	Name       string
+	Level      int    // AdminLevelCountry or AdminLevelRegion
+	ParentCode string // Code of the country of a region
+	Polygons   []GeoJSONPolygon
+	MinLat     float64 // Bounding box of the polygons
+	MinLon     float64
+	MaxLat     float64
+	MaxLon     float64
+}
+
+// NewAdminArea creates a new AdminArea instance and computes its bounding box.
+func NewAdminArea(code string, name string, level int, parentCode string, polygons []GeoJSONPolygon) (AdminArea, error) {
+	nowUtc := time.Now().UTC()
+	area := AdminArea{
+		ModelBase: ModelBase{
+			ID:          uuid.NewString(),
+			CreatedAt:   nowUtc,
+			UpdatedAt:   &nowUtc,
+			DisplayName: name,
+			IsActive:    true,
+			ProcessedAt: &nowUtc,
+		},
+		Code:       strings.TrimSpace(code),
+		Name:       strings.TrimSpace(name),
+		Level:      level,
+		ParentCode: strings.TrimSpace(parentCode),
+		Polygons:   polygons,
+	}
+	if err := area.Validate(); err != nil {
+		return AdminArea{}, err
+	}
+	area.computeBoundingBox()
+	return area, nil
+}
+
+// Validate ensures that the AdminArea fields are valid.
+func (a *AdminArea) Validate() error {
+	if a.Code == "" {
+		return errors.New("admin area code cannot be empty")
+	}
+	if a.Name == "" {
+		return fmt.Errorf("admin area %s name cannot be empty", a.Code)
+	}
+	if a.Level != AdminLevelCountry && a.Level != AdminLevelRegion {
+		return fmt.Errorf("admin area %s has an unsupported level: %d", a.Code, a.Level)
+	}
+	if len(a.Polygons) == 0 {
+		return fmt.Errorf("admin area %s has no boundary", a.Code)
+	}
+	for _, polygon := range a.Polygons {
+		if err := polygon.Validate(); err != nil {
+			return fmt.Errorf("admin area %s: %w", a.Code, err)
+		}
+	}
+	return nil
+}
+
+// computeBoundingBox sets the bounding box from the outer rings of the polygons.
+func (a *AdminArea) computeBoundingBox() {
+	a.MinLat, a.MinLon = math.Inf(1), math.Inf(1)
+	a.MaxLat, a.MaxLon = math.Inf(-1), math.Inf(-1)
+	for _, polygon := range a.Polygons {
+		for _, position := range polygon.Coordinates[0] {
+			a.MinLon = math.Min(a.MinLon, position[0])
+			a.MaxLon = math.Max(a.MaxLon, position[0])
+			a.MinLat = math.Min(a.MinLat, position[1])
+			a.MaxLat = math.Max(a.MaxLat, position[1])
+		}
+	}
+}
+
+// Contains reports whether a point lies within the area.
+func (a *AdminArea) Contains(latitude float64, longitude float64) bool {
+	if latitude < a.MinLat || latitude > a.MaxLat || longitude < a.MinLon || longitude > a.MaxLon {
+		return false
+	}
+	for _, polygon := range a.Polygons {
+		if polygon.Contains(latitude, longitude) {
+			return true
+		}
+	}
+	return false
+}
+
+// geoJSONFeatureCollection is the subset of a GeoJSON FeatureCollection read by ParseAdminAreas.
+type geoJSONFeatureCollection struct {
+	Type     string `json:"type"`
+	Features []struct {
+		ID         interface{}            `json:"id"`
+		Properties map[string]interface{} `json:"properties"`
+		Geometry   struct {
+			Type        string          `json:"type"`
+			Coordinates json.RawMessage `json:"coordinates"`
+		} `json:"geometry"`
+	} `json:"features"`
+}
+
+// ParseAdminAreas reads the admin areas of a GeoJSON FeatureCollection of Polygon and MultiPolygon features.
+// The level is read from the admin_level property when present, defaultLevel is used otherwise.
+// Features without code, name or supported geometry are skipped and reported in the returned warnings.
+func ParseAdminAreas(data []byte, defaultLevel int) (areas []AdminArea, warnings []string, err error) {
+	var collection geoJSONFeatureCollection
+	if err := json.Unmarshal(data, &collection); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse GeoJSON: %w", err)
+	}
+	if collection.Type != "FeatureCollection" {
+		return nil, nil, fmt.Errorf("unsupported GeoJSON type: %s", collection.Type)
+	}
+
+	for i, feature := range collection.Features {
+		code := firstStringProperty(feature.Properties, adminAreaCodeProperties)
+		if code == "" && feature.ID != nil {
+			code = fmt.Sprint(feature.ID)
+		}
+		name := firstStringProperty(feature.Properties, adminAreaNameProperties)
+
+		level := defaultLevel
+		if value, ok := feature.Properties["admin_level"].(float64); ok {
+			level = int(value)
+		}
+		parentCode := ""
+		if level == AdminLevelRegion {
+			parentCode = firstStringProperty(feature.Properties, adminAreaParentProperties)
+		}
+
+		var polygons []GeoJSONPolygon
+		switch feature.Geometry.Type {
+		case "Polygon":
+			var coordinates [][][]float64
+			if err := json.Unmarshal(feature.Geometry.Coordinates, &coordinates); err != nil {
+				warnings = append(warnings, fmt.Sprintf("feature %d (%s): %v", i, code, err))
+				continue
+			}
+			polygons = append(polygons, GeoJSONPolygon{Type: "Polygon", Coordinates: coordinates})
+		case "MultiPolygon":
+			var coordinates [][][][]float64
+			if err := json.Unmarshal(feature.Geometry.Coordinates, &coordinates); err != nil {
+				warnings = append(warnings, fmt.Sprintf("feature %d (%s): %v", i, code, err))
+				continue
+			}
+			for _, polygon := range coordinates {
+				polygons = append(polygons, GeoJSONPolygon{Type: "Polygon", Coordinates: polygon})
+			}
+		default:
+			warnings = append(warnings, fmt.Sprintf("feature %d (%s): unsupported geometry %s", i, code, feature.Geometry.Type))
+			continue
+		}
+
+		area, err := NewAdminArea(code, name, level, parentCode, polygons)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("feature %d: %v", i, err))
+			continue
+		}
+		areas = append(areas, area)
+	}
+	return areas, warnings, nil
+}
+
+// firstStringProperty returns the first non-empty string property among keys.
+func firstStringProperty(properties map[string]interface{}, keys []string) string {
+	for _, key := range keys {
+		if value, ok := properties[key].(string); ok && strings.TrimSpace(value) != "" && value != "-99" {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
+
+// AdminAreaRepository defines the interface for AdminArea operations.
+type AdminAreaRepository interface {
+	SaveBatch(ctx context.Context, areas []AdminArea) error
+	FindByCodes(ctx context.Context, codes []string) ([]AdminArea, error)
+	FindByLevel(ctx context.Context, level int) ([]AdminArea, error)
+}
@@ -0,0 +1,104 @@
+package domain
+
+import "testing"
+
+const adminAreasGeoJSON = `{
+	"type": "FeatureCollection",
+	"features": [{
+		"type": "Feature",
+		"properties": {"ISO_A3": "LSO", "ADMIN": "Enclave"},
+		"geometry": {"type": "Polygon", "coordinates": [
+			[[-10, -10], [10, -10], [10, 10], [-10, 10], [-10, -10]],
+			[[-2, -2], [2, -2], [2, 2], [-2, 2], [-2, -2]]
+		]}
+	}, {
+		"type": "Feature",
+		"properties": {"ISO_A3": "FJI", "ADMIN": "Fiji"},
+		"geometry": {"type": "MultiPolygon", "coordinates": [
+			[[[177, -20], [180, -20], [180, -15], [177, -15], [177, -20]]],
+			[[[-180, -20], [-178, -20], [-178, -15], [-180, -15], [-180, -20]]]
+		]}
+	}, {
+		"type": "Feature",
+		"properties": {"iso_3166_2": "FR-BRE", "name": "Bretagne", "admin_level": 1, "adm0_a3": "FRA"},
+		"geometry": {"type": "Polygon", "coordinates": [[[-5, 47], [-1, 47], [-1, 49], [-5, 49], [-5, 47]]]}
+	}, {
+		"type": "Feature",
+		"id": "unnamed",
+		"properties": {"NAME": "-99"},
+		"geometry": {"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [1, 1], [0, 1], [0, 0]]]}
+	}, {
+		"type": "Feature",
+		"properties": {"code": "PNT", "name": "Point"},
+		"geometry": {"type": "Point", "coordinates": [0, 0]}
+	}, {
+		"type": "Feature",
+		"properties": {"code": "OPN", "name": "Open ring"},
+		"geometry": {"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [1, 1], [0, 1]]]}
+	}]
+}`
+
+func TestParseAdminAreas(t *testing.T) {
+	areas, warnings, err := ParseAdminAreas([]byte(adminAreasGeoJSON), AdminLevelCountry)
+	if err != nil {
+		t.Fatalf("ParseAdminAreas returned an error: %v", err)
+	}
+	if len(areas) != 3 {
+		t.Fatalf("Expected 3 areas, got %d", len(areas))
+	}
+	// The unnamed feature, the point and the open ring are skipped
+	if len(warnings) != 3 {
+		t.Errorf("Expected 3 warnings, got %v", warnings)
+	}
+
+	enclave, fiji, region := areas[0], areas[1], areas[2]
+	if enclave.Code != "LSO" || enclave.Name != "Enclave" || enclave.Level != AdminLevelCountry || len(enclave.Polygons[0].Coordinates) != 2 {
+		t.Errorf("Unexpected area %+v", enclave)
+	}
+	if fiji.Code != "FJI" || len(fiji.Polygons) != 2 || fiji.MinLon != -180 || fiji.MaxLon != 180 || fiji.MinLat != -20 || fiji.MaxLat != -15 {
+		t.Errorf("Unexpected area %+v", fiji)
+	}
+	if region.Code != "FR-BRE" || region.Level != AdminLevelRegion || region.ParentCode != "FRA" {
+		t.Errorf("Unexpected region %+v", region)
+	}
+
+	tests := []struct {
+		name      string
+		area      AdminArea
+		latitude  float64
+		longitude float64
+		expected  bool
+	}{
+		{name: "inside the area", area: enclave, latitude: 5, longitude: -5, expected: true},
+		{name: "inside the hole", area: enclave, latitude: 1, longitude: 1},
+		{name: "outside the bounding box", area: enclave, latitude: 20, longitude: 0},
+		{name: "west of the antimeridian", area: fiji, latitude: -17, longitude: 179, expected: true},
+		{name: "east of the antimeridian", area: fiji, latitude: -17, longitude: -179, expected: true},
+		{name: "inside the bounding box between the polygons", area: fiji, latitude: -17, longitude: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if contains := tt.area.Contains(tt.latitude, tt.longitude); contains != tt.expected {
+				t.Errorf("Expected %v for (%v, %v), got %v", tt.expected, tt.latitude, tt.longitude, contains)
+			}
+		})
+	}
+}
+
+func TestParseAdminAreasErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "invalid JSON", data: `{"type": "FeatureCollection",`},
+		{name: "single feature", data: `{"type": "Feature", "properties": {}, "geometry": null}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := ParseAdminAreas([]byte(tt.data), AdminLevelCountry); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+}
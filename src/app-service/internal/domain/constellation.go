@@ -0,0 +1,323 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	xconstants "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xconstants"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+	"github.com/google/uuid"
+)
+
+// ConstellationKind represents the way the orbits of a constellation are defined.
+type ConstellationKind string
+
+const (
+	// ConstellationWalkerDelta Walker constellation with nodes spread over 360 degrees.
+	ConstellationWalkerDelta ConstellationKind = "WALKER_DELTA"
+	// ConstellationWalkerStar Walker constellation with nodes spread over 180 degrees.
+	ConstellationWalkerStar ConstellationKind = "WALKER_STAR"
+	// ConstellationCustom constellation defined plane by plane.
+	ConstellationCustom ConstellationKind = "CUSTOM"
+)
+
+// IsValid checks if the ConstellationKind is valid.
+func (k ConstellationKind) IsValid() error {
+	switch k {
+	case ConstellationWalkerDelta, ConstellationWalkerStar, ConstellationCustom:
+		return nil
+	default:
+		return fmt.Errorf("invalid constellation kind: %s", k)
+	}
+}
+
+// walkerPattern returns the Walker pattern matching the kind.
+func (k ConstellationKind) walkerPattern() xspace.WalkerPattern {
+	if k == ConstellationWalkerStar {
+		return xspace.WALKER_STAR
+	}
+	return xspace.WALKER_DELTA
+}
+
+// ConstellationPlane describes one circular orbital plane of a custom constellation.
+type ConstellationPlane struct {
+	AltitudeKm  float64 `json:"altitudeKm"`
+	Inclination float64 `json:"inclination"` // Degrees
+	RAAN        float64 `json:"raan"`        // Degrees
+	Satellites  int     `json:"satellites"`  // Satellites evenly spaced in the plane
+	PhaseOffset float64 `json:"phaseOffset"` // Mean anomaly of the first satellite in degrees
+}
+
+// Constellation groups the synthetic satellites generated from a Walker or custom definition within a GameContext.
+type Constellation struct {
+	ModelBase
+	ContextID string
+	TenantID  TenantID
+	Name      string
+	Kind      ConstellationKind
+	Walker    *xspace.WalkerConstellation // Set for Walker kinds
+	Planes    []ConstellationPlane        // Set for the custom kind
+	Epoch     time.Time                   // Epoch of the generated element sets
+	NoradIDs  []string                    // Member satellites
+}
+
+// NewConstellation creates a new Constellation instance.
+func NewConstellation(
+	contextID string,
+	tenantID TenantID,
+	name string,
+	kind ConstellationKind,
+	walker *xspace.WalkerConstellation,
+	planes []ConstellationPlane,
+	epoch time.Time,
+) (Constellation, error) {
+	nowUtc := time.Now().UTC()
+	constellation := Constellation{
+		ModelBase: ModelBase{
+			ID:          uuid.NewString(),
+			CreatedAt:   nowUtc,
+			UpdatedAt:   &nowUtc,
+			DisplayName: name,
+			IsActive:    true,
+			ProcessedAt: &nowUtc,
+			IsFavourite: false,
+		},
+		ContextID: contextID,
+		TenantID:  tenantID,
+		Name:      name,
+		Kind:      kind,
+		Walker:    walker,
+		Planes:    planes,
+		Epoch:     epoch.UTC(),
+	}
+	if walker != nil {
+		// The pattern always follows the kind
+		normalized := *walker
+		normalized.Pattern = kind.walkerPattern()
+		constellation.Walker = &normalized
+	}
+	if err := constellation.Validate(); err != nil {
+		return Constellation{}, err
+	}
+	return constellation, nil
+}
+
+// Validate ensures that the Constellation fields are valid.
+func (c *Constellation) Validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return errors.New("constellation name cannot be empty")
+	}
+	if c.ContextID == "" {
+		return errors.New("constellation must belong to a context")
+	}
+	if err := c.Kind.IsValid(); err != nil {
+		return err
+	}
+	if c.Epoch.IsZero() {
+		return errors.New("constellation epoch cannot be zero")
+	}
+
+	if c.Kind == ConstellationCustom {
+		if len(c.Planes) == 0 {
+			return errors.New("custom constellation requires at least one plane")
+		}
+		for i, plane := range c.Planes {
+			if plane.Satellites <= 0 {
+				return fmt.Errorf("plane %d must have at least one satellite", i+1)
+			}
+			if plane.AltitudeKm <= 0 {
+				return fmt.Errorf("plane %d altitude must be greater than zero", i+1)
+			}
+			if plane.Inclination < 0 || plane.Inclination > 180 {
+				return fmt.Errorf("plane %d inclination out of bounds: %f", i+1, plane.Inclination)
+			}
+		}
+		return nil
+	}
+
+	if c.Walker == nil {
+		return errors.New("walker constellation requires a walker definition")
+	}
+	return c.Walker.Validate()
+}
+
+// SatelliteCount returns the number of satellites defined by the constellation.
+func (c *Constellation) SatelliteCount() int {
+	if c.Kind != ConstellationCustom {
+		if c.Walker == nil {
+			return 0
+		}
+		return c.Walker.TotalSatellites
+	}
+	count := 0
+	for _, plane := range c.Planes {
+		count += plane.Satellites
+	}
+	return count
+}
+
+// PlaneElements returns the Keplerian elements of the satellites, plane by plane.
+func (c *Constellation) PlaneElements() ([][]xspace.KeplerianElements, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	if c.Kind != ConstellationCustom {
+		return walkerPlaneElements(*c.Walker, c.Epoch)
+	}
+
+	planes := make([][]xspace.KeplerianElements, len(c.Planes))
+	for i, plane := range c.Planes {
+		for slot := 0; slot < plane.Satellites; slot++ {
+			planes[i] = append(planes[i], xspace.KeplerianElements{
+				Epoch:           c.Epoch,
+				SemiMajorAxisKm: xconstants.EARTH_EQUATORIAL_RADIUS_KM + plane.AltitudeKm,
+				Inclination:     plane.Inclination,
+				RAAN:            plane.RAAN,
+				MeanAnomaly:     plane.PhaseOffset + 360.0*float64(slot)/float64(plane.Satellites),
+			})
+		}
+	}
+	return planes, nil
+}
+
+// GenerateSatellites creates the synthetic member satellites and their TLEs, numbered from firstNoradID.
+// The generated NORAD IDs are recorded as the constellation members.
+func (c *Constellation) GenerateSatellites(firstNoradID int) ([]Satellite, []TLE, error) {
+	planes, err := c.PlaneElements()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	satellites, tles, err := newSyntheticPlaneSatellites(c.Name, firstNoradID, planes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c.NoradIDs = make([]string, len(satellites))
+	for i, satellite := range satellites {
+		c.NoradIDs[i] = satellite.NoradID
+	}
+	return satellites, tles, nil
+}
+
+// CoverageBand holds the coverage statistics of a latitude band.
+type CoverageBand struct {
+	MinLatitude        float64 `json:"minLatitude"`
+	MaxLatitude        float64 `json:"maxLatitude"`
+	Tiles              int     `json:"tiles"`
+	CoverageFraction   float64 `json:"coverageFraction"`
+	MeanRevisitMinutes float64 `json:"meanRevisitMinutes"`
+	MaxGapMinutes      float64 `json:"maxGapMinutes"`
+}
+
+// ConstellationCoverageReport holds the coverage statistics of a constellation over the tiles of its context.
+type ConstellationCoverageReport struct {
+	ModelBase
+	ConstellationID    string
+	WindowStart        time.Time
+	WindowEnd          time.Time
+	StepSeconds        int
+	MinElevation       float64 // Degrees
+	Tiles              int
+	CoverageFraction   float64 // Mean coverage fraction of the tiles
+	MeanRevisitMinutes float64 // Mean revisit of the tiles
+	MaxGapMinutes      float64 // Longest gap of any tile
+	Bands              []CoverageBand
+}
+
+// NewConstellationCoverageReport computes the coverage of the tiles by the member satellites between start and end.
+// A tile is covered when at least one satellite is above minElevation from its center.
+func NewConstellationCoverageReport(
+	constellation Constellation,
+	tles []TLE,
+	tiles []Tile,
+	minElevation float64,
+	bandWidth float64,
+	start time.Time,
+	end time.Time,
+	step time.Duration,
+) (ConstellationCoverageReport, error) {
+	if len(tiles) == 0 {
+		return ConstellationCoverageReport{}, errors.New("no tiles to evaluate coverage against")
+	}
+
+	tracks := make([][]xspace.SatellitePosition, 0, len(tles))
+	for _, tle := range tles {
+		track, err := xspace.PropagateRange(tle.Line1, tle.Line2, start, end, step)
+		if err != nil {
+			return ConstellationCoverageReport{}, fmt.Errorf("failed to propagate NORAD ID %s: %w", tle.NoradID, err)
+		}
+		tracks = append(tracks, track)
+	}
+
+	targets := make([]xspace.CoverageTarget, len(tiles))
+	for i, tile := range tiles {
+		targets[i] = xspace.CoverageTarget{ID: tile.ID, Latitude: tile.CenterLat, Longitude: tile.CenterLon}
+	}
+
+	coverages, err := xspace.ComputeCoverage(tracks, targets, minElevation, start, end, step)
+	if err != nil {
+		return ConstellationCoverageReport{}, err
+	}
+	latitudeBands, err := xspace.AggregateCoverageByLatitude(coverages, bandWidth)
+	if err != nil {
+		return ConstellationCoverageReport{}, err
+	}
+
+	nowUtc := time.Now().UTC()
+	report := ConstellationCoverageReport{
+		ModelBase: ModelBase{
+			ID:          uuid.NewString(),
+			CreatedAt:   nowUtc,
+			UpdatedAt:   &nowUtc,
+			DisplayName: constellation.Name,
+			IsActive:    true,
+			ProcessedAt: &nowUtc,
+		},
+		ConstellationID: constellation.ID,
+		WindowStart:     start,
+		WindowEnd:       end,
+		StepSeconds:     int(step.Seconds()),
+		MinElevation:    minElevation,
+		Tiles:           len(coverages),
+	}
+
+	var totalRevisit time.Duration
+	for _, coverage := range coverages {
+		report.CoverageFraction += coverage.CoverageFraction
+		totalRevisit += coverage.MeanRevisit
+		report.MaxGapMinutes = math.Max(report.MaxGapMinutes, coverage.MaxGap.Minutes())
+	}
+	report.CoverageFraction /= float64(len(coverages))
+	report.MeanRevisitMinutes = (totalRevisit / time.Duration(len(coverages))).Minutes()
+
+	for _, band := range latitudeBands {
+		report.Bands = append(report.Bands, CoverageBand{
+			MinLatitude:        band.MinLatitude,
+			MaxLatitude:        band.MaxLatitude,
+			Tiles:              band.Targets,
+			CoverageFraction:   band.CoverageFraction,
+			MeanRevisitMinutes: band.MeanRevisit.Minutes(),
+			MaxGapMinutes:      band.MaxGap.Minutes(),
+		})
+	}
+	return report, nil
+}
+
+// ConstellationRepository defines the interface for Constellation operations.
+// All lookups are scoped to a context and its tenant.
+type ConstellationRepository interface {
+	// Save stores a constellation with its synthetic satellites and their element sets, all or nothing.
+	Save(ctx context.Context, constellation Constellation, satellites []Satellite, tles []TLE) error
+	ExistsByName(ctx context.Context, contextID string, name string) (bool, error)
+	FindByID(ctx context.Context, contextID string, tenantID TenantID, id string) (Constellation, error)
+	FindAllByContext(ctx context.Context, contextID string, tenantID TenantID) ([]Constellation, error)
+	DeleteByID(ctx context.Context, contextID string, tenantID TenantID, id string) error
+	SaveCoverageReport(ctx context.Context, report ConstellationCoverageReport) error
+	FindLatestCoverageReport(ctx context.Context, constellationID string) (*ConstellationCoverageReport, error)
+}
@@ -0,0 +1,210 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// ContactRequest asks the scheduler for contacts with a satellite. Higher priorities are served first.
+type ContactRequest struct {
+	NoradID  string
+	Priority int
+}
+
+// ContactCandidate is a pass competing for a slot in the contact plan.
+type ContactCandidate struct {
+	GroundStationPass
+	Priority int
+}
+
+// Contact represents a scheduled contact between a ground station and a satellite.
+// The station is booked from SetupStart to TeardownEnd.
+type Contact struct {
+	ID           string
+	StationID    string
+	StationName  string
+	NoradID      string
+	Priority     int
+	SetupStart   time.Time
+	AOS          time.Time
+	TCA          time.Time
+	LOS          time.Time
+	TeardownEnd  time.Time
+	MaxElevation float64
+}
+
+// overlaps checks if the station bookings of two contacts overlap.
+func (c Contact) overlaps(other Contact) bool {
+	return c.SetupStart.Before(other.TeardownEnd) && other.SetupStart.Before(c.TeardownEnd)
+}
+
+// ContactPlan represents a conflict-free contact schedule of a context.
+type ContactPlan struct {
+	ModelBase
+	ContextID      string
+	TenantID       TenantID
+	Start          time.Time
+	End            time.Time
+	SetupMargin    time.Duration
+	TeardownMargin time.Duration
+	Contacts       []Contact
+	Rejected       int // Number of candidate passes dropped because of conflicts
+}
+
+// NewContactPlan schedules the candidate passes of a context into a conflict-free plan.
+func NewContactPlan(gameContext GameContext, start time.Time, end time.Time, setupMargin time.Duration, teardownMargin time.Duration, candidates []ContactCandidate) (ContactPlan, error) {
+	if !end.After(start) {
+		return ContactPlan{}, errors.New("plan end must be after its start")
+	}
+	if setupMargin < 0 || teardownMargin < 0 {
+		return ContactPlan{}, errors.New("setup and teardown margins cannot be negative")
+	}
+
+	contacts := ScheduleContacts(candidates, setupMargin, teardownMargin)
+
+	nowUtc := time.Now().UTC()
+	return ContactPlan{
+		ModelBase: ModelBase{
+			ID:          uuid.NewString(),
+			CreatedAt:   nowUtc,
+			UpdatedAt:   &nowUtc,
+			DisplayName: string(gameContext.Name),
+			IsActive:    true,
+			ProcessedAt: &nowUtc,
+		},
+		ContextID:      gameContext.ID,
+		TenantID:       gameContext.TenantID,
+		Start:          start,
+		End:            end,
+		SetupMargin:    setupMargin,
+		TeardownMargin: teardownMargin,
+		Contacts:       contacts,
+		Rejected:       len(candidates) - len(contacts),
+	}, nil
+}
+
+// ScheduleContacts greedily books the candidates, highest priority first, then highest elevation, then earliest.
+// Each station tracks a single satellite at a time, margins included.
+func ScheduleContacts(candidates []ContactCandidate, setupMargin time.Duration, teardownMargin time.Duration) []Contact {
+	ordered := make([]ContactCandidate, len(candidates))
+	copy(ordered, candidates)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].Priority != ordered[j].Priority {
+			return ordered[i].Priority > ordered[j].Priority
+		}
+		if ordered[i].MaxElevation != ordered[j].MaxElevation {
+			return ordered[i].MaxElevation > ordered[j].MaxElevation
+		}
+		return ordered[i].AOS.Before(ordered[j].AOS)
+	})
+
+	booked := make(map[string][]Contact)
+	var contacts []Contact
+	for _, candidate := range ordered {
+		contact := Contact{
+			ID:           uuid.NewString(),
+			StationID:    candidate.StationID,
+			StationName:  candidate.StationName,
+			NoradID:      candidate.NoradID,
+			Priority:     candidate.Priority,
+			SetupStart:   candidate.AOS.Add(-setupMargin),
+			AOS:          candidate.AOS,
+			TCA:          candidate.TCA,
+			LOS:          candidate.LOS,
+			TeardownEnd:  candidate.LOS.Add(teardownMargin),
+			MaxElevation: candidate.MaxElevation,
+		}
+
+		conflict := false
+		for _, other := range booked[contact.StationID] {
+			if contact.overlaps(other) {
+				conflict = true
+				break
+			}
+		}
+		if conflict {
+			continue
+		}
+		booked[contact.StationID] = append(booked[contact.StationID], contact)
+		contacts = append(contacts, contact)
+	}
+
+	sort.Slice(contacts, func(i, j int) bool {
+		return contacts[i].AOS.Before(contacts[j].AOS)
+	})
+	return contacts
+}
+
+// ICalendar renders the plan as an RFC 5545 calendar with one event per station booking.
+func (p ContactPlan) ICalendar() string {
+	const icalTime = "20060102T150405Z"
+
+	var b strings.Builder
+	writeLine := func(line string) {
+		b.WriteString(foldICalLine(line))
+	}
+
+	writeLine("BEGIN:VCALENDAR")
+	writeLine("VERSION:2.0")
+	writeLine("PRODID:-//2112//Contact Plan//EN")
+	writeLine("CALSCALE:GREGORIAN")
+	writeLine("X-WR-CALNAME:" + escapeICalText(p.DisplayName+" contact plan"))
+	for _, contact := range p.Contacts {
+		writeLine("BEGIN:VEVENT")
+		writeLine("UID:" + contact.ID + "@2112")
+		writeLine("DTSTAMP:" + p.CreatedAt.UTC().Format(icalTime))
+		writeLine("DTSTART:" + contact.SetupStart.UTC().Format(icalTime))
+		writeLine("DTEND:" + contact.TeardownEnd.UTC().Format(icalTime))
+		writeLine("SUMMARY:" + escapeICalText(fmt.Sprintf("%s - NORAD %s", contact.StationName, contact.NoradID)))
+		writeLine("LOCATION:" + escapeICalText(contact.StationName))
+		writeLine("DESCRIPTION:" + escapeICalText(fmt.Sprintf(
+			"AOS %s, TCA %s, LOS %s, max elevation %.1f deg, priority %d",
+			contact.AOS.UTC().Format(time.RFC3339),
+			contact.TCA.UTC().Format(time.RFC3339),
+			contact.LOS.UTC().Format(time.RFC3339),
+			contact.MaxElevation,
+			contact.Priority,
+		)))
+		writeLine("END:VEVENT")
+	}
+	writeLine("END:VCALENDAR")
+	return b.String()
+}
+
+// icalLineOctets is the maximum length of an iCalendar content line, line break excluded.
+const icalLineOctets = 75
+
+// foldICalLine terminates a content line, folding it when longer than 75 octets. Continuation lines start
+// with a space and cuts fall on rune boundaries, so multi-byte UTF-8 characters are never split.
+func foldICalLine(line string) string {
+	var b strings.Builder
+	for len(line) > icalLineOctets {
+		cut := icalLineOctets
+		for cut > 1 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut] + "\r\n")
+		line = " " + line[cut:]
+	}
+	b.WriteString(line + "\r\n")
+	return b.String()
+}
+
+// escapeICalText escapes the characters reserved in iCalendar text values.
+func escapeICalText(text string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+	return replacer.Replace(text)
+}
+
+// ContactPlanRepository defines the interface for ContactPlan operations.
+type ContactPlanRepository interface {
+	Save(ctx context.Context, plan ContactPlan) error
+	FindLatestByContext(ctx context.Context, contextID string, tenantID TenantID) (ContactPlan, error)
+}
@@ -0,0 +1,211 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+var contactEpoch = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+func contactCandidate(stationID string, noradID string, priority int, aosMinutes int, losMinutes int, maxElevation float64) ContactCandidate {
+	return ContactCandidate{
+		GroundStationPass: GroundStationPass{
+			StationID:    stationID,
+			StationName:  "Station " + stationID,
+			NoradID:      noradID,
+			AOS:          contactEpoch.Add(time.Duration(aosMinutes) * time.Minute),
+			TCA:          contactEpoch.Add(time.Duration(aosMinutes+losMinutes) * time.Minute / 2),
+			LOS:          contactEpoch.Add(time.Duration(losMinutes) * time.Minute),
+			MaxElevation: maxElevation,
+		},
+		Priority: priority,
+	}
+}
+
+func TestScheduleContacts(t *testing.T) {
+	tests := []struct {
+		name       string
+		candidates []ContactCandidate
+		setup      time.Duration
+		teardown   time.Duration
+		expected   []string // NORAD IDs of the booked contacts in AOS order
+	}{
+		{
+			name: "higher priority wins an overlapping pass",
+			candidates: []ContactCandidate{
+				contactCandidate("A", "1", 1, 0, 10, 80),
+				contactCandidate("A", "2", 5, 5, 15, 20),
+			},
+			expected: []string{"2"},
+		},
+		{
+			name: "higher elevation wins at equal priority",
+			candidates: []ContactCandidate{
+				contactCandidate("A", "1", 1, 0, 10, 30),
+				contactCandidate("A", "2", 1, 5, 15, 60),
+			},
+			expected: []string{"2"},
+		},
+		{
+			name: "earlier pass wins at equal priority and elevation",
+			candidates: []ContactCandidate{
+				contactCandidate("A", "2", 1, 5, 15, 30),
+				contactCandidate("A", "1", 1, 0, 10, 30),
+			},
+			expected: []string{"1"},
+		},
+		{
+			name: "stations are booked independently",
+			candidates: []ContactCandidate{
+				contactCandidate("A", "1", 1, 0, 10, 30),
+				contactCandidate("B", "2", 1, 5, 15, 30),
+			},
+			expected: []string{"1", "2"},
+		},
+		{
+			name: "back to back passes fit without margins",
+			candidates: []ContactCandidate{
+				contactCandidate("A", "1", 1, 0, 10, 30),
+				contactCandidate("A", "2", 1, 10, 20, 30),
+			},
+			expected: []string{"1", "2"},
+		},
+		{
+			name: "margins turn back to back passes into a conflict",
+			candidates: []ContactCandidate{
+				contactCandidate("A", "1", 2, 0, 10, 30),
+				contactCandidate("A", "2", 1, 12, 20, 30),
+			},
+			setup:    time.Minute,
+			teardown: 2 * time.Minute,
+			expected: []string{"1"},
+		},
+		{
+			name:     "no candidates",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contacts := ScheduleContacts(tt.candidates, tt.setup, tt.teardown)
+
+			var noradIDs []string
+			for _, contact := range contacts {
+				noradIDs = append(noradIDs, contact.NoradID)
+			}
+			if strings.Join(noradIDs, ",") != strings.Join(tt.expected, ",") {
+				t.Fatalf("Expected contacts %v, got %v", tt.expected, noradIDs)
+			}
+
+			for i, contact := range contacts {
+				if !contact.SetupStart.Equal(contact.AOS.Add(-tt.setup)) || !contact.TeardownEnd.Equal(contact.LOS.Add(tt.teardown)) {
+					t.Errorf("Contact %s booked from %v to %v, margins not applied", contact.NoradID, contact.SetupStart, contact.TeardownEnd)
+				}
+				for _, other := range contacts[i+1:] {
+					if contact.StationID == other.StationID && contact.overlaps(other) {
+						t.Errorf("Contacts %s and %s overlap on station %s", contact.NoradID, other.NoradID, contact.StationID)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestNewContactPlanCountsRejected(t *testing.T) {
+	candidates := []ContactCandidate{
+		contactCandidate("A", "1", 1, 0, 10, 30),
+		contactCandidate("A", "2", 1, 5, 15, 20),
+		contactCandidate("B", "3", 1, 5, 15, 20),
+	}
+	plan, err := NewContactPlan(GameContext{Name: "ops"}, contactEpoch, contactEpoch.Add(time.Hour), 0, 0, candidates)
+	if err != nil {
+		t.Fatalf("NewContactPlan returned an error: %v", err)
+	}
+	if len(plan.Contacts) != 2 || plan.Rejected != 1 {
+		t.Errorf("Expected 2 contacts and 1 rejected pass, got %d and %d", len(plan.Contacts), plan.Rejected)
+	}
+
+	if _, err := NewContactPlan(GameContext{Name: "ops"}, contactEpoch, contactEpoch, 0, 0, nil); err == nil {
+		t.Error("Expected an error for an empty plan period")
+	}
+	if _, err := NewContactPlan(GameContext{Name: "ops"}, contactEpoch, contactEpoch.Add(time.Hour), -time.Minute, 0, nil); err == nil {
+		t.Error("Expected an error for a negative margin")
+	}
+}
+
+func TestFoldICalLine(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+	}{
+		{name: "short line", line: "SUMMARY:Pass"},
+		{name: "exactly 75 octets", line: "SUMMARY:" + strings.Repeat("a", 67)},
+		{name: "long ascii line", line: "DESCRIPTION:" + strings.Repeat("abcdefghij", 20)},
+		{name: "two byte runes across the fold", line: "LOCATION:" + strings.Repeat("é", 100)},
+		{name: "three byte runes across the fold", line: "LOCATION:x" + strings.Repeat("日本", 60)},
+		{name: "four byte runes across the fold", line: "SUMMARY:" + strings.Repeat("🛰", 40)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			folded := foldICalLine(tt.line)
+			if !strings.HasSuffix(folded, "\r\n") {
+				t.Fatalf("Folded line %q is not terminated by CRLF", folded)
+			}
+
+			lines := strings.Split(strings.TrimSuffix(folded, "\r\n"), "\r\n")
+			var unfolded strings.Builder
+			for i, line := range lines {
+				if len(line) > icalLineOctets {
+					t.Errorf("Line %d is %d octets long", i, len(line))
+				}
+				if !utf8.ValidString(line) {
+					t.Errorf("Line %d %q splits a multi-byte character", i, line)
+				}
+				if i > 0 {
+					if !strings.HasPrefix(line, " ") {
+						t.Fatalf("Continuation line %d %q does not start with a space", i, line)
+					}
+					line = line[1:]
+				}
+				unfolded.WriteString(line)
+			}
+			if unfolded.String() != tt.line {
+				t.Errorf("Unfolding gives %q, expected %q", unfolded.String(), tt.line)
+			}
+			if len(tt.line) <= icalLineOctets && len(lines) != 1 {
+				t.Errorf("Line of %d octets should not be folded", len(tt.line))
+			}
+		})
+	}
+}
+
+func TestContactPlanICalendar(t *testing.T) {
+	plan := ContactPlan{
+		ModelBase: ModelBase{DisplayName: "ops", CreatedAt: contactEpoch},
+		Contacts: ScheduleContacts([]ContactCandidate{
+			contactCandidate("A", "25544", 1, 0, 10, 45),
+		}, time.Minute, time.Minute),
+	}
+	plan.Contacts[0].StationName = "Kiruna, Suède; nord"
+
+	calendar := plan.ICalendar()
+	for _, expected := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"BEGIN:VEVENT\r\n",
+		"DTSTART:20240301T115900Z\r\n",
+		"DTEND:20240301T121100Z\r\n",
+		`LOCATION:Kiruna\, Suède\; nord` + "\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(calendar, expected) {
+			t.Errorf("Calendar does not contain %q:\n%s", expected, calendar)
+		}
+	}
+	if !utf8.ValidString(calendar) {
+		t.Error("Calendar is not valid UTF-8")
+	}
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// DataQualityCheck is a consistency check of the catalog.
+type DataQualityCheck string
+
+const (
+	// CheckOrphanTLEs finds TLEs without a satellite, it runs on the whole catalog.
+	CheckOrphanTLEs DataQualityCheck = "orphan_tles"
+	// CheckSatellitesWithoutTLE finds satellites of a context without any TLE.
+	CheckSatellitesWithoutTLE DataQualityCheck = "satellites_without_tle"
+	// CheckStaleTLEs finds satellites of a context whose current TLE is older than the stale limit.
+	CheckStaleTLEs DataQualityCheck = "stale_tles"
+	// CheckDanglingMappings finds tile mappings of a context pointing to deleted tiles.
+	CheckDanglingMappings DataQualityCheck = "dangling_mappings"
+)
+
+// dataQualitySampleSize is the number of offending keys reported with a finding.
+const dataQualitySampleSize = 5
+
+// DefaultDataQualityStaleAfter is the age after which the current TLE of a satellite is stale.
+const DefaultDataQualityStaleAfter = 30 * 24 * time.Hour
+
+// DataQualityOptions selects the stale limit and the corrective actions of a data quality run.
+// Fix repairs without losing data, that is it creates the satellites of orphan TLEs. Prune removes what cannot
+// be repaired: the orphan TLEs left, the satellites without TLE from their context and the dangling mappings.
+// Stale TLEs are only reported since they are refreshed by the element set ingestion, and orphan TLEs are only
+// reported when the run is restricted to a context.
+type DataQualityOptions struct {
+	StaleAfter time.Duration
+	Fix        bool
+	Prune      bool
+}
+
+// Validate checks the options.
+func (o DataQualityOptions) Validate() error {
+	if o.StaleAfter <= 0 {
+		return errors.New("stale limit must be positive")
+	}
+	return nil
+}
+
+// DataQualityFinding is the result of a check, for a context or for the whole catalog when Context is empty.
+type DataQualityFinding struct {
+	Context GameContextName  `json:"context,omitempty"`
+	Check   DataQualityCheck `json:"check"`
+	Count   int              `json:"count"`
+	Sample  []string         `json:"sample,omitempty"` // First offending keys, NORAD IDs or NORAD ID/tile ID pairs
+	Fixed   int              `json:"fixed"`
+	Pruned  int              `json:"pruned"`
+}
+
+// NewDataQualityFinding creates the finding of a check from the offending keys.
+func NewDataQualityFinding(contextName GameContextName, check DataQualityCheck, keys []string) DataQualityFinding {
+	sample := keys
+	if len(sample) > dataQualitySampleSize {
+		sample = sample[:dataQualitySampleSize]
+	}
+	return DataQualityFinding{
+		Context: contextName,
+		Check:   check,
+		Count:   len(keys),
+		Sample:  sample,
+	}
+}
+
+// DataQualityReport gathers the findings of a data quality run.
+type DataQualityReport struct {
+	GeneratedAt time.Time            `json:"generatedAt"`
+	StaleDays   int                  `json:"staleDays"`
+	Fix         bool                 `json:"fix"`
+	Prune       bool                 `json:"prune"`
+	Findings    []DataQualityFinding `json:"findings"`
+}
+
+// Issues returns the number of issues found, including the fixed and pruned ones.
+func (r DataQualityReport) Issues() int {
+	issues := 0
+	for _, finding := range r.Findings {
+		issues += finding.Count
+	}
+	return issues
+}
+
+// DataQualityRepository defines the consistency queries and the corrective actions of the catalog.
+type DataQualityRepository interface {
+	// FindOrphanTLEs returns the latest TLE of each NORAD ID without a satellite.
+	FindOrphanTLEs(ctx context.Context) ([]TLE, error)
+	DeleteOrphanTLEs(ctx context.Context) (int64, error)
+	FindSatellitesWithoutTLE(ctx context.Context, contextID string) ([]Satellite, error)
+	// FindStaleSatellites returns the NORAD IDs of the satellites of a context whose current TLE has an epoch before the given time.
+	FindStaleSatellites(ctx context.Context, contextID string, before time.Time) ([]string, error)
+	// FindDanglingMappings returns the mappings of a context whose tile does not exist anymore.
+	FindDanglingMappings(ctx context.Context, contextID string) ([]TileSatelliteMapping, error)
+	DeleteDanglingMappings(ctx context.Context, contextID string) (int64, error)
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewDataQualityFinding(t *testing.T) {
+	tests := []struct {
+		name           string
+		keys           []string
+		expectedSample []string
+	}{
+		{name: "no issue"},
+		{name: "fewer keys than the sample", keys: []string{"1", "2"}, expectedSample: []string{"1", "2"}},
+		{name: "sample truncated", keys: []string{"1", "2", "3", "4", "5", "6", "7"}, expectedSample: []string{"1", "2", "3", "4", "5"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			finding := NewDataQualityFinding("context", CheckStaleTLEs, tt.keys)
+			if finding.Context != "context" || finding.Check != CheckStaleTLEs {
+				t.Errorf("Unexpected finding %+v", finding)
+			}
+			if finding.Count != len(tt.keys) || finding.Fixed != 0 || finding.Pruned != 0 {
+				t.Errorf("Expected %d issues and no action, got %+v", len(tt.keys), finding)
+			}
+			if len(finding.Sample) != len(tt.expectedSample) {
+				t.Fatalf("Expected sample %v, got %v", tt.expectedSample, finding.Sample)
+			}
+			for i, key := range tt.expectedSample {
+				if finding.Sample[i] != key {
+					t.Errorf("Expected sample %v, got %v", tt.expectedSample, finding.Sample)
+				}
+			}
+		})
+	}
+}
+
+func TestDataQualityReportIssues(t *testing.T) {
+	report := DataQualityReport{Findings: []DataQualityFinding{
+		NewDataQualityFinding("", CheckOrphanTLEs, []string{"1", "2", "3", "4", "5", "6"}),
+		NewDataQualityFinding("context", CheckStaleTLEs, nil),
+		{Context: "context", Check: CheckDanglingMappings, Count: 2, Pruned: 2},
+	}}
+
+	// Fixed and pruned issues are still counted
+	if issues := report.Issues(); issues != 8 {
+		t.Errorf("Expected 8 issues, got %d", issues)
+	}
+	if issues := (DataQualityReport{}).Issues(); issues != 0 {
+		t.Errorf("Expected no issue in an empty report, got %d", issues)
+	}
+}
+
+func TestDataQualityOptionsValidate(t *testing.T) {
+	if err := (DataQualityOptions{StaleAfter: DefaultDataQualityStaleAfter}).Validate(); err != nil {
+		t.Errorf("Expected the default stale limit to be valid, got %v", err)
+	}
+	for _, staleAfter := range []time.Duration{0, -time.Hour} {
+		if err := (DataQualityOptions{StaleAfter: staleAfter}).Validate(); err == nil {
+			t.Errorf("Expected an error for a stale limit of %v", staleAfter)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+	"github.com/google/uuid"
+)
+
+// DecayPrediction represents the predicted reentry window of a satellite.
+type DecayPrediction struct {
+	ModelBase
+	NoradID     string
+	Name        string
+	Perigee     float64   // Perigee altitude in kilometers at the TLE epoch
+	TleEpoch    time.Time // Epoch of the latest TLE used for the prediction
+	DecayAt     time.Time // Most likely reentry time
+	WindowStart time.Time // Earliest expected reentry time
+	WindowEnd   time.Time // Latest expected reentry time
+	Ballistic   float64   // Effective Cd*A/m in m^2/kg
+	F107        float64   // Solar flux used for the prediction
+	Ap          float64   // Geomagnetic index used for the prediction
+}
+
+// NewDecayPrediction predicts the reentry of a satellite from its TLE history.
+func NewDecayPrediction(satellite Satellite, tles []TLE, weather xspace.SpaceWeather) (DecayPrediction, error) {
+	if len(tles) == 0 {
+		return DecayPrediction{}, errors.New("no TLE available")
+	}
+
+	var history []xspace.MeanElements
+	for _, tle := range tles {
+		elements, err := xspace.ParseMeanElements(tle.Line1, tle.Line2)
+		if err != nil {
+			return DecayPrediction{}, fmt.Errorf("unparsable TLE with epoch %s: %w", tle.Epoch.Format(time.RFC3339), err)
+		}
+		history = append(history, elements)
+	}
+
+	prediction, err := xspace.PredictDecay(history, weather)
+	if err != nil {
+		return DecayPrediction{}, err
+	}
+	latest := history[len(history)-1]
+
+	nowUtc := time.Now().UTC()
+	return DecayPrediction{
+		ModelBase: ModelBase{
+			ID:          uuid.NewString(),
+			CreatedAt:   nowUtc,
+			UpdatedAt:   &nowUtc,
+			DisplayName: satellite.Name,
+			IsActive:    true,
+			ProcessedAt: &nowUtc,
+		},
+		NoradID:     satellite.NoradID,
+		Name:        satellite.Name,
+		Perigee:     latest.PerigeeAltitudeKm(),
+		TleEpoch:    prediction.Epoch,
+		DecayAt:     prediction.Decay,
+		WindowStart: prediction.WindowStart,
+		WindowEnd:   prediction.WindowEnd,
+		Ballistic:   prediction.Ballistic,
+		F107:        weather.F107,
+		Ap:          weather.Ap,
+	}, nil
+}
+
+// DecayPredictionRepository defines the interface for DecayPrediction operations.
+type DecayPredictionRepository interface {
+	SaveBatch(ctx context.Context, predictions []DecayPrediction) error
+	FindUpcoming(ctx context.Context, from time.Time, until time.Time) ([]DecayPrediction, error)
+	FindPassed(ctx context.Context, before time.Time) ([]DecayPrediction, error)
+	DeleteByNoradID(ctx context.Context, noradID string) error
+}
@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ElementSetFetch is a group of element sets fetched from a source, along with the sync state to record
+// and the input files to consume once the TLEs are stored.
+type ElementSetFetch struct {
+	TLEs    []TLE
+	Changed bool            // False when the group did not change since the last recorded fetch
+	State   SyncState       // Last-fetch record of the group
+	Context GameContextName // Context whose source was fetched
+	Files   []string        // Files read from a consuming source, such as a drop directory
+}
+
+// Complete reports whether the fetch holds the whole group, rather than only the files dropped since the last one.
+func (f ElementSetFetch) Complete() bool {
+	return len(f.Files) == 0
+}
+
+// ElementSetPrecedence is the rule deciding which candidate element set of a satellite is current.
+type ElementSetPrecedence string
+
+const (
+	// PrecedenceNewestEpoch selects the candidate with the most recent epoch, whatever its source.
+	PrecedenceNewestEpoch ElementSetPrecedence = "newest"
+	// PrecedencePreferredSource selects the newest candidate of the first preferred source providing one,
+	// and falls back on the newest epoch when none of them does.
+	PrecedencePreferredSource ElementSetPrecedence = "source"
+)
+
+// ElementSetPolicy decides which element set of a satellite is used for propagation.
+// An operator override always takes precedence over the policy.
+type ElementSetPolicy struct {
+	Precedence ElementSetPrecedence `json:"precedence"`
+	Sources    []string             `json:"sources,omitempty"` // Preferred sources, highest priority first
+}
+
+// ParseElementSetPolicy reads a policy written "newest" or "source:spacetrack,celestrak,file".
+// A preferred source matches the sources of the same kind, "file" matching "file:/data/catalog.tle".
+func ParseElementSetPolicy(value string) (ElementSetPolicy, error) {
+	value = strings.TrimSpace(value)
+	kind, sources, _ := strings.Cut(value, ":")
+	switch ElementSetPrecedence(strings.TrimSpace(kind)) {
+	case PrecedenceNewestEpoch, "":
+		return ElementSetPolicy{Precedence: PrecedenceNewestEpoch}, nil
+	case PrecedencePreferredSource:
+		policy := ElementSetPolicy{Precedence: PrecedencePreferredSource}
+		for _, source := range strings.Split(sources, ",") {
+			if source = strings.TrimSpace(source); source != "" {
+				policy.Sources = append(policy.Sources, source)
+			}
+		}
+		if len(policy.Sources) == 0 {
+			return ElementSetPolicy{}, errors.New("source precedence requires at least one source")
+		}
+		return policy, nil
+	default:
+		return ElementSetPolicy{}, fmt.Errorf("unknown element set precedence: %s", kind)
+	}
+}
+
+// Select returns the current element set among the candidates of a satellite. A pinned element set,
+// set by an operator override, wins when it is one of the candidates.
+func (p ElementSetPolicy) Select(candidates []TLE, pinnedID string) (TLE, error) {
+	if len(candidates) == 0 {
+		return TLE{}, errors.New("no candidate element set")
+	}
+	if pinnedID != "" {
+		for _, candidate := range candidates {
+			if candidate.ID == pinnedID {
+				return candidate, nil
+			}
+		}
+	}
+
+	sorted := append([]TLE(nil), candidates...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if p.Precedence == PrecedencePreferredSource {
+			ri, rj := p.sourceRank(sorted[i].Source), p.sourceRank(sorted[j].Source)
+			if ri != rj {
+				return ri < rj
+			}
+		}
+		return sorted[i].Epoch.After(sorted[j].Epoch)
+	})
+	return sorted[0], nil
+}
+
+// sourceRank is the position of a source in the preferred sources, sources not listed rank last.
+func (p ElementSetPolicy) sourceRank(source string) int {
+	for rank, preferred := range p.Sources {
+		if source == preferred || strings.HasPrefix(source, preferred+":") {
+			return rank
+		}
+	}
+	return len(p.Sources)
+}
+
+// ElementSetOverride pins the element set used for propagation of a satellite, regardless of the policy.
+type ElementSetOverride struct {
+	ModelBase
+	NoradID string
+	TleID   string
+	Reason  string
+}
+
+// NewElementSetOverride creates a new ElementSetOverride instance.
+func NewElementSetOverride(noradID string, tleID string, reason string) (ElementSetOverride, error) {
+	if noradID == "" || tleID == "" {
+		return ElementSetOverride{}, errors.New("override requires a NORAD ID and an element set ID")
+	}
+	nowUtc := time.Now().UTC()
+	return ElementSetOverride{
+		ModelBase: ModelBase{
+			ID:          uuid.NewString(),
+			CreatedAt:   nowUtc,
+			UpdatedAt:   &nowUtc,
+			DisplayName: noradID,
+			IsActive:    true,
+			ProcessedAt: &nowUtc,
+		},
+		NoradID: noradID,
+		TleID:   tleID,
+		Reason:  reason,
+	}, nil
+}
+
+// ElementSetOverrideRepository defines the interface for ElementSetOverride operations.
+type ElementSetOverrideRepository interface {
+	// FindByNoradID returns the override of a satellite, or a zero override without error when there is none.
+	FindByNoradID(ctx context.Context, noradID string) (ElementSetOverride, error)
+	// FindByNoradIDs returns the overrides of several satellites by NORAD ID, satellites without one are absent.
+	FindByNoradIDs(ctx context.Context, noradIDs []string) (map[string]ElementSetOverride, error)
+	// Save creates or replaces the override of a satellite.
+	Save(ctx context.Context, override ElementSetOverride) error
+	DeleteByNoradID(ctx context.Context, noradID string) error
+}
+
+// ElementSetCandidates lists the candidate element sets of a satellite, one per source, with the one selected.
+type ElementSetCandidates struct {
+	NoradID    string              `json:"noradId"`
+	Policy     ElementSetPolicy    `json:"policy"`
+	Override   *ElementSetOverride `json:"override,omitempty"`
+	Candidates []TLE               `json:"candidates"`
+	CurrentID  string              `json:"currentId,omitempty"`
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseElementSetPolicy(t *testing.T) {
+	tests := []struct {
+		value           string
+		expected        ElementSetPrecedence
+		expectedSources []string
+		expectError     bool
+	}{
+		{value: "", expected: PrecedenceNewestEpoch},
+		{value: "newest", expected: PrecedenceNewestEpoch},
+		{value: " newest ", expected: PrecedenceNewestEpoch},
+		{value: "source:spacetrack,celestrak,file", expected: PrecedencePreferredSource, expectedSources: []string{"spacetrack", "celestrak", "file"}},
+		{value: "source: spacetrack , ,celestrak", expected: PrecedencePreferredSource, expectedSources: []string{"spacetrack", "celestrak"}},
+		{value: "source", expectError: true},
+		{value: "source:,", expectError: true},
+		{value: "oldest", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			policy, err := ParseElementSetPolicy(tt.value)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("Expected an error, got %+v", policy)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseElementSetPolicy returned an error: %v", err)
+			}
+			if policy.Precedence != tt.expected || strings.Join(policy.Sources, ",") != strings.Join(tt.expectedSources, ",") {
+				t.Errorf("Expected %s %v, got %+v", tt.expected, tt.expectedSources, policy)
+			}
+		})
+	}
+}
+
+func TestElementSetPolicySelect(t *testing.T) {
+	epoch := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	candidate := func(id string, source string, ageHours int) TLE {
+		return TLE{ID: id, NoradID: "25544", Source: source, Epoch: epoch.Add(-time.Duration(ageHours) * time.Hour)}
+	}
+	candidates := []TLE{
+		candidate("celestrak", "celestrak", 2),
+		candidate("spacetrack", "spacetrack", 1),
+		candidate("file", "file:/data/catalog.tle", 0),
+		candidate("synthetic", "synthetic", 5),
+	}
+
+	tests := []struct {
+		name       string
+		policy     ElementSetPolicy
+		candidates []TLE
+		pinnedID   string
+		expected   string
+	}{
+		{name: "newest epoch", policy: ElementSetPolicy{Precedence: PrecedenceNewestEpoch}, candidates: candidates, expected: "file"},
+		{name: "preferred source", policy: ElementSetPolicy{Precedence: PrecedencePreferredSource, Sources: []string{"spacetrack", "celestrak"}}, candidates: candidates, expected: "spacetrack"},
+		{name: "source kind prefix", policy: ElementSetPolicy{Precedence: PrecedencePreferredSource, Sources: []string{"file", "spacetrack"}}, candidates: candidates, expected: "file"},
+		{name: "no preferred source falls back on the newest", policy: ElementSetPolicy{Precedence: PrecedencePreferredSource, Sources: []string{"http"}}, candidates: candidates, expected: "file"},
+		{name: "pinned wins", policy: ElementSetPolicy{Precedence: PrecedenceNewestEpoch}, candidates: candidates, pinnedID: "synthetic", expected: "synthetic"},
+		{name: "unknown pin ignored", policy: ElementSetPolicy{Precedence: PrecedenceNewestEpoch}, candidates: candidates, pinnedID: "deleted", expected: "file"},
+		{
+			name:       "equal epochs keep the candidate order",
+			policy:     ElementSetPolicy{Precedence: PrecedenceNewestEpoch},
+			candidates: []TLE{candidate("first", "celestrak", 0), candidate("second", "spacetrack", 0)},
+			expected:   "first",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected, err := tt.policy.Select(tt.candidates, tt.pinnedID)
+			if err != nil {
+				t.Fatalf("Select returned an error: %v", err)
+			}
+			if selected.ID != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, selected.ID)
+			}
+		})
+	}
+
+	if _, err := (ElementSetPolicy{}).Select(nil, ""); err == nil {
+		t.Error("Expected an error without candidates")
+	}
+}
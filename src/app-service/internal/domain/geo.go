@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+	"github.com/google/uuid"
+)
+
+// GeoStatus represents the station-keeping status of a geosynchronous object.
+type GeoStatus string
+
+const (
+	// GeoStationKept object maintained inside its longitude slot.
+	GeoStationKept GeoStatus = "STATION_KEPT"
+	// GeoDrifting object drifting along the GEO belt.
+	GeoDrifting GeoStatus = "DRIFTING"
+)
+
+const (
+	// geoMaxStationKeptDrift maximum drift rate in degrees per day for a station-kept object.
+	geoMaxStationKeptDrift = 0.05
+	// geoMaxStationKeptSpan maximum longitude excursion in degrees over the history for a station-kept object.
+	geoMaxStationKeptSpan = 0.5
+)
+
+// IsValid checks if the GeoStatus is valid.
+func (s GeoStatus) IsValid() error {
+	switch s {
+	case GeoStationKept, GeoDrifting:
+		return nil
+	default:
+		return errors.New("invalid geo status")
+	}
+}
+
+// GeoObservation represents the state of a GEO object at the epoch of one of its TLEs.
+type GeoObservation struct {
+	ModelBase
+	NoradID     string
+	Epoch       time.Time
+	Longitude   float64 // Subsatellite longitude in degrees
+	DriftRate   float64 // Degrees per day, positive eastward
+	Inclination float64 // Degrees
+}
+
+// NewGeoObservationFromTLE computes the GEO state at the epoch of the TLE.
+func NewGeoObservationFromTLE(tle TLE) (GeoObservation, error) {
+	state, err := xspace.ComputeGeoState(tle.Line1, tle.Line2, tle.Epoch)
+	if err != nil {
+		return GeoObservation{}, err
+	}
+
+	nowUtc := time.Now().UTC()
+	return GeoObservation{
+		ModelBase: ModelBase{
+			ID:          uuid.NewString(),
+			CreatedAt:   nowUtc,
+			UpdatedAt:   &nowUtc,
+			DisplayName: tle.NoradID,
+			IsActive:    true,
+			ProcessedAt: &nowUtc,
+		},
+		NoradID:     tle.NoradID,
+		Epoch:       tle.Epoch,
+		Longitude:   state.Longitude,
+		DriftRate:   state.DriftRate,
+		Inclination: state.Inclination,
+	}, nil
+}
+
+// GeoObject represents the current GEO catalog entry of a satellite.
+type GeoObject struct {
+	ModelBase
+	NoradID       string
+	Name          string
+	Longitude     float64 // Latest subsatellite longitude in degrees
+	DriftRate     float64 // Latest drift rate in degrees per day
+	Inclination   float64 // Latest inclination in degrees
+	LongitudeSpan float64 // Longitude excursion over the history window in degrees
+	Status        GeoStatus
+	Epoch         time.Time // Epoch of the latest observation
+}
+
+// NewGeoObject builds the catalog entry from the observation history of a satellite.
+func NewGeoObject(satellite Satellite, observations []GeoObservation) (GeoObject, error) {
+	if len(observations) == 0 {
+		return GeoObject{}, errors.New("no GEO observations")
+	}
+
+	sort.Slice(observations, func(i, j int) bool {
+		return observations[i].Epoch.Before(observations[j].Epoch)
+	})
+	latest := observations[len(observations)-1]
+
+	// Longitude excursion relative to the latest position, handling the antimeridian
+	minOffset, maxOffset := 0.0, 0.0
+	for _, observation := range observations {
+		offset := xspace.LongitudeDifference(latest.Longitude, observation.Longitude)
+		minOffset = math.Min(minOffset, offset)
+		maxOffset = math.Max(maxOffset, offset)
+	}
+	span := maxOffset - minOffset
+
+	status := GeoDrifting
+	if math.Abs(latest.DriftRate) <= geoMaxStationKeptDrift && span <= geoMaxStationKeptSpan {
+		status = GeoStationKept
+	}
+
+	nowUtc := time.Now().UTC()
+	return GeoObject{
+		ModelBase: ModelBase{
+			ID:          uuid.NewString(),
+			CreatedAt:   nowUtc,
+			UpdatedAt:   &nowUtc,
+			DisplayName: satellite.Name,
+			IsActive:    true,
+			ProcessedAt: &nowUtc,
+		},
+		NoradID:       satellite.NoradID,
+		Name:          satellite.Name,
+		Longitude:     latest.Longitude,
+		DriftRate:     latest.DriftRate,
+		Inclination:   latest.Inclination,
+		LongitudeSpan: span,
+		Status:        status,
+		Epoch:         latest.Epoch,
+	}, nil
+}
+
+// GeoRepository defines the interface for GEO catalog operations.
+type GeoRepository interface {
+	SaveBatch(ctx context.Context, objects []GeoObject) error
+	SaveObservations(ctx context.Context, observations []GeoObservation) error
+	FindNearLongitude(ctx context.Context, longitude float64, tolerance float64) ([]GeoObject, error)
+	FindObservationsByNoradID(ctx context.Context, noradID string, since time.Time) ([]GeoObservation, error)
+	DeleteNotIn(ctx context.Context, noradIDs []string) error
+}
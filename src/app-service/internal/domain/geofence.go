@@ -0,0 +1,218 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xpolygon"
+	"github.com/google/uuid"
+)
+
+// GeofenceEventKind represents the direction of a geofence boundary crossing.
+type GeofenceEventKind string
+
+const (
+	// GeofenceEnter the satellite sub-point moved into the polygon.
+	GeofenceEnter GeofenceEventKind = "ENTER"
+	// GeofenceExit the satellite sub-point moved out of the polygon.
+	GeofenceExit GeofenceEventKind = "EXIT"
+)
+
+// GeoJSONPolygon is a GeoJSON Polygon geometry. Positions are [longitude, latitude] pairs,
+// the first ring is the outer boundary and the following rings are holes.
+type GeoJSONPolygon struct {
+	Type        string        `json:"type"`
+	Coordinates [][][]float64 `json:"coordinates"`
+}
+
+// Validate ensures that the polygon is a well-formed GeoJSON Polygon.
+func (p GeoJSONPolygon) Validate() error {
+	if p.Type != "Polygon" {
+		return fmt.Errorf("invalid GeoJSON geometry type: %s", p.Type)
+	}
+	if len(p.Coordinates) == 0 {
+		return errors.New("polygon requires an outer ring")
+	}
+	for i, ring := range p.Coordinates {
+		if len(ring) < 4 {
+			return fmt.Errorf("ring %d must have at least 4 positions", i)
+		}
+		for _, position := range ring {
+			if len(position) < 2 {
+				return fmt.Errorf("ring %d has a position without longitude and latitude", i)
+			}
+			if position[0] < -180 || position[0] > 180 || position[1] < -90 || position[1] > 90 {
+				return fmt.Errorf("ring %d position out of bounds: [%f, %f]", i, position[0], position[1])
+			}
+		}
+		first, last := ring[0], ring[len(ring)-1]
+		if first[0] != last[0] || first[1] != last[1] {
+			return fmt.Errorf("ring %d must be closed", i)
+		}
+	}
+	return nil
+}
+
+// Contains reports whether the point lies inside the outer ring and outside every hole.
+func (p GeoJSONPolygon) Contains(latitude float64, longitude float64) bool {
+	if len(p.Coordinates) == 0 {
+		return false
+	}
+	point := xpolygon.Point{Latitude: latitude, Longitude: longitude}
+	if !xpolygon.IsPointInPolygon(point, ringPoints(p.Coordinates[0])) {
+		return false
+	}
+	for _, hole := range p.Coordinates[1:] {
+		if xpolygon.IsPointInPolygon(point, ringPoints(hole)) {
+			return false
+		}
+	}
+	return true
+}
+
+// ringPoints converts a GeoJSON linear ring to polygon vertices, dropping the closing position.
+func ringPoints(ring [][]float64) []xpolygon.Point {
+	points := make([]xpolygon.Point, 0, len(ring))
+	for i, position := range ring {
+		if i == len(ring)-1 && len(ring) > 1 {
+			break
+		}
+		points = append(points, xpolygon.Point{Latitude: position[1], Longitude: position[0]})
+	}
+	return points
+}
+
+// Geofence is a user-defined area of a GameContext watched for a set of satellites.
+type Geofence struct {
+	ModelBase
+	ContextID string
+	TenantID  TenantID
+	Name      string
+	Polygon   GeoJSONPolygon
+	NoradIDs  []string // Watched satellites
+}
+
+// NewGeofence creates a new Geofence instance.
+func NewGeofence(contextID string, tenantID TenantID, name string, polygon GeoJSONPolygon, noradIDs []string) (Geofence, error) {
+	nowUtc := time.Now().UTC()
+	geofence := Geofence{
+		ModelBase: ModelBase{
+			ID:          uuid.NewString(),
+			CreatedAt:   nowUtc,
+			UpdatedAt:   &nowUtc,
+			DisplayName: name,
+			IsActive:    true,
+			ProcessedAt: &nowUtc,
+		},
+		ContextID: contextID,
+		TenantID:  tenantID,
+		Name:      name,
+		Polygon:   polygon,
+		NoradIDs:  uniqueNoradIDs(noradIDs),
+	}
+	if err := geofence.Validate(); err != nil {
+		return Geofence{}, err
+	}
+	return geofence, nil
+}
+
+// Validate ensures that the Geofence fields are valid.
+func (g *Geofence) Validate() error {
+	if strings.TrimSpace(g.Name) == "" {
+		return errors.New("geofence name cannot be empty")
+	}
+	if g.ContextID == "" {
+		return errors.New("geofence must belong to a context")
+	}
+	if len(g.NoradIDs) == 0 {
+		return errors.New("geofence must watch at least one satellite")
+	}
+	return g.Polygon.Validate()
+}
+
+// uniqueNoradIDs removes blank and duplicated NORAD IDs while keeping their order.
+func uniqueNoradIDs(noradIDs []string) []string {
+	seen := make(map[string]bool, len(noradIDs))
+	unique := make([]string, 0, len(noradIDs))
+	for _, noradID := range noradIDs {
+		noradID = strings.TrimSpace(noradID)
+		if noradID == "" || seen[noradID] {
+			continue
+		}
+		seen[noradID] = true
+		unique = append(unique, noradID)
+	}
+	return unique
+}
+
+// GeofenceEvent is a predicted crossing of a geofence boundary by a satellite sub-point.
+type GeofenceEvent struct {
+	ModelBase
+	GeofenceID string
+	ContextID  string
+	NoradID    string
+	Kind       GeofenceEventKind
+	OccurredAt time.Time
+	Latitude   float64 // Sub-point at the first sample past the boundary
+	Longitude  float64
+}
+
+// EvaluateGeofenceCrossings walks the positions of a satellite in time order and returns an event
+// each time the sub-point changes side of the geofence boundary. The event is stamped with the first
+// sample past the boundary, so its accuracy is bounded by the sampling step of the positions.
+// No event is emitted for the first sample since the previous state is unknown.
+func EvaluateGeofenceCrossings(geofence Geofence, noradID string, positions []SatellitePosition) []GeofenceEvent {
+	var events []GeofenceEvent
+	if len(positions) == 0 {
+		return events
+	}
+
+	nowUtc := time.Now().UTC()
+	inside := geofence.Polygon.Contains(positions[0].Latitude, positions[0].Longitude)
+	for _, position := range positions[1:] {
+		current := geofence.Polygon.Contains(position.Latitude, position.Longitude)
+		if current == inside {
+			continue
+		}
+		inside = current
+
+		kind := GeofenceExit
+		if current {
+			kind = GeofenceEnter
+		}
+		events = append(events, GeofenceEvent{
+			ModelBase: ModelBase{
+				ID:          uuid.NewString(),
+				CreatedAt:   nowUtc,
+				UpdatedAt:   &nowUtc,
+				DisplayName: fmt.Sprintf("%s %s %s", noradID, kind, geofence.Name),
+				IsActive:    true,
+				ProcessedAt: &nowUtc,
+			},
+			GeofenceID: geofence.ID,
+			ContextID:  geofence.ContextID,
+			NoradID:    noradID,
+			Kind:       kind,
+			OccurredAt: position.Timestamp,
+			Latitude:   position.Latitude,
+			Longitude:  position.Longitude,
+		})
+	}
+	return events
+}
+
+// GeofenceRepository defines the interface for Geofence and GeofenceEvent operations.
+// Geofence lookups are scoped to a context and its tenant.
+type GeofenceRepository interface {
+	Save(ctx context.Context, geofence Geofence) error
+	Update(ctx context.Context, geofence Geofence) error
+	FindByID(ctx context.Context, contextID string, tenantID TenantID, id string) (Geofence, error)
+	FindAllByContext(ctx context.Context, contextID string, tenantID TenantID) ([]Geofence, error)
+	FindByNoradID(ctx context.Context, noradID string) ([]Geofence, error)
+	DeleteByID(ctx context.Context, contextID string, tenantID TenantID, id string) error
+	ReplaceEvents(ctx context.Context, geofenceID string, noradID string, start time.Time, end time.Time, events []GeofenceEvent) error
+	FindEvents(ctx context.Context, geofenceID string, start time.Time, end time.Time) ([]GeofenceEvent, error)
+}
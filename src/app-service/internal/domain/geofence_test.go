@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+var geofenceStart = time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+// squareRing returns a closed ring of a square centered on the given point, positions being [longitude, latitude].
+func squareRing(longitude float64, latitude float64, halfSide float64) [][]float64 {
+	return [][]float64{
+		{longitude - halfSide, latitude - halfSide},
+		{longitude + halfSide, latitude - halfSide},
+		{longitude + halfSide, latitude + halfSide},
+		{longitude - halfSide, latitude + halfSide},
+		{longitude - halfSide, latitude - halfSide},
+	}
+}
+
+// squareWithHole is a 20 degree square around (0, 0) with a 4 degree hole in its middle.
+var squareWithHole = GeoJSONPolygon{Type: "Polygon", Coordinates: [][][]float64{squareRing(0, 0, 10), squareRing(0, 0, 2)}}
+
+func TestGeoJSONPolygonContains(t *testing.T) {
+	tests := []struct {
+		name      string
+		polygon   GeoJSONPolygon
+		latitude  float64
+		longitude float64
+		expected  bool
+	}{
+		{name: "inside the outer ring", polygon: squareWithHole, latitude: 5, longitude: 5, expected: true},
+		{name: "outside the outer ring", polygon: squareWithHole, latitude: 15, longitude: 5},
+		{name: "inside the hole", polygon: squareWithHole, latitude: 1, longitude: -1},
+		{name: "between the hole and the outer ring", polygon: squareWithHole, latitude: 0, longitude: 3, expected: true},
+		{
+			name:      "inside one of two holes",
+			polygon:   GeoJSONPolygon{Type: "Polygon", Coordinates: [][][]float64{squareRing(0, 0, 10), squareRing(-5, -5, 2), squareRing(5, 5, 2)}},
+			latitude:  5,
+			longitude: 5,
+		},
+		{
+			name:      "concave ring notch",
+			polygon:   GeoJSONPolygon{Type: "Polygon", Coordinates: [][][]float64{{{0, 0}, {10, 0}, {10, 10}, {5, 2}, {0, 10}, {0, 0}}}},
+			latitude:  8,
+			longitude: 5,
+		},
+		{name: "empty polygon", polygon: GeoJSONPolygon{Type: "Polygon"}, latitude: 0, longitude: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if contains := tt.polygon.Contains(tt.latitude, tt.longitude); contains != tt.expected {
+				t.Errorf("Expected %v for (%v, %v), got %v", tt.expected, tt.latitude, tt.longitude, contains)
+			}
+		})
+	}
+}
+
+func TestGeoJSONPolygonValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		polygon     GeoJSONPolygon
+		expectError bool
+	}{
+		{name: "polygon with a hole", polygon: squareWithHole},
+		{name: "wrong geometry type", polygon: GeoJSONPolygon{Type: "MultiPolygon", Coordinates: squareWithHole.Coordinates}, expectError: true},
+		{name: "no ring", polygon: GeoJSONPolygon{Type: "Polygon"}, expectError: true},
+		{name: "ring too short", polygon: GeoJSONPolygon{Type: "Polygon", Coordinates: [][][]float64{{{0, 0}, {1, 0}, {0, 0}}}}, expectError: true},
+		{name: "open ring", polygon: GeoJSONPolygon{Type: "Polygon", Coordinates: [][][]float64{{{0, 0}, {1, 0}, {1, 1}, {0, 1}}}}, expectError: true},
+		{name: "latitude out of bounds", polygon: GeoJSONPolygon{Type: "Polygon", Coordinates: [][][]float64{squareRing(0, 89, 2)}}, expectError: true},
+		{name: "position without latitude", polygon: GeoJSONPolygon{Type: "Polygon", Coordinates: [][][]float64{{{0, 0}, {1}, {1, 1}, {0, 0}}}}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.polygon.Validate()
+			if tt.expectError && err == nil {
+				t.Error("Expected an error")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Validate returned an error: %v", err)
+			}
+		})
+	}
+}
+
+// geofenceTrack samples sub-points along the equator, one sample per minute.
+func geofenceTrack(longitudes ...float64) []SatellitePosition {
+	positions := make([]SatellitePosition, len(longitudes))
+	for i, longitude := range longitudes {
+		positions[i] = SatellitePosition{Longitude: longitude, Timestamp: geofenceStart.Add(time.Duration(i) * time.Minute)}
+	}
+	return positions
+}
+
+func TestEvaluateGeofenceCrossings(t *testing.T) {
+	geofence := Geofence{ModelBase: ModelBase{ID: "fence"}, ContextID: "context", Name: "Square", Polygon: squareWithHole, NoradIDs: []string{"25544"}}
+
+	tests := []struct {
+		name          string
+		positions     []SatellitePosition
+		expected      []GeofenceEventKind
+		expectedTimes []int // Minutes after the start
+	}{
+		{name: "no position"},
+		{name: "single sample inside", positions: geofenceTrack(5)},
+		// The first sample only sets the initial state
+		{name: "starting inside", positions: geofenceTrack(5, 6, 7)},
+		{name: "starting inside then leaving", positions: geofenceTrack(5, 12), expected: []GeofenceEventKind{GeofenceExit}, expectedTimes: []int{1}},
+		{name: "entering", positions: geofenceTrack(-15, -12, -8), expected: []GeofenceEventKind{GeofenceEnter}, expectedTimes: []int{2}},
+		{name: "repeated samples inside", positions: geofenceTrack(-15, -8, -8, -7, -6, 15), expected: []GeofenceEventKind{GeofenceEnter, GeofenceExit}, expectedTimes: []int{1, 5}},
+		{
+			name:          "crossing the hole",
+			positions:     geofenceTrack(-15, -5, 0, 5, 15),
+			expected:      []GeofenceEventKind{GeofenceEnter, GeofenceExit, GeofenceEnter, GeofenceExit},
+			expectedTimes: []int{1, 2, 3, 4},
+		},
+		{name: "staying outside", positions: geofenceTrack(-30, -20, 20, 30)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events := EvaluateGeofenceCrossings(geofence, "25544", tt.positions)
+			if len(events) != len(tt.expected) {
+				t.Fatalf("Expected %d events, got %d: %+v", len(tt.expected), len(events), events)
+			}
+			for i, event := range events {
+				if event.Kind != tt.expected[i] {
+					t.Errorf("Event %d is %s, expected %s", i, event.Kind, tt.expected[i])
+				}
+				if expectedAt := geofenceStart.Add(time.Duration(tt.expectedTimes[i]) * time.Minute); !event.OccurredAt.Equal(expectedAt) {
+					t.Errorf("Event %d occurred at %v, expected %v", i, event.OccurredAt, expectedAt)
+				}
+				if event.GeofenceID != "fence" || event.ContextID != "context" || event.NoradID != "25544" || event.ID == "" {
+					t.Errorf("Event %d not linked to its geofence: %+v", i, event)
+				}
+			}
+		})
+	}
+}
+
+func TestNewGeofence(t *testing.T) {
+	geofence, err := NewGeofence("context", "tenant", "Square", squareWithHole, []string{" 25544 ", "", "25544", "20580"})
+	if err != nil {
+		t.Fatalf("NewGeofence returned an error: %v", err)
+	}
+	if len(geofence.NoradIDs) != 2 || geofence.NoradIDs[0] != "25544" || geofence.NoradIDs[1] != "20580" {
+		t.Errorf("Expected unique NORAD IDs, got %v", geofence.NoradIDs)
+	}
+
+	if _, err := NewGeofence("context", "tenant", "Square", squareWithHole, []string{" "}); err == nil {
+		t.Error("Expected an error without watched satellites")
+	}
+	if _, err := NewGeofence("", "tenant", "Square", squareWithHole, []string{"25544"}); err == nil {
+		t.Error("Expected an error without context")
+	}
+}
@@ -0,0 +1,218 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+	"github.com/google/uuid"
+)
+
+// Band represents a radio frequency band supported by a ground station.
+type Band string
+
+const (
+	BandVHF Band = "VHF"
+	BandUHF Band = "UHF"
+	BandL   Band = "L"
+	BandS   Band = "S"
+	BandC   Band = "C"
+	BandX   Band = "X"
+	BandKu  Band = "KU"
+	BandKa  Band = "KA"
+)
+
+// IsValid checks if the Band is valid.
+func (b Band) IsValid() error {
+	switch b {
+	case BandVHF, BandUHF, BandL, BandS, BandC, BandX, BandKu, BandKa:
+		return nil
+	default:
+		return fmt.Errorf("invalid band: %s", b)
+	}
+}
+
+// GroundStation represents a ground station of a tenant, scoped to a GameContext.
+type GroundStation struct {
+	ModelBase
+	ContextID    string
+	TenantID     TenantID
+	Name         string
+	Latitude     float64            // Degrees
+	Longitude    float64            // Degrees
+	Altitude     float64            // Meters above the ellipsoid
+	MinElevation float64            // Minimum usable elevation in degrees
+	HorizonMask  xspace.HorizonMask // Azimuth-dependent terrain mask
+	Bands        []Band
+	Refraction   *xspace.Atmosphere // Local conditions for the refraction correction, nil for geometric elevations
+}
+
+// NewGroundStation creates a new GroundStation instance.
+func NewGroundStation(
+	contextID string,
+	tenantID TenantID,
+	name string,
+	latitude float64,
+	longitude float64,
+	altitude float64,
+	minElevation float64,
+	horizonMask xspace.HorizonMask,
+	bands []Band,
+	refraction *xspace.Atmosphere,
+) (GroundStation, error) {
+	nowUtc := time.Now().UTC()
+	station := GroundStation{
+		ModelBase: ModelBase{
+			ID:          uuid.NewString(),
+			CreatedAt:   nowUtc,
+			UpdatedAt:   &nowUtc,
+			DisplayName: name,
+			IsActive:    true,
+			ProcessedAt: &nowUtc,
+			IsFavourite: false,
+		},
+		ContextID:    contextID,
+		TenantID:     tenantID,
+		Name:         name,
+		Latitude:     latitude,
+		Longitude:    longitude,
+		Altitude:     altitude,
+		MinElevation: minElevation,
+		HorizonMask:  horizonMask,
+		Bands:        bands,
+		Refraction:   refraction,
+	}
+	if err := station.Validate(); err != nil {
+		return GroundStation{}, err
+	}
+	return station, nil
+}
+
+// Validate ensures that the GroundStation fields are valid.
+func (s *GroundStation) Validate() error {
+	if strings.TrimSpace(s.Name) == "" {
+		return errors.New("ground station name cannot be empty")
+	}
+	if s.ContextID == "" {
+		return errors.New("ground station must belong to a context")
+	}
+	if s.Latitude < -90 || s.Latitude > 90 {
+		return fmt.Errorf("latitude out of bounds: %f", s.Latitude)
+	}
+	if s.Longitude < -180 || s.Longitude > 180 {
+		return fmt.Errorf("longitude out of bounds: %f", s.Longitude)
+	}
+	if s.MinElevation < -5 || s.MinElevation >= 90 {
+		return fmt.Errorf("minimum elevation out of bounds: %f", s.MinElevation)
+	}
+	if err := s.HorizonMask.Validate(); err != nil {
+		return err
+	}
+	if s.Refraction != nil {
+		if err := s.Refraction.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, band := range s.Bands {
+		if err := band.IsValid(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Observer returns the station location and atmosphere as an xspace observer.
+func (s *GroundStation) Observer() xspace.Observer {
+	return xspace.Observer{
+		Latitude:   s.Latitude,
+		Longitude:  s.Longitude,
+		AltitudeKm: s.Altitude / 1000,
+		Refraction: s.Refraction,
+	}
+}
+
+// SupportsBand checks if the station supports the given band.
+func (s *GroundStation) SupportsBand(band Band) bool {
+	for _, b := range s.Bands {
+		if b == band {
+			return true
+		}
+	}
+	return false
+}
+
+// GroundStationPass represents a satellite pass over a ground station.
+type GroundStationPass struct {
+	StationID    string
+	StationName  string
+	NoradID      string
+	AOS          time.Time
+	TCA          time.Time
+	LOS          time.Time
+	MaxElevation float64
+	AOSAzimuth   float64
+	LOSAzimuth   float64
+	// Closest approaches to the Sun and the Moon in degrees, set by FlagInterference
+	MinSunSeparation  *float64
+	MinMoonSeparation *float64
+	NearSun           bool // The satellite came within the interference threshold of the Sun
+	NearMoon          bool // The satellite came within the interference threshold of the Moon
+	// Active transmitters of the satellite with a downlink in the bands of the station, set by the service
+	Transmitters []Transmitter
+}
+
+// PredictPasses computes the passes of a satellite over the station, honoring its minimum elevation and terrain mask.
+// Elevations are corrected for refraction when the station has an atmosphere.
+func (s *GroundStation) PredictPasses(tle TLE, start time.Time, end time.Time, step time.Duration) ([]GroundStationPass, error) {
+	passes, err := xspace.PredictPasses(tle.Line1, tle.Line2, s.Observer(), s.MinElevation, s.HorizonMask, start, end, step)
+	if err != nil {
+		return nil, err
+	}
+
+	stationPasses := make([]GroundStationPass, len(passes))
+	for i, pass := range passes {
+		stationPasses[i] = GroundStationPass{
+			StationID:    s.ID,
+			StationName:  s.Name,
+			NoradID:      tle.NoradID,
+			AOS:          pass.AOS,
+			TCA:          pass.TCA,
+			LOS:          pass.LOS,
+			MaxElevation: pass.MaxElevation,
+			AOSAzimuth:   pass.AOSAzimuth,
+			LOSAzimuth:   pass.LOSAzimuth,
+		}
+	}
+	return stationPasses, nil
+}
+
+// FlagInterference sets the closest approaches of each pass to the Sun and the Moon, and flags the passes
+// coming within thresholdDeg degrees of either body, where radio and optical links suffer interference.
+func (s *GroundStation) FlagInterference(tle TLE, passes []GroundStationPass, thresholdDeg float64, step time.Duration) error {
+	observer := s.Observer()
+	for i := range passes {
+		pass := xspace.Pass{AOS: passes[i].AOS, TCA: passes[i].TCA, LOS: passes[i].LOS}
+		interference, err := xspace.ComputePassInterference(tle.Line1, tle.Line2, observer, pass, step)
+		if err != nil {
+			return fmt.Errorf("failed to compute interference of pass at %v: %w", passes[i].AOS, err)
+		}
+		passes[i].MinSunSeparation = &interference.MinSunSeparation
+		passes[i].MinMoonSeparation = &interference.MinMoonSeparation
+		passes[i].NearSun = interference.NearSun(thresholdDeg)
+		passes[i].NearMoon = interference.NearMoon(thresholdDeg)
+	}
+	return nil
+}
+
+// GroundStationRepository defines the interface for GroundStation operations.
+// All lookups are scoped to a context and its tenant.
+type GroundStationRepository interface {
+	Save(ctx context.Context, station GroundStation) error
+	Update(ctx context.Context, station GroundStation) error
+	FindByID(ctx context.Context, contextID string, tenantID TenantID, id string) (GroundStation, error)
+	FindAllByContext(ctx context.Context, contextID string, tenantID TenantID) ([]GroundStation, error)
+	DeleteByID(ctx context.Context, contextID string, tenantID TenantID, id string) error
+}
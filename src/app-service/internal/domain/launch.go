@@ -0,0 +1,210 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// cosparPattern matches the catalog form of an international designator, for example "1999-025A".
+	cosparPattern = regexp.MustCompile(`^(\d{4})-(\d{3})([A-Z]{0,3})$`)
+	// cosparShortPattern matches the TLE form of an international designator, for example "99025A".
+	cosparShortPattern = regexp.MustCompile(`^(\d{2})(\d{3})([A-Z]{0,3})$`)
+)
+
+// firstLaunchYear is the year of Sputnik 1, two digit years below it belong to the 21st century.
+const firstLaunchYear = 1957
+
+// CosparID is a parsed international designator: the launch year, the number of the launch in that year
+// and the piece of the launch, "A" usually being the primary payload.
+type CosparID struct {
+	Year         int
+	LaunchNumber int
+	Piece        string
+}
+
+// ParseCosparID parses an international designator in the catalog form "1999-025A" or the TLE form "99025A".
+// A designator without a piece identifies a launch.
+func ParseCosparID(designator string) (CosparID, error) {
+	value := strings.ToUpper(strings.TrimSpace(designator))
+
+	if match := cosparPattern.FindStringSubmatch(value); match != nil {
+		year, _ := strconv.Atoi(match[1])
+		number, _ := strconv.Atoi(match[2])
+		return newCosparID(year, number, match[3], designator)
+	}
+	if match := cosparShortPattern.FindStringSubmatch(value); match != nil {
+		year, _ := strconv.Atoi(match[1])
+		if year < firstLaunchYear%100 {
+			year += 2000
+		} else {
+			year += 1900
+		}
+		number, _ := strconv.Atoi(match[2])
+		return newCosparID(year, number, match[3], designator)
+	}
+	return CosparID{}, fmt.Errorf("invalid international designator %q", designator)
+}
+
+func newCosparID(year int, number int, piece string, designator string) (CosparID, error) {
+	if year < firstLaunchYear || number == 0 {
+		return CosparID{}, fmt.Errorf("invalid international designator %q", designator)
+	}
+	return CosparID{Year: year, LaunchNumber: number, Piece: piece}, nil
+}
+
+// Launch returns the designator of the launch, for example "1999-025".
+func (c CosparID) Launch() string {
+	return LaunchDesignator(c.Year, c.LaunchNumber)
+}
+
+// String returns the designator in the catalog form.
+func (c CosparID) String() string {
+	return c.Launch() + c.Piece
+}
+
+// LaunchDesignator formats the designator of a launch.
+func LaunchDesignator(year int, number int) string {
+	return fmt.Sprintf("%04d-%03d", year, number)
+}
+
+// SetIntlDesignator sets the international designator of a satellite and the launch it identifies.
+// The launch is cleared when the designator cannot be parsed, as for analyst objects.
+func (s *Satellite) SetIntlDesignator(designator string) {
+	s.IntlDesignator = designator
+	s.LaunchYear, s.LaunchNumber, s.LaunchPiece = 0, 0, ""
+	if cospar, err := ParseCosparID(designator); err == nil {
+		s.LaunchYear = cospar.Year
+		s.LaunchNumber = cospar.LaunchNumber
+		s.LaunchPiece = cospar.Piece
+	}
+}
+
+// ErrLaunchNotFound reports that no object of a launch is cataloged.
+var ErrLaunchNotFound = errors.New("launch not found")
+
+// Launch summarizes the cataloged objects of a launch.
+type Launch struct {
+	Designator   string     `json:"designator"`
+	Year         int        `json:"year"`
+	Number       int        `json:"number"`
+	LaunchDate   *time.Time `json:"launchDate,omitempty"`
+	LaunchSite   string     `json:"launchSite,omitempty"`
+	Objects      int        `json:"objects"`
+	Payloads     int        `json:"payloads"`
+	RocketBodies int        `json:"rocketBodies"`
+	Debris       int        `json:"debris"`
+	Decayed      int        `json:"decayed"`
+}
+
+// LaunchRepository defines the interface for the launches view of the satellite catalog.
+type LaunchRepository interface {
+	// FindLaunches returns the launches of a year, or of all years when year is zero, most recent first.
+	FindLaunches(ctx context.Context, year int, page int, pageSize int) ([]Launch, int64, error)
+	// FindLaunch returns the summary of a launch, zero if no object of the launch is cataloged.
+	FindLaunch(ctx context.Context, year int, number int) (Launch, error)
+	// FindLaunchObjects returns the satellites of a launch ordered by piece.
+	FindLaunchObjects(ctx context.Context, year int, number int) ([]Satellite, error)
+	// FindLaunchesWithNewPieces returns the launches with at least minPieces objects cataloged since the given time.
+	FindLaunchesWithNewPieces(ctx context.Context, since time.Time, minPieces int) ([]CosparID, error)
+}
+
+// FragmentationPolicy decides when new pieces of a launch are flagged as a fragmentation event.
+type FragmentationPolicy struct {
+	MinPieces int           // Number of new pieces that triggers an event
+	Window    time.Duration // Period in which the new pieces have to be cataloged
+}
+
+// DefaultFragmentationPolicy flags ten new pieces of a launch cataloged within a week.
+var DefaultFragmentationPolicy = FragmentationPolicy{MinPieces: 10, Window: 7 * 24 * time.Hour}
+
+// Validate checks the policy.
+func (p FragmentationPolicy) Validate() error {
+	if p.MinPieces < 2 {
+		return errors.New("fragmentation policy requires at least two pieces")
+	}
+	if p.Window <= 0 {
+		return errors.New("fragmentation policy requires a positive window")
+	}
+	return nil
+}
+
+// FragmentationEvent flags a burst of new pieces cataloged for a launch, the usual sign of a breakup.
+type FragmentationEvent struct {
+	ModelBase    `json:"-"`
+	Launch       string    `json:"launch"`       // Launch designator, for example "1999-025"
+	LaunchYear   int       `json:"launchYear"`   // Year of the launch
+	LaunchNumber int       `json:"launchNumber"` // Number of the launch in its year
+	NewPieces    int       `json:"newPieces"`    // Number of pieces cataloged in the window
+	NoradIDs     []string  `json:"noradIds"`     // NORAD IDs of the new pieces
+	WindowStart  time.Time `json:"windowStart"`  // Creation time of the first new piece
+	WindowEnd    time.Time `json:"windowEnd"`    // Creation time of the last new piece
+	DetectedAt   time.Time `json:"detectedAt"`
+}
+
+// DetectFragmentation checks the objects of a launch for a fragmentation at the given time. New pieces are
+// the objects cataloged within the policy window. A launch is flagged only when it has enough new pieces and
+// was already cataloged before the window, so that a recent launch or a first catalog import is not mistaken
+// for a breakup.
+func DetectFragmentation(cospar CosparID, objects []Satellite, policy FragmentationPolicy, at time.Time) (FragmentationEvent, bool) {
+	since := at.Add(-policy.Window)
+
+	var pieces []Satellite
+	cataloged := false
+	for _, object := range objects {
+		if object.CreatedAt.Before(since) {
+			cataloged = true
+			continue
+		}
+		pieces = append(pieces, object)
+	}
+	if !cataloged || len(pieces) < policy.MinPieces {
+		return FragmentationEvent{}, false
+	}
+
+	sort.Slice(pieces, func(i, j int) bool {
+		return pieces[i].CreatedAt.Before(pieces[j].CreatedAt)
+	})
+	noradIDs := make([]string, 0, len(pieces))
+	for _, piece := range pieces {
+		noradIDs = append(noradIDs, piece.NoradID)
+	}
+
+	designator := cospar.Launch()
+	detectedAt := at.UTC()
+	return FragmentationEvent{
+		ModelBase: ModelBase{
+			ID:          uuid.NewString(),
+			CreatedAt:   detectedAt,
+			UpdatedAt:   &detectedAt,
+			DisplayName: designator,
+			IsActive:    true,
+			ProcessedAt: &detectedAt,
+		},
+		Launch:       designator,
+		LaunchYear:   cospar.Year,
+		LaunchNumber: cospar.LaunchNumber,
+		NewPieces:    len(pieces),
+		NoradIDs:     noradIDs,
+		WindowStart:  pieces[0].CreatedAt,
+		WindowEnd:    pieces[len(pieces)-1].CreatedAt,
+		DetectedAt:   detectedAt,
+	}, true
+}
+
+// FragmentationEventRepository defines the interface for FragmentationEvent operations.
+type FragmentationEventRepository interface {
+	// FindOpenByLaunch returns the event of a launch whose window ended after the given time, zero if none.
+	FindOpenByLaunch(ctx context.Context, launch string, since time.Time) (FragmentationEvent, error)
+	// FindSince returns the events detected after the given time, most recent first.
+	FindSince(ctx context.Context, since time.Time) ([]FragmentationEvent, error)
+	Save(ctx context.Context, event FragmentationEvent) error
+}
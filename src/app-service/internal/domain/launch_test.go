@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCosparID(t *testing.T) {
+	tests := []struct {
+		name        string
+		designator  string
+		expected    CosparID
+		expectError bool
+	}{
+		{name: "catalog form", designator: "1999-025A", expected: CosparID{Year: 1999, LaunchNumber: 25, Piece: "A"}},
+		{name: "catalog form with a long piece", designator: "2019-036ABC", expected: CosparID{Year: 2019, LaunchNumber: 36, Piece: "ABC"}},
+		{name: "launch without piece", designator: "1998-067", expected: CosparID{Year: 1998, LaunchNumber: 67}},
+		{name: "lower case and spaces", designator: " 1998-067a ", expected: CosparID{Year: 1998, LaunchNumber: 67, Piece: "A"}},
+		{name: "TLE form", designator: "98067A", expected: CosparID{Year: 1998, LaunchNumber: 67, Piece: "A"}},
+		{name: "TLE form of the first launch year", designator: "57001B", expected: CosparID{Year: 1957, LaunchNumber: 1, Piece: "B"}},
+		{name: "TLE form before the pivot year", designator: "56001A", expected: CosparID{Year: 2056, LaunchNumber: 1, Piece: "A"}},
+		{name: "TLE form of this century", designator: "24001A", expected: CosparID{Year: 2024, LaunchNumber: 1, Piece: "A"}},
+		{name: "TLE form without piece", designator: "00001", expected: CosparID{Year: 2000, LaunchNumber: 1}},
+		{name: "catalog form before the first launch", designator: "1956-001A", expectError: true},
+		{name: "launch number zero", designator: "1999-000A", expectError: true},
+		{name: "piece too long", designator: "1999-025ABCD", expectError: true},
+		{name: "analyst object", designator: "", expectError: true},
+		{name: "unknown form", designator: "1999/025A", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cospar, err := ParseCosparID(tt.designator)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("Expected an error, got %+v", cospar)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseCosparID returned an error: %v", err)
+			}
+			if cospar != tt.expected {
+				t.Errorf("Expected %+v, got %+v", tt.expected, cospar)
+			}
+		})
+	}
+
+	if cospar, _ := ParseCosparID("99025A"); cospar.String() != "1999-025A" || cospar.Launch() != "1999-025" {
+		t.Errorf("Unexpected designators %s and %s", cospar.String(), cospar.Launch())
+	}
+}
+
+func launchObjects(at time.Time, ages ...time.Duration) []Satellite {
+	objects := make([]Satellite, len(ages))
+	for i, age := range ages {
+		objects[i] = Satellite{ModelBase: ModelBase{CreatedAt: at.Add(-age)}, NoradID: string(rune('A' + i))}
+	}
+	return objects
+}
+
+func TestDetectFragmentation(t *testing.T) {
+	at := time.Date(2024, time.March, 8, 12, 0, 0, 0, time.UTC)
+	cospar := CosparID{Year: 1999, LaunchNumber: 25}
+	policy := FragmentationPolicy{MinPieces: 3, Window: 7 * 24 * time.Hour}
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name           string
+		objects        []Satellite
+		expected       bool
+		expectedPieces int
+	}{
+		{name: "new pieces of an old launch", objects: launchObjects(at, 900*day, 3*day, 1*day, 2*day), expected: true, expectedPieces: 3},
+		{name: "too few new pieces", objects: launchObjects(at, 900*day, 3*day, 1*day)},
+		{name: "recent launch cataloged within the window", objects: launchObjects(at, 6*day, 3*day, 1*day, 2*day)},
+		{name: "first catalog import", objects: launchObjects(at, 0, 0, 0, 0)},
+		{name: "piece cataloged right at the window start", objects: launchObjects(at, 900*day, 7*day, 1*day, 2*day), expected: true, expectedPieces: 3},
+		{name: "pieces cataloged before the window", objects: launchObjects(at, 900*day, 8*day, 9*day, 10*day, 1*day)},
+		{name: "no object"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, ok := DetectFragmentation(cospar, tt.objects, policy, at)
+			if ok != tt.expected {
+				t.Fatalf("Expected detection %v, got %v", tt.expected, ok)
+			}
+			if !ok {
+				return
+			}
+			if event.NewPieces != tt.expectedPieces || len(event.NoradIDs) != tt.expectedPieces {
+				t.Errorf("Expected %d new pieces, got %d: %v", tt.expectedPieces, event.NewPieces, event.NoradIDs)
+			}
+			if event.Launch != "1999-025" || event.LaunchYear != 1999 || event.LaunchNumber != 25 {
+				t.Errorf("Unexpected launch %+v", event)
+			}
+			if !event.DetectedAt.Equal(at) || !event.CreatedAt.Equal(at) {
+				t.Errorf("Expected the event detected at %v, got %v created at %v", at, event.DetectedAt, event.CreatedAt)
+			}
+			if event.WindowStart.After(event.WindowEnd) {
+				t.Errorf("Unordered window %v - %v", event.WindowStart, event.WindowEnd)
+			}
+		})
+	}
+
+	// The pieces are listed in cataloging order
+	event, _ := DetectFragmentation(cospar, launchObjects(at, 900*day, 1*day, 3*day, 2*day), policy, at)
+	if len(event.NoradIDs) != 3 || event.NoradIDs[0] != "C" || event.NoradIDs[2] != "B" {
+		t.Errorf("Expected pieces C, D, B, got %v", event.NoradIDs)
+	}
+	if !event.WindowStart.Equal(at.Add(-3*day)) || !event.WindowEnd.Equal(at.Add(-1*day)) {
+		t.Errorf("Unexpected window %v - %v", event.WindowStart, event.WindowEnd)
+	}
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+	"github.com/google/uuid"
+)
+
+// LinkWindow represents an interval during which two satellites of a context can see each other.
+// The pair is stored ordered, with NoradIDA lower than NoradIDB.
+type LinkWindow struct {
+	ModelBase
+	ContextID  string
+	NoradIDA   string
+	NoradIDB   string
+	Start      time.Time
+	End        time.Time
+	MinRangeKm float64
+	MaxRangeKm float64
+}
+
+// NewLinkWindows computes the link windows between two satellites from their TLEs.
+func NewLinkWindows(contextID string, tleA TLE, tleB TLE, grazingAltitudeKm float64, maxRangeKm float64, start time.Time, end time.Time, step time.Duration) ([]LinkWindow, error) {
+	if tleB.NoradID < tleA.NoradID {
+		tleA, tleB = tleB, tleA
+	}
+
+	windows, err := xspace.ComputeLinkWindows(tleA.Line1, tleA.Line2, tleB.Line1, tleB.Line2, grazingAltitudeKm, maxRangeKm, start, end, step)
+	if err != nil {
+		return nil, err
+	}
+
+	nowUtc := time.Now().UTC()
+	links := make([]LinkWindow, len(windows))
+	for i, window := range windows {
+		links[i] = LinkWindow{
+			ModelBase: ModelBase{
+				ID:          uuid.NewString(),
+				CreatedAt:   nowUtc,
+				UpdatedAt:   &nowUtc,
+				DisplayName: tleA.NoradID + "-" + tleB.NoradID,
+				IsActive:    true,
+				ProcessedAt: &nowUtc,
+			},
+			ContextID:  contextID,
+			NoradIDA:   tleA.NoradID,
+			NoradIDB:   tleB.NoradID,
+			Start:      window.Start,
+			End:        window.End,
+			MinRangeKm: window.MinRangeKm,
+			MaxRangeKm: window.MaxRangeKm,
+		}
+	}
+	return links, nil
+}
+
+// LinkWindowRepository defines the interface for LinkWindow operations.
+type LinkWindowRepository interface {
+	ReplaceForContext(ctx context.Context, contextID string, from time.Time, until time.Time, windows []LinkWindow) error
+	FindByContext(ctx context.Context, contextID string, from time.Time, until time.Time) ([]LinkWindow, error)
+	FindByNoradID(ctx context.Context, contextID string, noradID string, from time.Time, until time.Time) ([]LinkWindow, error)
+}
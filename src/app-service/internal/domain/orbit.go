@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"errors"
+	"math"
+
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+)
+
+// OrbitRegime represents the orbital regime a satellite belongs to.
+type OrbitRegime string
+
+const (
+	// OrbitRegimeLEO low earth orbit, apogee below 2000 km.
+	OrbitRegimeLEO OrbitRegime = "LEO"
+	// OrbitRegimeSSO sun-synchronous low earth orbit.
+	OrbitRegimeSSO OrbitRegime = "SSO"
+	// OrbitRegimeMEO medium earth orbit, between LEO and GEO.
+	OrbitRegimeMEO OrbitRegime = "MEO"
+	// OrbitRegimeGEO geosynchronous orbit.
+	OrbitRegimeGEO OrbitRegime = "GEO"
+	// OrbitRegimeGTO geosynchronous transfer orbit.
+	OrbitRegimeGTO OrbitRegime = "GTO"
+	// OrbitRegimeMolniya highly eccentric 12 hours orbit at critical inclination.
+	OrbitRegimeMolniya OrbitRegime = "MOLNIYA"
+	// OrbitRegimeHEO other highly eccentric orbits.
+	OrbitRegimeHEO OrbitRegime = "HEO"
+	// OrbitRegimeUnknown regime could not be determined.
+	OrbitRegimeUnknown OrbitRegime = "UNKNOWN"
+)
+
+const (
+	leoMaxAltitudeKm       = 2000.0
+	geoAltitudeKm          = 35786.0
+	geoAltitudeToleranceKm = 1000.0
+	gtoApogeeToleranceKm   = 5000.0
+	geoPeriodMinutes       = 1436.1
+	geoPeriodToleranceMin  = 30.0
+	geoMaxEccentricity     = 0.05
+	molniyaPeriodMinutes   = 718.0
+	molniyaPeriodTolerance = 40.0
+	molniyaMinInclination  = 60.0
+	molniyaMaxInclination  = 66.0
+	molniyaMinEccentricity = 0.5
+	heoMinEccentricity     = 0.25
+	ssoInclinationTolDeg   = 1.5
+)
+
+// IsValid checks if the OrbitRegime is valid.
+func (r OrbitRegime) IsValid() error {
+	switch r {
+	case OrbitRegimeLEO, OrbitRegimeSSO, OrbitRegimeMEO, OrbitRegimeGEO, OrbitRegimeGTO, OrbitRegimeMolniya, OrbitRegimeHEO, OrbitRegimeUnknown:
+		return nil
+	default:
+		return errors.New("invalid orbit regime")
+	}
+}
+
+// OrbitalParameters holds the orbit characteristics derived from a TLE.
+type OrbitalParameters struct {
+	Period      float64 // Orbital period in minutes
+	Inclination float64 // Inclination in degrees
+	Apogee      float64 // Apogee altitude in kilometers
+	Perigee     float64 // Perigee altitude in kilometers
+	Regime      OrbitRegime
+}
+
+// NewOrbitalParametersFromTLE derives the orbital parameters and the regime from a TLE.
+func NewOrbitalParametersFromTLE(tle TLE) (OrbitalParameters, error) {
+	elements, err := xspace.ParseMeanElements(tle.Line1, tle.Line2)
+	if err != nil {
+		return OrbitalParameters{}, err
+	}
+
+	params := OrbitalParameters{
+		Period:      elements.PeriodMinutes(),
+		Inclination: elements.Inclination,
+		Apogee:      elements.ApogeeAltitudeKm(),
+		Perigee:     elements.PerigeeAltitudeKm(),
+	}
+	params.Regime = ClassifyOrbit(params.Period, params.Inclination, params.Apogee, params.Perigee, elements.Eccentricity, elements.SemiMajorAxisKm())
+	return params, nil
+}
+
+// ClassifyOrbit determines the orbit regime from the orbit shape.
+// The more specific regimes (Molniya, GTO, GEO, SSO) are checked before the generic altitude bands. Molniya comes
+// first since its apogee, about 39,000 km, falls within the GTO apogee tolerance.
+func ClassifyOrbit(period, inclination, apogee, perigee, eccentricity, semiMajorAxisKm float64) OrbitRegime {
+	if period <= 0 || semiMajorAxisKm <= 0 {
+		return OrbitRegimeUnknown
+	}
+
+	switch {
+	case math.Abs(period-molniyaPeriodMinutes) < molniyaPeriodTolerance &&
+		inclination >= molniyaMinInclination && inclination <= molniyaMaxInclination &&
+		eccentricity >= molniyaMinEccentricity:
+		return OrbitRegimeMolniya
+	case perigee < leoMaxAltitudeKm && math.Abs(apogee-geoAltitudeKm) < gtoApogeeToleranceKm:
+		return OrbitRegimeGTO
+	case math.Abs(period-geoPeriodMinutes) < geoPeriodToleranceMin && eccentricity < geoMaxEccentricity &&
+		math.Abs(perigee-geoAltitudeKm) < geoAltitudeToleranceKm:
+		return OrbitRegimeGEO
+	case eccentricity >= heoMinEccentricity:
+		return OrbitRegimeHEO
+	case apogee < leoMaxAltitudeKm:
+		if ssoInclination, ok := xspace.SunSynchronousInclination(semiMajorAxisKm, eccentricity); ok &&
+			math.Abs(inclination-ssoInclination) < ssoInclinationTolDeg {
+			return OrbitRegimeSSO
+		}
+		return OrbitRegimeLEO
+	case apogee < geoAltitudeKm-geoAltitudeToleranceKm:
+		return OrbitRegimeMEO
+	default:
+		return OrbitRegimeHEO
+	}
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+	"github.com/google/uuid"
+)
+
+// OrbitLightingSample represents the illumination geometry of a satellite orbit at a given time.
+type OrbitLightingSample struct {
+	ModelBase
+	NoradID         string
+	SampledAt       time.Time
+	TleEpoch        time.Time // Epoch of the TLE used for the sample
+	RAAN            float64   // Degrees
+	LTAN            float64   // Mean local time of the ascending node in hours
+	LTDN            float64   // Mean local time of the descending node in hours
+	BetaAngle       float64   // Degrees
+	EclipseFraction float64   // Fraction of the orbit in the Earth shadow
+	EclipseMinutes  float64   // Eclipse duration per orbit in minutes
+}
+
+// NewOrbitLightingSeries samples the orbit lighting of a satellite from its TLE between start and end.
+func NewOrbitLightingSeries(tle TLE, start time.Time, end time.Time, step time.Duration) ([]OrbitLightingSample, error) {
+	elements, err := xspace.ParseMeanElements(tle.Line1, tle.Line2)
+	if err != nil {
+		return nil, err
+	}
+
+	nowUtc := time.Now().UTC()
+	var samples []OrbitLightingSample
+	for t := start; !t.After(end); t = t.Add(step) {
+		lighting := xspace.ComputeOrbitLighting(elements, t)
+		samples = append(samples, OrbitLightingSample{
+			ModelBase: ModelBase{
+				ID:          uuid.NewString(),
+				CreatedAt:   nowUtc,
+				UpdatedAt:   &nowUtc,
+				DisplayName: tle.NoradID,
+				IsActive:    true,
+				ProcessedAt: &nowUtc,
+			},
+			NoradID:         tle.NoradID,
+			SampledAt:       t,
+			TleEpoch:        elements.Epoch,
+			RAAN:            lighting.RAAN,
+			LTAN:            lighting.LTAN,
+			LTDN:            lighting.LTDN,
+			BetaAngle:       lighting.BetaAngle,
+			EclipseFraction: lighting.EclipseFraction,
+			EclipseMinutes:  lighting.EclipseMinutes,
+		})
+	}
+	return samples, nil
+}
+
+// OrbitLightingRepository defines the interface for OrbitLightingSample operations.
+type OrbitLightingRepository interface {
+	SaveBatch(ctx context.Context, samples []OrbitLightingSample) error
+	FindByNoradID(ctx context.Context, noradID string, from time.Time, until time.Time) ([]OrbitLightingSample, error)
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// orbitTLE builds an element set with the given inclination in degrees, eccentricity and mean motion in revolutions per day.
+func orbitTLE(inclination float64, eccentricity float64, meanMotion float64) TLE {
+	return TLE{
+		NoradID: "25544",
+		Line1:   "1 25544U 98067A   24061.50000000  .00016717  00000-0  30270-3 0  9993",
+		Line2:   fmt.Sprintf("2 25544 %8.4f 247.4627 %07.0f 130.5360 325.0288 %11.8f441330", inclination, eccentricity*1e7, meanMotion),
+	}
+}
+
+func TestNewOrbitalParametersFromTLE(t *testing.T) {
+	tests := []struct {
+		name        string
+		tle         TLE
+		expected    OrbitRegime
+		expectError bool
+	}{
+		{name: "ISS", tle: orbitTLE(51.6416, 0.0006703, 15.49815508), expected: OrbitRegimeLEO},
+		{name: "sun-synchronous", tle: orbitTLE(97.6, 0.001, 15.2), expected: OrbitRegimeSSO},
+		{name: "polar but not sun-synchronous", tle: orbitTLE(90, 0.001, 15.2), expected: OrbitRegimeLEO},
+		{name: "GPS", tle: orbitTLE(55, 0.01, 2.0056), expected: OrbitRegimeMEO},
+		{name: "geostationary", tle: orbitTLE(0.05, 0.0002, 1.0027), expected: OrbitRegimeGEO},
+		{name: "geostationary transfer", tle: orbitTLE(27, 0.7285, 2.2795), expected: OrbitRegimeGTO},
+		// Apogee about 39,300 km and perigee about 1,060 km, within the GTO bounds
+		{name: "Molniya", tle: orbitTLE(63.7, 0.72, 2.006), expected: OrbitRegimeMolniya},
+		{name: "Molniya shape outside the critical inclination", tle: orbitTLE(50, 0.72, 2.006), expected: OrbitRegimeGTO},
+		{name: "Tundra", tle: orbitTLE(63.4, 0.27, 1.0027), expected: OrbitRegimeHEO},
+		{name: "lines too short", tle: TLE{Line1: "1 25544U", Line2: "2 25544"}, expectError: true},
+		{name: "zero mean motion", tle: orbitTLE(51.6, 0.0006, 0), expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, err := NewOrbitalParametersFromTLE(tt.tle)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("Expected an error, got %+v", params)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewOrbitalParametersFromTLE returned an error: %v", err)
+			}
+			if params.Regime != tt.expected {
+				t.Errorf("Expected %s, got %s for %+v", tt.expected, params.Regime, params)
+			}
+			if params.Apogee < params.Perigee {
+				t.Errorf("Apogee %v below perigee %v", params.Apogee, params.Perigee)
+			}
+		})
+	}
+
+	iss, err := NewOrbitalParametersFromTLE(orbitTLE(51.6416, 0.0006703, 15.49815508))
+	if err != nil {
+		t.Fatalf("NewOrbitalParametersFromTLE returned an error: %v", err)
+	}
+	if math.Abs(iss.Period-1440/15.49815508) > 1e-6 || iss.Inclination != 51.6416 {
+		t.Errorf("Unexpected period %v or inclination %v", iss.Period, iss.Inclination)
+	}
+	if iss.Perigee < 400 || iss.Apogee > 440 {
+		t.Errorf("Expected the ISS between 400 and 440 km, got %v - %v", iss.Perigee, iss.Apogee)
+	}
+}
+
+func TestClassifyOrbitUnknown(t *testing.T) {
+	if regime := ClassifyOrbit(0, 51.6, 420, 410, 0.0006, 6790); regime != OrbitRegimeUnknown {
+		t.Errorf("Expected %s without a period, got %s", OrbitRegimeUnknown, regime)
+	}
+	if regime := ClassifyOrbit(92.9, 51.6, 420, 410, 0.0006, 0); regime != OrbitRegimeUnknown {
+		t.Errorf("Expected %s without a semi-major axis, got %s", OrbitRegimeUnknown, regime)
+	}
+}
@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+	"github.com/google/uuid"
+)
+
+// OverflightRecord is a continuous stay of a satellite sub-point over an admin area.
+type OverflightRecord struct {
+	NoradID         string    `json:"noradID"`
+	SatelliteName   string    `json:"satelliteName"`
+	AreaCode        string    `json:"areaCode"`
+	AreaName        string    `json:"areaName"`
+	EntryAt         time.Time `json:"entryAt"`
+	ExitAt          time.Time `json:"exitAt"`
+	DurationSeconds float64   `json:"durationSeconds"`
+	Truncated       bool      `json:"truncated"` // The overflight started before or ended after the report window
+}
+
+// OverflightReport lists the overflights of the satellites of a context over a set of admin areas.
+type OverflightReport struct {
+	ModelBase
+	ContextID   string
+	TenantID    TenantID
+	AreaCodes   []string
+	WindowStart time.Time
+	WindowEnd   time.Time
+	StepSeconds int
+	Records     []OverflightRecord
+}
+
+// NewOverflightReport intersects the ground tracks propagated from the TLEs with the admin areas.
+// Entries and exits are stamped with the first sample inside and outside the area, so their accuracy is
+// bounded by the step. Tracks are propagated from the given element sets, so accuracy degrades for windows
+// far from their epochs: pick for each satellite the element set closest to the window, see ClosestTLE.
+func NewOverflightReport(
+	contextID string,
+	tenantID TenantID,
+	areas []AdminArea,
+	satellites []Satellite,
+	tles []TLE,
+	start time.Time,
+	end time.Time,
+	step time.Duration,
+) (OverflightReport, error) {
+	if len(areas) == 0 {
+		return OverflightReport{}, errors.New("overflight report requires at least one admin area")
+	}
+	if !end.After(start) {
+		return OverflightReport{}, errors.New("overflight window end must be after start")
+	}
+	if step <= 0 {
+		return OverflightReport{}, errors.New("overflight step must be greater than zero")
+	}
+
+	names := make(map[string]string, len(satellites))
+	for _, satellite := range satellites {
+		names[satellite.NoradID] = satellite.Name
+	}
+	codes := make([]string, len(areas))
+	for i, area := range areas {
+		codes[i] = area.Code
+	}
+
+	nowUtc := time.Now().UTC()
+	report := OverflightReport{
+		ModelBase: ModelBase{
+			ID:          uuid.NewString(),
+			CreatedAt:   nowUtc,
+			UpdatedAt:   &nowUtc,
+			DisplayName: fmt.Sprintf("Overflights %s - %s", start.Format(time.RFC3339), end.Format(time.RFC3339)),
+			IsActive:    true,
+			ProcessedAt: &nowUtc,
+		},
+		ContextID:   contextID,
+		TenantID:    tenantID,
+		AreaCodes:   codes,
+		WindowStart: start,
+		WindowEnd:   end,
+		StepSeconds: int(step.Seconds()),
+		Records:     []OverflightRecord{},
+	}
+
+	for _, tle := range tles {
+		track, err := xspace.PropagateRange(tle.Line1, tle.Line2, start, end, step)
+		if err != nil {
+			return OverflightReport{}, fmt.Errorf("failed to propagate %s: %w", tle.NoradID, err)
+		}
+		for _, area := range areas {
+			report.Records = append(report.Records, ComputeOverflights(tle.NoradID, names[tle.NoradID], area, track, start, end)...)
+		}
+	}
+
+	sort.Slice(report.Records, func(i, j int) bool {
+		if report.Records[i].NoradID != report.Records[j].NoradID {
+			return report.Records[i].NoradID < report.Records[j].NoradID
+		}
+		return report.Records[i].EntryAt.Before(report.Records[j].EntryAt)
+	})
+	return report, nil
+}
+
+// ComputeOverflights returns the overflights of an area along a ground track sampled in time order.
+// An overflight still open at the end of the track is closed at the window end and flagged as truncated,
+// as is an overflight already in progress at the first sample. Track longitudes are wrapped into [-180, 180)
+// since the propagation does not.
+func ComputeOverflights(noradID string, satelliteName string, area AdminArea, track []xspace.SatellitePosition, start time.Time, end time.Time) []OverflightRecord {
+	var records []OverflightRecord
+	var current *OverflightRecord
+
+	for i, position := range track {
+		inside := area.Contains(position.Latitude, xspace.NormalizeLongitude(position.Longitude))
+		switch {
+		case inside && current == nil:
+			current = &OverflightRecord{
+				NoradID:       noradID,
+				SatelliteName: satelliteName,
+				AreaCode:      area.Code,
+				AreaName:      area.Name,
+				EntryAt:       position.Time,
+				Truncated:     i == 0,
+			}
+		case !inside && current != nil:
+			current.ExitAt = position.Time
+			current.DurationSeconds = current.ExitAt.Sub(current.EntryAt).Seconds()
+			records = append(records, *current)
+			current = nil
+		}
+	}
+
+	if current != nil {
+		current.ExitAt = end
+		current.DurationSeconds = current.ExitAt.Sub(current.EntryAt).Seconds()
+		current.Truncated = true
+		records = append(records, *current)
+	}
+	return records
+}
+
+// TotalDurationByArea sums the overflight durations of each satellite over each area, in seconds.
+func (r *OverflightReport) TotalDurationByArea() map[string]map[string]float64 {
+	totals := make(map[string]map[string]float64)
+	for _, record := range r.Records {
+		if totals[record.AreaCode] == nil {
+			totals[record.AreaCode] = make(map[string]float64)
+		}
+		totals[record.AreaCode][record.NoradID] += record.DurationSeconds
+	}
+	return totals
+}
+
+// OverflightReportRepository defines the interface for OverflightReport operations.
+// Lookups are scoped to a context and its tenant.
+type OverflightReportRepository interface {
+	Save(ctx context.Context, report OverflightReport) error
+	FindByID(ctx context.Context, contextID string, tenantID TenantID, id string) (OverflightReport, error)
+	FindAllByContext(ctx context.Context, contextID string, tenantID TenantID) ([]OverflightReport, error)
+	DeleteByID(ctx context.Context, contextID string, tenantID TenantID, id string) error
+}
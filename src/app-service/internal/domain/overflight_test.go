@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+)
+
+var overflightStart = time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+// overflightTrack samples a ground track along a parallel, one sample per minute.
+func overflightTrack(latitude float64, longitudes ...float64) []xspace.SatellitePosition {
+	track := make([]xspace.SatellitePosition, len(longitudes))
+	for i, longitude := range longitudes {
+		track[i] = xspace.SatellitePosition{
+			Latitude:  latitude,
+			Longitude: longitude,
+			Altitude:  420,
+			Time:      overflightStart.Add(time.Duration(i) * time.Minute),
+		}
+	}
+	return track
+}
+
+func overflightArea(t *testing.T, code string, polygons ...GeoJSONPolygon) AdminArea {
+	area, err := NewAdminArea(code, code, AdminLevelCountry, "", polygons)
+	if err != nil {
+		t.Fatalf("NewAdminArea returned an error: %v", err)
+	}
+	return area
+}
+
+func TestComputeOverflights(t *testing.T) {
+	// 20 degree square around (0, 0) with a 4 degree hole in its middle
+	enclave := overflightArea(t, "ENC", squareWithHole)
+	// Split at the antimeridian like the boundary datasets do
+	fiji := overflightArea(t, "FJI",
+		GeoJSONPolygon{Type: "Polygon", Coordinates: [][][]float64{{{177, -20}, {180, -20}, {180, -15}, {177, -15}, {177, -20}}}},
+		GeoJSONPolygon{Type: "Polygon", Coordinates: [][][]float64{{{-180, -20}, {-178, -20}, {-178, -15}, {-180, -15}, {-180, -20}}}},
+	)
+
+	type expectedRecord struct {
+		entry     int // Minutes after the start
+		exit      int
+		truncated bool
+	}
+	tests := []struct {
+		name     string
+		area     AdminArea
+		track    []xspace.SatellitePosition
+		expected []expectedRecord
+	}{
+		{name: "crossing the area", area: enclave, track: overflightTrack(5, -15, -5, 5, 15), expected: []expectedRecord{{entry: 1, exit: 3}}},
+		{
+			name:     "crossing the hole",
+			area:     enclave,
+			track:    overflightTrack(0, -15, -5, 0, 5, 15),
+			expected: []expectedRecord{{entry: 1, exit: 2}, {entry: 3, exit: 4}},
+		},
+		{name: "passing north of the area", area: enclave, track: overflightTrack(12, -15, -5, 5, 15)},
+		{name: "in progress at the first sample", area: enclave, track: overflightTrack(5, 5, 15), expected: []expectedRecord{{entry: 0, exit: 1, truncated: true}}},
+		{name: "open at the window end", area: enclave, track: overflightTrack(5, -15, -5), expected: []expectedRecord{{entry: 1, exit: 10, truncated: true}}},
+		{
+			name:     "unwrapped longitudes",
+			area:     enclave,
+			track:    overflightTrack(5, -375, -365, -355, -345),
+			expected: []expectedRecord{{entry: 1, exit: 3}},
+		},
+		{
+			name:     "crossing the antimeridian",
+			area:     fiji,
+			track:    overflightTrack(-17, 176, 178, 179.5, -179.5, -178.5, -177),
+			expected: []expectedRecord{{entry: 1, exit: 5}},
+		},
+	}
+
+	end := overflightStart.Add(10 * time.Minute)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records := ComputeOverflights("25544", "ISS", tt.area, tt.track, overflightStart, end)
+			if len(records) != len(tt.expected) {
+				t.Fatalf("Expected %d overflights, got %d: %+v", len(tt.expected), len(records), records)
+			}
+			for i, record := range records {
+				expected := tt.expected[i]
+				entry := overflightStart.Add(time.Duration(expected.entry) * time.Minute)
+				exit := overflightStart.Add(time.Duration(expected.exit) * time.Minute)
+				if !record.EntryAt.Equal(entry) || !record.ExitAt.Equal(exit) || record.Truncated != expected.truncated {
+					t.Errorf("Overflight %d is %v - %v truncated %v, expected %v - %v truncated %v",
+						i, record.EntryAt, record.ExitAt, record.Truncated, entry, exit, expected.truncated)
+				}
+				if record.DurationSeconds != exit.Sub(entry).Seconds() {
+					t.Errorf("Overflight %d lasts %vs, expected %vs", i, record.DurationSeconds, exit.Sub(entry).Seconds())
+				}
+				if record.NoradID != "25544" || record.SatelliteName != "ISS" || record.AreaCode != tt.area.Code {
+					t.Errorf("Overflight %d not linked to its satellite and area: %+v", i, record)
+				}
+			}
+		})
+	}
+}
+
+func TestNewOverflightReport(t *testing.T) {
+	// A band around the equator crossed twice per revolution
+	equator := overflightArea(t, "EQ", GeoJSONPolygon{Type: "Polygon", Coordinates: [][][]float64{
+		{{-180, -5}, {180, -5}, {180, 5}, {-180, 5}, {-180, -5}},
+	}})
+	iss := TLE{
+		NoradID: "25544",
+		Line1:   "1 25544U 98067A   24061.50000000  .00016717  00000-0  30270-3 0  9993",
+		Line2:   "2 25544  51.6416 247.4627 0006703 130.5360 325.0288 15.49815508441330",
+	}
+	satellites := []Satellite{{NoradID: "25544", Name: "ISS (ZARYA)"}}
+	start := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	end := start.Add(3 * time.Hour)
+
+	report, err := NewOverflightReport("context", "tenant", []AdminArea{equator}, satellites, []TLE{iss}, start, end, 30*time.Second)
+	if err != nil {
+		t.Fatalf("NewOverflightReport returned an error: %v", err)
+	}
+	// About 93 minutes per revolution, so 3 hours hold 3 or 4 equator crossings
+	if len(report.Records) < 3 || len(report.Records) > 5 {
+		t.Fatalf("Expected about 4 equator crossings, got %d", len(report.Records))
+	}
+	for i, record := range report.Records {
+		if record.SatelliteName != "ISS (ZARYA)" || record.EntryAt.Before(start) || record.ExitAt.After(end) {
+			t.Errorf("Unexpected overflight %+v", record)
+		}
+		if i > 0 && record.EntryAt.Before(report.Records[i-1].EntryAt) {
+			t.Errorf("Overflights not ordered by entry: %v before %v", report.Records[i-1].EntryAt, record.EntryAt)
+		}
+		// At 51.6 degrees of inclination the 10 degree band is crossed in a couple of minutes
+		if !record.Truncated && (record.DurationSeconds < 60 || record.DurationSeconds > 300) {
+			t.Errorf("Unexpected overflight duration %vs", record.DurationSeconds)
+		}
+	}
+	if totals := report.TotalDurationByArea(); totals["EQ"]["25544"] <= 0 {
+		t.Errorf("Expected a total duration over the equator band, got %v", totals)
+	}
+
+	if _, err := NewOverflightReport("context", "tenant", nil, satellites, []TLE{iss}, start, end, time.Minute); err == nil {
+		t.Error("Expected an error without admin area")
+	}
+	if _, err := NewOverflightReport("context", "tenant", []AdminArea{equator}, satellites, []TLE{iss}, end, start, time.Minute); err == nil {
+		t.Error("Expected an error for a window ending before it starts")
+	}
+	if _, err := NewOverflightReport("context", "tenant", []AdminArea{equator}, satellites, []TLE{iss}, start, end, 0); err == nil {
+		t.Error("Expected an error without step")
+	}
+}
+
+func TestClosestTLE(t *testing.T) {
+	at := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	tles := []TLE{
+		{ID: "week before", Epoch: at.Add(-7 * 24 * time.Hour)},
+		{ID: "day before", Epoch: at.Add(-24 * time.Hour)},
+		{ID: "two days after", Epoch: at.Add(48 * time.Hour)},
+	}
+
+	if closest, ok := ClosestTLE(tles, at); !ok || closest.ID != "day before" {
+		t.Errorf("Expected the element set of the day before, got %q", closest.ID)
+	}
+	if closest, ok := ClosestTLE(tles, at.Add(10*24*time.Hour)); !ok || closest.ID != "two days after" {
+		t.Errorf("Expected the latest element set for a later time, got %q", closest.ID)
+	}
+	if _, ok := ClosestTLE(nil, at); ok {
+		t.Error("Expected no element set from an empty history")
+	}
+}
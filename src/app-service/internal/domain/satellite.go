@@ -47,6 +47,15 @@ type Satellite struct {
 	RCS            *float64   // Added field for radar cross-section in square meters
 	TleUpdatedAt   *time.Time `gorm:"-"`
 	Altitude       *float64
+	OrbitRegime    OrbitRegime // Orbit regime derived from the latest TLE
+	Synthetic      bool        // User-defined satellite with a generated TLE
+	OpsStatus      string      // SATCAT operational status code, for example "+" for operational
+	LaunchSite     string      // SATCAT launch site code
+	OrbitCenter    string      // SATCAT orbit center, for example "EA" for the Earth
+	OrbitType      string      // SATCAT orbit type, for example "ORB" or "IMP"
+	LaunchYear     int         // Launch year parsed from the international designator, zero if unknown
+	LaunchNumber   int         // Launch number in its year parsed from the international designator
+	LaunchPiece    string      // Piece of the launch parsed from the international designator, for example "A"
 }
 
 // NewSatelliteFromStatCat creates a new Satellite instance with optional SATCAT data.
@@ -70,7 +79,7 @@ func NewSatelliteFromStatCat(
 	if err := satType.IsValid(); err != nil {
 		return Satellite{}, err
 	}
-	return Satellite{
+	satellite := Satellite{
 		ModelBase: ModelBase{
 			ID:          uuid.NewString(),
 			CreatedAt:   nowUtc,
@@ -93,7 +102,10 @@ func NewSatelliteFromStatCat(
 		Apogee:         apogee,
 		Perigee:        perigee,
 		RCS:            rcs,
-	}, nil
+		Altitude:       altitude,
+	}
+	satellite.SetIntlDesignator(intlDesignator)
+	return satellite, nil
 }
 
 // NewSatellite creates a new Satellite instance.
@@ -121,6 +133,8 @@ func NewSatellite(name string, noradID string, satType SatelliteType, isFavourit
 type SatelliteRepository interface {
 	// Existing Methods
 	FindByNoradID(ctx context.Context, noradID string) (Satellite, error)
+	// FindByNoradIDs returns the satellites of the given NORAD IDs, excluding deleted ones. Unknown IDs are skipped.
+	FindByNoradIDs(ctx context.Context, noradIDs []string) ([]Satellite, error)
 	FindAll(ctx context.Context) ([]Satellite, error)
 	Save(ctx context.Context, satellite Satellite) error
 	Update(ctx context.Context, satellite Satellite) error
@@ -128,6 +142,21 @@ type SatelliteRepository interface {
 	SaveBatch(ctx context.Context, satellites []Satellite) error
 	FindAllWithPagination(ctx context.Context, page int, pageSize int, searchRequest *SearchRequest) ([]Satellite, int64, error)
 	FindSatelliteInfoWithPagination(ctx context.Context, page int, pageSize int, searchRequest *SearchRequest) ([]SatelliteInfo, int64, error)
+	UpdateOrbitalParameters(ctx context.Context, noradID string, params OrbitalParameters) error
+	FindByOrbitRegime(ctx context.Context, regime OrbitRegime) ([]Satellite, error)
+	FindWithPerigeeBelow(ctx context.Context, perigee float64) ([]Satellite, error)
+	MarkDecayed(ctx context.Context, noradID string, decayDate time.Time) error
+	UpdateName(ctx context.Context, noradID string, name string) error
+	SaveCatalogBatch(ctx context.Context, satellites []Satellite) error
+	FindSynthetic(ctx context.Context) ([]Satellite, error)
+	// FindHighestSyntheticNoradID returns the highest catalog number of the synthetic range ever used, deleted
+	// satellites included, or zero when none is.
+	FindHighestSyntheticNoradID(ctx context.Context) (int, error)
+	// CreateSynthetic inserts synthetic satellites with their current element sets in one transaction and
+	// assigns them to a context when contextID is set. It fails with ErrNoradIDTaken, storing nothing,
+	// when one of the NORAD IDs is already cataloged.
+	CreateSynthetic(ctx context.Context, satellites []Satellite, tles []TLE, contextID string) error
+	FindByTags(ctx context.Context, tags []string) ([]Satellite, error)
 
 	// New Context-Specific Methods
 	AssignSatelliteToContext(ctx context.Context, contextID, satelliteID string) error
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"time"
+)
+
+// SatelliteCatalogEventKind is the kind of change found when comparing a catalog with the stored satellites.
+type SatelliteCatalogEventKind string
+
+const (
+	SatelliteCreated SatelliteCatalogEventKind = "created"
+	SatelliteUpdated SatelliteCatalogEventKind = "updated"
+	SatelliteDecayed SatelliteCatalogEventKind = "decayed"
+)
+
+// SatelliteCatalogEvent reports a satellite added to the catalog, changed in it or newly decayed.
+type SatelliteCatalogEvent struct {
+	Kind       SatelliteCatalogEventKind
+	NoradID    string
+	Name       string
+	Fields     []string // Catalog fields that changed, empty for a creation
+	OccurredAt time.Time
+}
+
+// DiffSatelliteCatalog compares catalog entries with the stored satellites and returns the entries to store,
+// that is the new and changed ones, with an event for each. A decay date appearing yields a decayed event
+// instead of an updated one. The orbital parameters are not compared since the TLE ingestion keeps them
+// more current than the catalog.
+func DiffSatelliteCatalog(stored []Satellite, entries []Satellite, at time.Time) ([]Satellite, []SatelliteCatalogEvent) {
+	byNoradID := make(map[string]Satellite, len(stored))
+	for _, satellite := range stored {
+		byNoradID[satellite.NoradID] = satellite
+	}
+
+	var changed []Satellite
+	var events []SatelliteCatalogEvent
+	for _, entry := range entries {
+		entry.IsActive = entry.DecayDate == nil
+
+		current, ok := byNoradID[entry.NoradID]
+		if !ok {
+			changed = append(changed, entry)
+			events = append(events, SatelliteCatalogEvent{Kind: SatelliteCreated, NoradID: entry.NoradID, Name: entry.Name, OccurredAt: at})
+			continue
+		}
+
+		fields := catalogFieldChanges(current, entry)
+		if len(fields) == 0 {
+			continue
+		}
+		kind := SatelliteUpdated
+		if current.DecayDate == nil && entry.DecayDate != nil {
+			kind = SatelliteDecayed
+		}
+		changed = append(changed, entry)
+		events = append(events, SatelliteCatalogEvent{Kind: kind, NoradID: entry.NoradID, Name: entry.Name, Fields: fields, OccurredAt: at})
+	}
+	return changed, events
+}
+
+// catalogFieldChanges lists the catalog fields that differ between two records of a satellite.
+func catalogFieldChanges(current Satellite, entry Satellite) []string {
+	var fields []string
+	compare := func(name string, equal bool) {
+		if !equal {
+			fields = append(fields, name)
+		}
+	}
+	compare("name", current.Name == entry.Name)
+	compare("intlDesignator", current.IntlDesignator == entry.IntlDesignator)
+	compare("owner", current.Owner == entry.Owner)
+	compare("objectType", current.ObjectType == entry.ObjectType)
+	compare("launchDate", sameDate(current.LaunchDate, entry.LaunchDate))
+	compare("decayDate", sameDate(current.DecayDate, entry.DecayDate))
+	compare("rcs", sameValue(current.RCS, entry.RCS))
+	compare("opsStatus", current.OpsStatus == entry.OpsStatus)
+	compare("launchSite", current.LaunchSite == entry.LaunchSite)
+	compare("orbitCenter", current.OrbitCenter == entry.OrbitCenter)
+	compare("orbitType", current.OrbitType == entry.OrbitType)
+	return fields
+}
+
+// sameDate compares two optional dates by calendar day, as the catalog stores them.
+func sameDate(a *time.Time, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.UTC().Format("2006-01-02") == b.UTC().Format("2006-01-02")
+}
+
+func sameValue(a *float64, b *float64) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
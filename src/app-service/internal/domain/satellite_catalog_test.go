@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+var catalogSyncAt = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+func catalogEntry(noradID string, name string, decayDate *time.Time) Satellite {
+	launchDate := time.Date(1998, time.November, 20, 0, 0, 0, 0, time.UTC)
+	return Satellite{
+		ModelBase:      ModelBase{IsActive: true},
+		NoradID:        noradID,
+		Name:           name,
+		IntlDesignator: "1998-067A",
+		Owner:          "ISS",
+		ObjectType:     "PAYLOAD",
+		LaunchDate:     &launchDate,
+		DecayDate:      decayDate,
+	}
+}
+
+func TestDiffSatelliteCatalog(t *testing.T) {
+	decayDate := time.Date(2024, time.February, 28, 0, 0, 0, 0, time.UTC)
+	// The same day at another time of day, as read back from the database
+	decayDateLater := decayDate.Add(6 * time.Hour)
+	period := 92.8
+
+	tests := []struct {
+		name           string
+		stored         []Satellite
+		entries        []Satellite
+		expectedKinds  []SatelliteCatalogEventKind
+		expectedFields []string // Changed fields of the first event
+	}{
+		{
+			name:          "created",
+			entries:       []Satellite{catalogEntry("25544", "ISS (ZARYA)", nil)},
+			expectedKinds: []SatelliteCatalogEventKind{SatelliteCreated},
+		},
+		{
+			name:          "unchanged",
+			stored:        []Satellite{catalogEntry("25544", "ISS (ZARYA)", nil)},
+			entries:       []Satellite{catalogEntry("25544", "ISS (ZARYA)", nil)},
+			expectedKinds: nil,
+		},
+		{
+			name:           "updated",
+			stored:         []Satellite{catalogEntry("25544", "ISS", nil)},
+			entries:        []Satellite{func() Satellite { s := catalogEntry("25544", "ISS (ZARYA)", nil); s.Owner = "NASA"; return s }()},
+			expectedKinds:  []SatelliteCatalogEventKind{SatelliteUpdated},
+			expectedFields: []string{"name", "owner"},
+		},
+		{
+			name:           "decayed",
+			stored:         []Satellite{catalogEntry("25544", "ISS (ZARYA)", nil)},
+			entries:        []Satellite{catalogEntry("25544", "ISS (ZARYA)", &decayDate)},
+			expectedKinds:  []SatelliteCatalogEventKind{SatelliteDecayed},
+			expectedFields: []string{"decayDate"},
+		},
+		{
+			name:          "decay date compared by day",
+			stored:        []Satellite{catalogEntry("25544", "ISS (ZARYA)", &decayDateLater)},
+			entries:       []Satellite{catalogEntry("25544", "ISS (ZARYA)", &decayDate)},
+			expectedKinds: nil,
+		},
+		{
+			name:          "orbital parameters ignored",
+			stored:        []Satellite{catalogEntry("25544", "ISS (ZARYA)", nil)},
+			entries:       []Satellite{func() Satellite { s := catalogEntry("25544", "ISS (ZARYA)", nil); s.Period = &period; return s }()},
+			expectedKinds: nil,
+		},
+		{
+			name:   "mixed",
+			stored: []Satellite{catalogEntry("1", "A", nil), catalogEntry("2", "B", nil)},
+			entries: []Satellite{
+				catalogEntry("1", "A", &decayDate),
+				catalogEntry("2", "B", nil),
+				catalogEntry("3", "C", nil),
+			},
+			expectedKinds:  []SatelliteCatalogEventKind{SatelliteDecayed, SatelliteCreated},
+			expectedFields: []string{"decayDate"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changed, events := DiffSatelliteCatalog(tt.stored, tt.entries, catalogSyncAt)
+
+			if len(changed) != len(events) || len(events) != len(tt.expectedKinds) {
+				t.Fatalf("Expected %d events, got %d events and %d changed entries", len(tt.expectedKinds), len(events), len(changed))
+			}
+			for i, event := range events {
+				if event.Kind != tt.expectedKinds[i] {
+					t.Errorf("Event %d is %s, expected %s", i, event.Kind, tt.expectedKinds[i])
+				}
+				if event.NoradID != changed[i].NoradID || !event.OccurredAt.Equal(catalogSyncAt) {
+					t.Errorf("Event %d does not describe its entry: %+v", i, event)
+				}
+				if event.Kind == SatelliteCreated && len(event.Fields) != 0 {
+					t.Errorf("Creation event with fields %v", event.Fields)
+				}
+				if changed[i].IsActive != (changed[i].DecayDate == nil) {
+					t.Errorf("Entry %s active flag does not follow its decay date", changed[i].NoradID)
+				}
+			}
+			if len(events) > 0 && tt.expectedFields != nil && strings.Join(events[0].Fields, ",") != strings.Join(tt.expectedFields, ",") {
+				t.Errorf("Expected changed fields %v, got %v", tt.expectedFields, events[0].Fields)
+			}
+		})
+	}
+}
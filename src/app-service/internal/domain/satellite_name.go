@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SatelliteNameChange records a change of the name of a satellite, as reported by the element sets it was ingested from.
+type SatelliteNameChange struct {
+	ModelBase
+	NoradID   string
+	OldName   string
+	NewName   string
+	ChangedAt time.Time
+}
+
+// UnknownSatelliteName is the placeholder name of a satellite created from an element set without line 0.
+func UnknownSatelliteName(noradID string) string {
+	return fmt.Sprintf("Unknown Satellite %s", noradID)
+}
+
+// NewSatelliteNameChange creates a new SatelliteNameChange instance.
+func NewSatelliteNameChange(noradID string, oldName string, newName string, changedAt time.Time) (SatelliteNameChange, error) {
+	if noradID == "" || newName == "" {
+		return SatelliteNameChange{}, errors.New("name change requires a NORAD ID and a new name")
+	}
+	if oldName == newName {
+		return SatelliteNameChange{}, errors.New("name change requires a different name")
+	}
+	nowUtc := time.Now().UTC()
+	return SatelliteNameChange{
+		ModelBase: ModelBase{
+			ID:          uuid.NewString(),
+			CreatedAt:   nowUtc,
+			UpdatedAt:   &nowUtc,
+			DisplayName: newName,
+			IsActive:    true,
+			ProcessedAt: &nowUtc,
+		},
+		NoradID:   noradID,
+		OldName:   oldName,
+		NewName:   newName,
+		ChangedAt: changedAt,
+	}, nil
+}
+
+// SatelliteNameChangeRepository defines the interface for SatelliteNameChange operations.
+type SatelliteNameChangeRepository interface {
+	Save(ctx context.Context, change SatelliteNameChange) error
+	// FindByNoradID returns the name changes of a satellite, oldest first.
+	FindByNoradID(ctx context.Context, noradID string) ([]SatelliteNameChange, error)
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SatelliteTagOrigin tells how a satellite got a tag.
+type SatelliteTagOrigin string
+
+const (
+	// TagOriginSource tags come from the group of the element set source, for example a CelesTrak category.
+	TagOriginSource SatelliteTagOrigin = "source"
+	// TagOriginUser tags are assigned by users.
+	TagOriginUser SatelliteTagOrigin = "user"
+)
+
+// SatelliteTag groups satellites under a name, such as "weather" or "starlink".
+// A satellite can have many tags and a tag many satellites.
+type SatelliteTag struct {
+	ModelBase
+	Name        string // Unique, lower case
+	Description string
+}
+
+// NormalizeTagName returns the canonical form of a tag name.
+func NormalizeTagName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// NewSatelliteTag creates a new SatelliteTag instance.
+func NewSatelliteTag(name string, description string) (SatelliteTag, error) {
+	name = NormalizeTagName(name)
+	if name == "" {
+		return SatelliteTag{}, errors.New("tag name cannot be empty")
+	}
+	if strings.ContainsAny(name, ",") {
+		return SatelliteTag{}, errors.New("tag name cannot contain a comma")
+	}
+
+	nowUtc := time.Now().UTC()
+	return SatelliteTag{
+		ModelBase: ModelBase{
+			ID:          uuid.NewString(),
+			CreatedAt:   nowUtc,
+			UpdatedAt:   &nowUtc,
+			DisplayName: name,
+			IsActive:    true,
+			ProcessedAt: &nowUtc,
+		},
+		Name:        name,
+		Description: description,
+	}, nil
+}
+
+// SatelliteTagRepository defines the interface for SatelliteTag operations.
+type SatelliteTagRepository interface {
+	FindAll(ctx context.Context) ([]SatelliteTag, error)
+	FindByName(ctx context.Context, name string) (SatelliteTag, error)
+	// Save creates a tag, or updates the description of the tag of the same name. It returns the stored tag.
+	Save(ctx context.Context, tag SatelliteTag) (SatelliteTag, error)
+	DeleteByName(ctx context.Context, name string) error
+	// TagSatellites tags the satellites of the NORAD IDs, ignoring unknown NORAD IDs and existing assignments.
+	TagSatellites(ctx context.Context, tagID string, noradIDs []string, origin SatelliteTagOrigin) error
+	UntagSatellites(ctx context.Context, tagID string, noradIDs []string) error
+	// UntagSourceSatellitesExcept removes a tag set from a source group from the satellites not in noradIDs.
+	UntagSourceSatellitesExcept(ctx context.Context, tagID string, noradIDs []string) error
+	FindByNoradID(ctx context.Context, noradID string) ([]SatelliteTag, error)
+}
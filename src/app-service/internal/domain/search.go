@@ -1,5 +1,7 @@
 package domain
 
 type SearchRequest struct {
-	Wildcard string
+	Wildcard    string
+	OrbitRegime OrbitRegime // Optional filter on the orbit regime
+	Tags        []string    // Optional filter on tags, satellites having any of them match
 }
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+	"github.com/google/uuid"
+)
+
+// SatelliteSensor represents the observation payload attached to a satellite.
+type SatelliteSensor struct {
+	ModelBase
+	NoradID string
+	Sensor  xspace.Sensor
+}
+
+// NewSatelliteSensor creates a new SatelliteSensor instance.
+func NewSatelliteSensor(noradID string, sensor xspace.Sensor) (SatelliteSensor, error) {
+	if err := sensor.Validate(); err != nil {
+		return SatelliteSensor{}, err
+	}
+	nowUtc := time.Now().UTC()
+	return SatelliteSensor{
+		ModelBase: ModelBase{
+			ID:          uuid.NewString(),
+			CreatedAt:   nowUtc,
+			UpdatedAt:   &nowUtc,
+			DisplayName: noradID,
+			IsActive:    true,
+			ProcessedAt: &nowUtc,
+		},
+		NoradID: noradID,
+		Sensor:  sensor,
+	}, nil
+}
+
+// SwathPolygons computes the ground area the sensor can image along a satellite track.
+func (s SatelliteSensor) SwathPolygons(positions []SatellitePosition) ([][]Point, error) {
+	track := make([]xspace.SatellitePosition, len(positions))
+	for i, position := range positions {
+		track[i] = xspace.SatellitePosition{
+			Latitude:  position.Latitude,
+			Longitude: position.Longitude,
+			Altitude:  position.Altitude,
+			Time:      position.Timestamp,
+		}
+	}
+
+	polygons, err := xspace.SwathPolygons(track, s.Sensor)
+	if err != nil {
+		return nil, err
+	}
+
+	swaths := make([][]Point, len(polygons))
+	for i, polygon := range polygons {
+		swaths[i] = make([]Point, len(polygon))
+		for j, vertex := range polygon {
+			swaths[i][j] = Point{Latitude: vertex.Latitude, Longitude: vertex.Longitude}
+		}
+	}
+	return swaths, nil
+}
+
+// SatelliteSensorRepository defines the interface for SatelliteSensor operations.
+type SatelliteSensorRepository interface {
+	Save(ctx context.Context, sensor SatelliteSensor) error
+	FindByNoradID(ctx context.Context, noradID string) (*SatelliteSensor, error)
+	DeleteByNoradID(ctx context.Context, noradID string) error
+}
+
+// FindTilesVisibleFromTrack maps a satellite to the tiles under its ground track,
+// or to the tiles its sensor can image when it carries one.
+func FindTilesVisibleFromTrack(ctx context.Context, tileRepo TileRepository, sat Satellite, sensor *SatelliteSensor, positions []SatellitePosition) ([]TileSatelliteMapping, error) {
+	if sensor == nil {
+		return tileRepo.FindTilesVisibleFromLine(ctx, sat, positions)
+	}
+
+	swaths, err := sensor.SwathPolygons(positions)
+	if err != nil {
+		return nil, err
+	}
+	return tileRepo.FindTilesVisibleFromSwath(ctx, sat, swaths)
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+)
+
+// SkyObjectKind represents the kind of an object of the sky view.
+type SkyObjectKind string
+
+const (
+	SkyObjectSatellite SkyObjectKind = "SATELLITE"
+	SkyObjectSun       SkyObjectKind = "SUN"
+	SkyObjectMoon      SkyObjectKind = "MOON"
+	SkyObjectPlanet    SkyObjectKind = "PLANET"
+)
+
+// SkyObject is the direction of an object seen from an observer.
+type SkyObject struct {
+	Kind      SkyObjectKind `json:"kind"`
+	ID        string        `json:"id"` // NORAD ID of satellites, body name otherwise
+	Name      string        `json:"name"`
+	Azimuth   float64       `json:"azimuth"`   // Degrees clockwise from north
+	Elevation float64       `json:"elevation"` // Degrees above the horizon
+	RangeKm   float64       `json:"rangeKm"`
+}
+
+// SkyView lists the satellites, the Sun, the Moon and the planets seen from an observer at a given time.
+type SkyView struct {
+	Time     time.Time       `json:"time"`
+	Observer xspace.Observer `json:"observer"`
+	Objects  []SkyObject     `json:"objects"`
+}
+
+// NewSkyView computes the sky of an observer at time t. The Sun, the Moon and the planets are always listed,
+// even below the horizon, while satellites are only listed above minElevation. Objects are sorted by
+// decreasing elevation.
+func NewSkyView(observer xspace.Observer, t time.Time, satellites []Satellite, tles []TLE, minElevation float64) (SkyView, error) {
+	view := SkyView{Time: t, Observer: observer, Objects: []SkyObject{}}
+
+	sun := xspace.ComputeCelestialLookAngle(xspace.SunPosition(t), observer, t)
+	view.Objects = append(view.Objects, newSkyObject(SkyObjectSun, "SUN", "Sun", sun))
+	moon := xspace.ComputeCelestialLookAngle(xspace.MoonPosition(t), observer, t)
+	view.Objects = append(view.Objects, newSkyObject(SkyObjectMoon, "MOON", "Moon", moon))
+
+	for _, planet := range xspace.Planets {
+		position, err := xspace.PlanetPosition(planet, t)
+		if err != nil {
+			return SkyView{}, err
+		}
+		angle := xspace.ComputeCelestialLookAngle(position, observer, t)
+		name := string(planet[:1]) + strings.ToLower(string(planet[1:]))
+		view.Objects = append(view.Objects, newSkyObject(SkyObjectPlanet, string(planet), name, angle))
+	}
+
+	names := make(map[string]string, len(satellites))
+	for _, satellite := range satellites {
+		names[satellite.NoradID] = satellite.Name
+	}
+	for _, tle := range tles {
+		angle, err := xspace.ComputeLookAngleFromTLE(tle.Line1, tle.Line2, observer, t)
+		if err != nil {
+			return SkyView{}, fmt.Errorf("failed to compute look angle of %s: %w", tle.NoradID, err)
+		}
+		if angle.Elevation < minElevation {
+			continue
+		}
+		view.Objects = append(view.Objects, newSkyObject(SkyObjectSatellite, tle.NoradID, names[tle.NoradID], angle))
+	}
+
+	sort.SliceStable(view.Objects, func(i, j int) bool {
+		return view.Objects[i].Elevation > view.Objects[j].Elevation
+	})
+	return view, nil
+}
+
+func newSkyObject(kind SkyObjectKind, id string, name string, angle xspace.LookAngle) SkyObject {
+	return SkyObject{
+		Kind:      kind,
+		ID:        id,
+		Name:      name,
+		Azimuth:   angle.Azimuth,
+		Elevation: angle.Elevation,
+		RangeKm:   angle.RangeKm,
+	}
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SyncState is the cursor of an incremental synchronization with an external catalog, such as the last
+// GP_ID read from Space-Track or the last fetch of a CelesTrak group. Scope distinguishes the independent
+// streams of a source.
+type SyncState struct {
+	ModelBase
+	Source       string    // External catalog, for example "spacetrack"
+	Scope        string    // Stream within the source, for example "gp" or "gp_history:25544"
+	Cursor       string    // Opaque position of the last synchronized record
+	SyncedAt     time.Time // Time of the last successful synchronization
+	ETag         string    // HTTP validators of the last response, sent back on the next conditional request
+	LastModified string
+	PayloadHash  string // Hash of the last payload, to detect unchanged data when the source has no validators
+}
+
+// NewSyncState creates a new SyncState instance.
+func NewSyncState(source string, scope string, cursor string, syncedAt time.Time) (SyncState, error) {
+	if source == "" || scope == "" {
+		return SyncState{}, errors.New("sync state requires a source and a scope")
+	}
+	nowUtc := time.Now().UTC()
+	return SyncState{
+		ModelBase: ModelBase{
+			ID:          uuid.NewString(),
+			CreatedAt:   nowUtc,
+			UpdatedAt:   &nowUtc,
+			DisplayName: source + ":" + scope,
+			IsActive:    true,
+			ProcessedAt: &nowUtc,
+		},
+		Source:   source,
+		Scope:    scope,
+		Cursor:   cursor,
+		SyncedAt: syncedAt,
+	}, nil
+}
+
+// SyncStateRepository defines the interface for SyncState operations.
+type SyncStateRepository interface {
+	// Find returns the state of a stream, or a zero SyncState without error when the stream was never synchronized.
+	Find(ctx context.Context, source string, scope string) (SyncState, error)
+	// Save creates or replaces the state of a stream.
+	Save(ctx context.Context, state SyncState) error
+}
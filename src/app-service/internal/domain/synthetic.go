@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+)
+
+const (
+	// SyntheticNoradIDMin is the first catalog number reserved for user-defined satellites,
+	// far above the numbers currently assigned by the catalog.
+	SyntheticNoradIDMin = 90000
+	// SyntheticNoradIDMax is the last catalog number reserved for user-defined satellites.
+	SyntheticNoradIDMax = xspace.MAX_TLE_NORAD_ID
+	syntheticObjectType = "PAYLOAD"
+	syntheticOwner      = "SYNTHETIC"
+	// SyntheticElementSetSource is the source of the generated TLEs of user-defined satellites.
+	SyntheticElementSetSource = "synthetic"
+)
+
+// NewSyntheticSatellite creates a user-defined satellite and its generated TLE from Keplerian elements.
+func NewSyntheticSatellite(name string, noradID int, elements xspace.KeplerianElements) (Satellite, TLE, error) {
+	if name == "" {
+		return Satellite{}, TLE{}, fmt.Errorf("satellite name cannot be empty")
+	}
+	if noradID < SyntheticNoradIDMin || noradID > SyntheticNoradIDMax {
+		return Satellite{}, TLE{}, fmt.Errorf("synthetic NORAD ID must be within [%d, %d]: %d", SyntheticNoradIDMin, SyntheticNoradIDMax, noradID)
+	}
+
+	line1, line2, err := xspace.GenerateTLE(noradID, "", elements)
+	if err != nil {
+		return Satellite{}, TLE{}, fmt.Errorf("failed to generate TLE for %s: %w", name, err)
+	}
+
+	nowUtc := time.Now().UTC()
+	id := strconv.Itoa(noradID)
+	tle, err := NewTLE(id, line1, line2, nowUtc, name, true, false)
+	if err != nil {
+		return Satellite{}, TLE{}, err
+	}
+	tle.Name = name
+	tle.SetProvenance(SyntheticElementSetSource, nowUtc)
+	tle.Current = true // Synthetic satellites have a single element set
+
+	params, err := NewOrbitalParametersFromTLE(tle)
+	if err != nil {
+		return Satellite{}, TLE{}, err
+	}
+
+	launchDate := elements.Epoch.UTC()
+	satellite, err := NewSatelliteFromStatCat(
+		name,
+		id,
+		Active,
+		&launchDate,
+		nil,
+		"",
+		syntheticOwner,
+		syntheticObjectType,
+		&params.Period,
+		&params.Inclination,
+		&params.Apogee,
+		&params.Perigee,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return Satellite{}, TLE{}, err
+	}
+	satellite.OrbitRegime = params.Regime
+	satellite.Synthetic = true
+	return satellite, tle, nil
+}
+
+// NewWalkerConstellationSatellites creates the synthetic satellites of a Walker constellation.
+// Catalog numbers are allocated sequentially from firstNoradID and names follow "<prefix>-P<plane>S<slot>".
+func NewWalkerConstellationSatellites(namePrefix string, firstNoradID int, walker xspace.WalkerConstellation, epoch time.Time) ([]Satellite, []TLE, error) {
+	planes, err := walkerPlaneElements(walker, epoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	return newSyntheticPlaneSatellites(namePrefix, firstNoradID, planes)
+}
+
+// walkerPlaneElements returns the Keplerian elements of a Walker constellation, plane by plane.
+func walkerPlaneElements(walker xspace.WalkerConstellation, epoch time.Time) ([][]xspace.KeplerianElements, error) {
+	elements, err := walker.Elements(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	perPlane := walker.TotalSatellites / walker.Planes
+	planes := make([][]xspace.KeplerianElements, walker.Planes)
+	for i := range planes {
+		planes[i] = elements[i*perPlane : (i+1)*perPlane]
+	}
+	return planes, nil
+}
+
+// newSyntheticPlaneSatellites creates the synthetic satellites of a set of orbital planes.
+func newSyntheticPlaneSatellites(namePrefix string, firstNoradID int, planes [][]xspace.KeplerianElements) ([]Satellite, []TLE, error) {
+	var satellites []Satellite
+	var tles []TLE
+	for p, plane := range planes {
+		for slot, element := range plane {
+			name := fmt.Sprintf("%s-P%dS%d", namePrefix, p+1, slot+1)
+			satellite, tle, err := NewSyntheticSatellite(name, firstNoradID+len(satellites), element)
+			if err != nil {
+				return nil, nil, err
+			}
+			satellites = append(satellites, satellite)
+			tles = append(tles, tle)
+		}
+	}
+	return satellites, tles, nil
+}
+
+// ErrNoradIDTaken reports that an allocated synthetic NORAD ID was cataloged meanwhile, typically by a
+// concurrent allocation. The allocation is retried from the new highest catalog number.
+var ErrNoradIDTaken = errors.New("synthetic NORAD ID already cataloged")
+
+// NextSyntheticNoradID returns the catalog number following the highest one in the synthetic range,
+// zero when the range is empty, ensuring that count consecutive numbers are available.
+func NextSyntheticNoradID(highest int, count int) (int, error) {
+	next := SyntheticNoradIDMin
+	if highest >= next {
+		next = highest + 1
+	}
+	if next+count-1 > SyntheticNoradIDMax {
+		return 0, fmt.Errorf("no room left for %d synthetic satellites", count)
+	}
+	return next, nil
+}
@@ -21,6 +21,7 @@ type TileRepository interface {
 	DeleteByQuadkey(ctx context.Context, key string) error                                                                   // Delete a tile by Quadkey
 	DeleteBySpatialLocation(ctx context.Context, lat float64, lon float64) error                                             // Delete a tile by spatial location
 	FindTilesVisibleFromLine(ctx context.Context, sat Satellite, points []SatellitePosition) ([]TileSatelliteMapping, error) // Find tiles visible from a satellite trajectory
+	FindTilesVisibleFromSwath(ctx context.Context, sat Satellite, polygons [][]Point) ([]TileSatelliteMapping, error)        // Find tiles within a sensor ground swath
 	FindTilesIntersectingLocation(ctx context.Context, contextID string, lat, lon, radius float64) ([]Tile, error)           // Find tiles intersecting a location with a radius
 
 	// New methods for context support
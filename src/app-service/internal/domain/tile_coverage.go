@@ -0,0 +1,255 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	xconstants "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xconstants"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+	"github.com/google/uuid"
+)
+
+// TileCoverageMetric represents a statistic of TileCoverageStats that can be rendered as a heatmap.
+type TileCoverageMetric string
+
+const (
+	TileCoverageMetricPasses      TileCoverageMetric = "passes"
+	TileCoverageMetricSatellites  TileCoverageMetric = "satellites"
+	TileCoverageMetricMeanRevisit TileCoverageMetric = "meanRevisit"
+	TileCoverageMetricMaxGap      TileCoverageMetric = "maxGap"
+	TileCoverageMetricDwell       TileCoverageMetric = "dwell"
+)
+
+// IsValid checks if the TileCoverageMetric is valid.
+func (m TileCoverageMetric) IsValid() error {
+	switch m {
+	case TileCoverageMetricPasses, TileCoverageMetricSatellites, TileCoverageMetricMeanRevisit, TileCoverageMetricMaxGap, TileCoverageMetricDwell:
+		return nil
+	default:
+		return fmt.Errorf("invalid tile coverage metric: %s", m)
+	}
+}
+
+// TilePass is a crossing of a tile by the ground track of a satellite.
+type TilePass struct {
+	NoradID string
+	Start   time.Time // Entry of the ground track into the tile
+	End     time.Time // Exit of the ground track from the tile
+}
+
+// FindTilePasses returns the crossings of a tile by a satellite ground track, in time order.
+// The tile is the disc of its radius around its center and the track is linearly interpolated between samples,
+// on a local projection centered on the tile, so that a tile smaller than the sampling step is not missed.
+func FindTilePasses(tile Tile, noradID string, track []xspace.SatellitePosition) []TilePass {
+	radiusKm := tile.Radius / 1000
+	if radiusKm <= 0 || len(track) < 2 {
+		return nil
+	}
+
+	// Local east/north coordinates in kilometers relative to the tile center
+	kmPerDegree := xconstants.EARTH_RADIUS_KM * math.Pi / 180
+	cosLat := math.Cos(xspace.DegreesToRadians(tile.CenterLat))
+	project := func(position xspace.SatellitePosition) (float64, float64) {
+		return xspace.LongitudeDifference(tile.CenterLon, position.Longitude) * kmPerDegree * cosLat,
+			(position.Latitude - tile.CenterLat) * kmPerDegree
+	}
+
+	var passes []TilePass
+	for i := 1; i < len(track); i++ {
+		from, to := track[i-1], track[i]
+		// Cheap rejection of the segments far from the tile in latitude
+		if (math.Min(from.Latitude, to.Latitude)-tile.CenterLat)*kmPerDegree > radiusKm ||
+			(tile.CenterLat-math.Max(from.Latitude, to.Latitude))*kmPerDegree > radiusKm {
+			continue
+		}
+
+		x0, y0 := project(from)
+		x1, y1 := project(to)
+		enter, exit, ok := segmentInDisc(x0, y0, x1-x0, y1-y0, radiusKm)
+		if !ok {
+			continue
+		}
+
+		duration := to.Time.Sub(from.Time)
+		start := from.Time.Add(time.Duration(enter * float64(duration)))
+		end := from.Time.Add(time.Duration(exit * float64(duration)))
+		if last := len(passes) - 1; last >= 0 && !passes[last].End.Before(start) {
+			passes[last].End = end // The track stays over the tile from the previous segment
+			continue
+		}
+		passes = append(passes, TilePass{NoradID: noradID, Start: start, End: end})
+	}
+	return passes
+}
+
+// segmentInDisc returns the range of the parameter t in [0, 1] for which the point (x+t*dx, y+t*dy)
+// lies within the disc of the given radius centered on the origin.
+func segmentInDisc(x, y, dx, dy, radius float64) (float64, float64, bool) {
+	a := dx*dx + dy*dy
+	c := x*x + y*y - radius*radius
+	if a == 0 {
+		return 0, 1, c <= 0
+	}
+	b := 2 * (x*dx + y*dy)
+	discriminant := b*b - 4*a*c
+	if discriminant < 0 {
+		return 0, 0, false
+	}
+	root := math.Sqrt(discriminant)
+	enter := math.Max((-b-root)/(2*a), 0)
+	exit := math.Min((-b+root)/(2*a), 1)
+	if enter > exit {
+		return 0, 0, false
+	}
+	return enter, exit, true
+}
+
+// TilePassAggregate holds the raw pass statistics of a tile.
+type TilePassAggregate struct {
+	TileID             string
+	Quadkey            string
+	ZoomLevel          int
+	CenterLat          float64
+	CenterLon          float64
+	RadiusMeters       float64
+	Passes             int
+	DistinctSatellites int
+	MeanGapSeconds     float64    // Mean interval between the starts of consecutive passes
+	MaxGapSeconds      float64    // Longest interval without any satellite over the tile between passes
+	FirstPassAt        *time.Time // Start of the first pass, nil when no satellite passed
+	LastPassAt         *time.Time // End of the last pass
+	DwellSeconds       float64    // Sum of the pass durations
+}
+
+// NewTilePassAggregate aggregates the passes of every satellite over a tile.
+func NewTilePassAggregate(tile Tile, passes []TilePass) TilePassAggregate {
+	aggregate := TilePassAggregate{
+		TileID:       tile.ID,
+		Quadkey:      tile.Quadkey,
+		ZoomLevel:    tile.ZoomLevel,
+		CenterLat:    tile.CenterLat,
+		CenterLon:    tile.CenterLon,
+		RadiusMeters: tile.Radius,
+		Passes:       len(passes),
+	}
+	if len(passes) == 0 {
+		return aggregate
+	}
+
+	sorted := make([]TilePass, len(passes))
+	copy(sorted, passes)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start.Before(sorted[j].Start)
+	})
+
+	satellites := make(map[string]bool)
+	coveredUntil := sorted[0].End
+	var totalGap float64
+	for i, pass := range sorted {
+		satellites[pass.NoradID] = true
+		aggregate.DwellSeconds += pass.End.Sub(pass.Start).Seconds()
+		if i == 0 {
+			continue
+		}
+
+		totalGap += pass.Start.Sub(sorted[i-1].Start).Seconds()
+		if pass.Start.After(coveredUntil) {
+			aggregate.MaxGapSeconds = math.Max(aggregate.MaxGapSeconds, pass.Start.Sub(coveredUntil).Seconds())
+		}
+		if pass.End.After(coveredUntil) {
+			coveredUntil = pass.End
+		}
+	}
+
+	aggregate.DistinctSatellites = len(satellites)
+	if len(sorted) > 1 {
+		aggregate.MeanGapSeconds = totalGap / float64(len(sorted)-1)
+	}
+	firstPassAt := sorted[0].Start
+	aggregate.FirstPassAt = &firstPassAt
+	aggregate.LastPassAt = &coveredUntil
+	return aggregate
+}
+
+// TileCoverageStats holds the revisit statistics of a tile within a context over a time window.
+type TileCoverageStats struct {
+	ModelBase
+	ContextID          string
+	TileID             string
+	Quadkey            string
+	ZoomLevel          int
+	CenterLat          float64
+	CenterLon          float64
+	WindowStart        time.Time
+	WindowEnd          time.Time
+	Passes             int
+	DistinctSatellites int
+	MeanRevisitSeconds float64 // Mean interval between consecutive passes, zero with less than two passes
+	MaxGapSeconds      float64 // Longest interval without pass, including the window boundaries
+	DwellSeconds       float64 // Total time spent by the ground tracks over the tile
+}
+
+// NewTileCoverageStats derives the coverage statistics of a tile from its pass aggregate.
+func NewTileCoverageStats(contextID string, aggregate TilePassAggregate, start time.Time, end time.Time) TileCoverageStats {
+	nowUtc := time.Now().UTC()
+	stats := TileCoverageStats{
+		ModelBase: ModelBase{
+			ID:          uuid.NewString(),
+			CreatedAt:   nowUtc,
+			UpdatedAt:   &nowUtc,
+			DisplayName: aggregate.Quadkey,
+			IsActive:    true,
+			ProcessedAt: &nowUtc,
+		},
+		ContextID:          contextID,
+		TileID:             aggregate.TileID,
+		Quadkey:            aggregate.Quadkey,
+		ZoomLevel:          aggregate.ZoomLevel,
+		CenterLat:          aggregate.CenterLat,
+		CenterLon:          aggregate.CenterLon,
+		WindowStart:        start,
+		WindowEnd:          end,
+		Passes:             aggregate.Passes,
+		DistinctSatellites: aggregate.DistinctSatellites,
+		MaxGapSeconds:      end.Sub(start).Seconds(),
+	}
+
+	if aggregate.Passes == 0 || aggregate.FirstPassAt == nil || aggregate.LastPassAt == nil {
+		return stats
+	}
+
+	if aggregate.Passes > 1 {
+		stats.MeanRevisitSeconds = aggregate.MeanGapSeconds
+	}
+	stats.MaxGapSeconds = math.Max(aggregate.MaxGapSeconds, math.Max(
+		math.Max(aggregate.FirstPassAt.Sub(start).Seconds(), 0),
+		math.Max(end.Sub(*aggregate.LastPassAt).Seconds(), 0),
+	))
+	stats.DwellSeconds = aggregate.DwellSeconds
+	return stats
+}
+
+// MetricValue returns the value of a heatmap metric.
+func (s TileCoverageStats) MetricValue(metric TileCoverageMetric) float64 {
+	switch metric {
+	case TileCoverageMetricSatellites:
+		return float64(s.DistinctSatellites)
+	case TileCoverageMetricMeanRevisit:
+		return s.MeanRevisitSeconds
+	case TileCoverageMetricMaxGap:
+		return s.MaxGapSeconds
+	case TileCoverageMetricDwell:
+		return s.DwellSeconds
+	default:
+		return float64(s.Passes)
+	}
+}
+
+// TileCoverageRepository defines the interface for TileCoverageStats operations.
+type TileCoverageRepository interface {
+	ReplaceForContext(ctx context.Context, contextID string, stats []TileCoverageStats) error
+	FindByContext(ctx context.Context, contextID string) ([]TileCoverageStats, error)
+}
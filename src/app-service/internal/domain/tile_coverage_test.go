@@ -0,0 +1,196 @@
+package domain
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+)
+
+var coverageStart = time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+func coverageTile() Tile {
+	return Tile{ModelBase: ModelBase{ID: "tile"}, Quadkey: "0123", ZoomLevel: 4, CenterLat: 10, CenterLon: 20, Radius: 50000}
+}
+
+func tilePass(noradID string, startMinutes int, endMinutes int) TilePass {
+	return TilePass{
+		NoradID: noradID,
+		Start:   coverageStart.Add(time.Duration(startMinutes) * time.Minute),
+		End:     coverageStart.Add(time.Duration(endMinutes) * time.Minute),
+	}
+}
+
+// eastboundTrack samples a ground track along a parallel, one sample per minute.
+func eastboundTrack(latitude float64, longitudes ...float64) []xspace.SatellitePosition {
+	track := make([]xspace.SatellitePosition, len(longitudes))
+	for i, longitude := range longitudes {
+		track[i] = xspace.SatellitePosition{
+			Latitude:  latitude,
+			Longitude: longitude,
+			Altitude:  500,
+			Time:      coverageStart.Add(time.Duration(i) * time.Minute),
+		}
+	}
+	return track
+}
+
+func TestFindTilePasses(t *testing.T) {
+	tile := coverageTile()
+	// 50 km at latitude 10 spans about 0.457 degrees of longitude
+	halfWidth := 50 / (xspace.DegreesToRadians(1) * 6371 * math.Cos(xspace.DegreesToRadians(10)))
+
+	tests := []struct {
+		name     string
+		tile     Tile
+		track    []xspace.SatellitePosition
+		expected int
+	}{
+		{name: "crossing between two samples", tile: tile, track: eastboundTrack(10, 18, 22), expected: 1},
+		{name: "crossing over several samples", tile: tile, track: eastboundTrack(10, 19.8, 20, 20.2, 21), expected: 1},
+		{name: "track north of the tile", tile: tile, track: eastboundTrack(11, 18, 22), expected: 0},
+		{name: "track ending before the tile", tile: tile, track: eastboundTrack(10, 18, 19), expected: 0},
+		{name: "back and forth crossings", tile: tile, track: eastboundTrack(10, 18, 22, 18, 22), expected: 3},
+		{
+			name:     "crossing the antimeridian",
+			tile:     Tile{ModelBase: ModelBase{ID: "tile"}, CenterLat: 10, CenterLon: 180, Radius: 50000},
+			track:    eastboundTrack(10, 178, -178),
+			expected: 1,
+		},
+		{name: "single sample", tile: tile, track: eastboundTrack(10, 20), expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			passes := FindTilePasses(tt.tile, "25544", tt.track)
+			if len(passes) != tt.expected {
+				t.Fatalf("Expected %d passes, got %d: %v", tt.expected, len(passes), passes)
+			}
+			for _, pass := range passes {
+				if pass.NoradID != "25544" || pass.End.Before(pass.Start) {
+					t.Errorf("Invalid pass %+v", pass)
+				}
+			}
+		})
+	}
+
+	// The track moves 4 degrees per minute, so the pass lasts twice the half width at that speed
+	passes := FindTilePasses(tile, "25544", eastboundTrack(10, 18, 22))
+	expected := time.Duration(2 * halfWidth / 4 * float64(time.Minute))
+	if duration := passes[0].End.Sub(passes[0].Start); math.Abs(duration.Seconds()-expected.Seconds()) > 0.5 {
+		t.Errorf("Expected a pass of %v, got %v", expected, duration)
+	}
+	if middle := passes[0].Start.Add(passes[0].End.Sub(passes[0].Start) / 2); middle.Sub(coverageStart.Add(30*time.Second)).Abs() > time.Second {
+		t.Errorf("Expected the pass centered 30s after the start, got %v", middle.Sub(coverageStart))
+	}
+}
+
+func TestNewTilePassAggregate(t *testing.T) {
+	aggregate := NewTilePassAggregate(coverageTile(), []TilePass{
+		tilePass("2", 30, 35),
+		tilePass("1", 10, 12),
+		tilePass("1", 50, 52),
+		tilePass("3", 33, 40), // Overlaps the pass of satellite 2
+	})
+
+	if aggregate.TileID != "tile" || aggregate.RadiusMeters != 50000 {
+		t.Errorf("Tile not copied: %+v", aggregate)
+	}
+	if aggregate.Passes != 4 || aggregate.DistinctSatellites != 3 {
+		t.Errorf("Expected 4 passes of 3 satellites, got %d of %d", aggregate.Passes, aggregate.DistinctSatellites)
+	}
+	// Starts at 10, 30, 33 and 50 minutes
+	if aggregate.MeanGapSeconds != 40*60/3.0 {
+		t.Errorf("Expected a mean gap of %vs, got %vs", 40*60/3.0, aggregate.MeanGapSeconds)
+	}
+	// Uncovered from 12 to 30 and from 40 to 50 minutes
+	if aggregate.MaxGapSeconds != 18*60 {
+		t.Errorf("Expected a max gap of 1080s, got %vs", aggregate.MaxGapSeconds)
+	}
+	if aggregate.DwellSeconds != 16*60 {
+		t.Errorf("Expected a dwell of 960s, got %vs", aggregate.DwellSeconds)
+	}
+	if !aggregate.FirstPassAt.Equal(coverageStart.Add(10*time.Minute)) || !aggregate.LastPassAt.Equal(coverageStart.Add(52*time.Minute)) {
+		t.Errorf("Unexpected pass bounds %v and %v", aggregate.FirstPassAt, aggregate.LastPassAt)
+	}
+
+	empty := NewTilePassAggregate(coverageTile(), nil)
+	if empty.Passes != 0 || empty.FirstPassAt != nil || empty.LastPassAt != nil {
+		t.Errorf("Expected an empty aggregate, got %+v", empty)
+	}
+}
+
+func TestNewTileCoverageStats(t *testing.T) {
+	end := coverageStart.Add(time.Hour)
+
+	tests := []struct {
+		name                string
+		passes              []TilePass
+		expectedPasses      int
+		expectedMeanRevisit float64
+		expectedMaxGap      float64
+		expectedDwell       float64
+	}{
+		{
+			name:           "no pass leaves the whole window as a gap",
+			expectedMaxGap: 3600,
+		},
+		{
+			name:           "single pass has no revisit",
+			passes:         []TilePass{tilePass("1", 10, 12)},
+			expectedPasses: 1,
+			expectedMaxGap: 48 * 60,
+			expectedDwell:  120,
+		},
+		{
+			name:                "gap before the first pass",
+			passes:              []TilePass{tilePass("1", 25, 26), tilePass("1", 40, 41), tilePass("2", 50, 59)},
+			expectedPasses:      3,
+			expectedMeanRevisit: 12.5 * 60,
+			expectedMaxGap:      25 * 60,
+			expectedDwell:       11 * 60,
+		},
+		{
+			name:                "gap between passes",
+			passes:              []TilePass{tilePass("1", 0, 5), tilePass("2", 50, 60)},
+			expectedPasses:      2,
+			expectedMeanRevisit: 50 * 60,
+			expectedMaxGap:      45 * 60,
+			expectedDwell:       15 * 60,
+		},
+		{
+			name:                "passes clipped by the window",
+			passes:              []TilePass{tilePass("1", -2, 1), tilePass("1", 58, 62)},
+			expectedPasses:      2,
+			expectedMeanRevisit: 60 * 60,
+			expectedMaxGap:      57 * 60,
+			expectedDwell:       7 * 60,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := NewTileCoverageStats("context", NewTilePassAggregate(coverageTile(), tt.passes), coverageStart, end)
+
+			if stats.ContextID != "context" || stats.TileID != "tile" || stats.Quadkey != "0123" {
+				t.Errorf("Tile identity not copied: %+v", stats)
+			}
+			if !stats.WindowStart.Equal(coverageStart) || !stats.WindowEnd.Equal(end) {
+				t.Errorf("Unexpected window %v - %v", stats.WindowStart, stats.WindowEnd)
+			}
+			if stats.Passes != tt.expectedPasses {
+				t.Errorf("Expected %d passes, got %d", tt.expectedPasses, stats.Passes)
+			}
+			if stats.MeanRevisitSeconds != tt.expectedMeanRevisit {
+				t.Errorf("Expected a mean revisit of %vs, got %vs", tt.expectedMeanRevisit, stats.MeanRevisitSeconds)
+			}
+			if stats.MaxGapSeconds != tt.expectedMaxGap {
+				t.Errorf("Expected a max gap of %vs, got %vs", tt.expectedMaxGap, stats.MaxGapSeconds)
+			}
+			if stats.DwellSeconds != tt.expectedDwell {
+				t.Errorf("Expected a dwell of %vs, got %vs", tt.expectedDwell, stats.DwellSeconds)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"time"
@@ -11,12 +13,19 @@ import (
 
 // TLE represents the domain entity for Two-Line Element sets.
 type TLE struct {
-	ModelBase
-	ID      string    // Unique identifier
-	NoradID string    // NORAD ID associated with the satellite
-	Line1   string    // First line of the TLE
-	Line2   string    // Second line of the TLE
-	Epoch   time.Time // Time associated with the TLE
+	ModelBase `json:"-"`
+	ID        string    `json:"id"`      // Unique identifier
+	NoradID   string    `json:"noradId"` // NORAD ID associated with the satellite
+	Name      string    `json:"name"`    // Object name from line 0 of a 3LE, empty for a 2LE
+	Line1     string    `json:"line1"`   // First line of the TLE
+	Line2     string    `json:"line2"`   // Second line of the TLE
+	Epoch     time.Time `json:"epoch"`   // Time associated with the TLE
+
+	// Provenance
+	Source      string    `json:"source"`      // Element set source, for example "celestrak", "spacetrack" or "file:/data/catalog.tle"
+	FetchedAt   time.Time `json:"fetchedAt"`   // Time the element set was fetched from the source
+	PayloadHash string    `json:"payloadHash"` // SHA-256 of the raw lines of the element set
+	Current     bool      `json:"current"`     // Selected for propagation among the candidates of the satellite
 }
 
 // Validate ensures that the TLE fields are valid.
@@ -63,3 +72,39 @@ func NewTLE(noradID string, line1 string, line2 string, createdAt time.Time, dis
 	}
 	return tle, nil
 }
+
+// SetProvenance records where and when the element set was fetched and hashes its raw lines.
+func (tle *TLE) SetProvenance(source string, fetchedAt time.Time) {
+	payload := tle.Line1 + "\n" + tle.Line2 + "\n"
+	if tle.Name != "" {
+		payload = tle.Name + "\n" + payload
+	}
+	sum := sha256.Sum256([]byte(payload))
+	tle.Source = source
+	tle.FetchedAt = fetchedAt
+	tle.PayloadHash = hex.EncodeToString(sum[:])
+}
+
+// ClosestTLE returns the TLE whose epoch is closest to the given time, false when there is none.
+func ClosestTLE(tles []TLE, at time.Time) (TLE, bool) {
+	var closest TLE
+	found := false
+	for _, tle := range tles {
+		if !found || tle.Epoch.Sub(at).Abs() < closest.Epoch.Sub(at).Abs() {
+			closest = tle
+			found = true
+		}
+	}
+	return closest, found
+}
+
+// LatestTLEByNoradID keeps the most recent TLE of each satellite.
+func LatestTLEByNoradID(tles []TLE) map[string]TLE {
+	latest := make(map[string]TLE)
+	for _, tle := range tles {
+		if current, ok := latest[tle.NoradID]; !ok || tle.Epoch.After(current.Epoch) {
+			latest[tle.NoradID] = tle
+		}
+	}
+	return latest
+}
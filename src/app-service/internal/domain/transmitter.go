@@ -0,0 +1,207 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TransmitterStatus is the operational status of a transmitter.
+type TransmitterStatus string
+
+const (
+	TransmitterActive   TransmitterStatus = "active"
+	TransmitterInactive TransmitterStatus = "inactive"
+	TransmitterInvalid  TransmitterStatus = "invalid" // Reported but never confirmed
+)
+
+// IsValid checks if the TransmitterStatus is valid.
+func (s TransmitterStatus) IsValid() error {
+	switch s {
+	case TransmitterActive, TransmitterInactive, TransmitterInvalid:
+		return nil
+	default:
+		return fmt.Errorf("invalid transmitter status: %s", s)
+	}
+}
+
+// FrequencyRange is a range of frequencies in Hz. High is zero for a single frequency.
+type FrequencyRange struct {
+	Low  int64
+	High int64
+}
+
+// NewFrequencyRange builds a range from optional bounds in Hz. It returns nil when there is no
+// positive low bound, and a single frequency when the high bound is missing or equals the low bound.
+func NewFrequencyRange(low *int64, high *int64) *FrequencyRange {
+	if low == nil || *low <= 0 {
+		return nil
+	}
+	frequencies := &FrequencyRange{Low: *low}
+	if high != nil && *high != *low {
+		frequencies.High = *high
+	}
+	return frequencies
+}
+
+// Validate ensures that the range is positive and ordered.
+func (r FrequencyRange) Validate() error {
+	if r.Low <= 0 {
+		return errors.New("frequency must be positive")
+	}
+	if r.High != 0 && r.High < r.Low {
+		return errors.New("high frequency must be greater than the low frequency")
+	}
+	return nil
+}
+
+// Center returns the center frequency of the range in Hz.
+func (r FrequencyRange) Center() int64 {
+	if r.High == 0 {
+		return r.Low
+	}
+	return (r.Low + r.High) / 2
+}
+
+// bandRanges are the IEEE radar band limits in Hz of the bands supported by ground stations.
+var bandRanges = []struct {
+	band Band
+	low  int64
+	high int64
+}{
+	{BandVHF, 30e6, 300e6},
+	{BandUHF, 300e6, 1e9},
+	{BandL, 1e9, 2e9},
+	{BandS, 2e9, 4e9},
+	{BandC, 4e9, 8e9},
+	{BandX, 8e9, 12e9},
+	{BandKu, 12e9, 18e9},
+	{BandKa, 26.5e9, 40e9},
+}
+
+// BandOf returns the band of a frequency in Hz, false when it falls outside the supported bands.
+func BandOf(frequency int64) (Band, bool) {
+	for _, r := range bandRanges {
+		if frequency >= r.low && frequency < r.high {
+			return r.band, true
+		}
+	}
+	return "", false
+}
+
+// Transmitter is a radio transmitter, transceiver or transponder of a satellite.
+type Transmitter struct {
+	ModelBase
+	NoradID     string
+	ExternalID  string // Identifier in the catalog the transmitter was imported from, empty when created by a user
+	Description string
+	Type        string          // Transmitter, Transceiver or Transponder
+	Uplink      *FrequencyRange // Nil for downlink-only transmitters
+	Downlink    *FrequencyRange // Nil for uplink-only transmitters
+	Mode        string          // Downlink modulation, for example FM, BPSK or CW
+	UplinkMode  string
+	Baud        *float64
+	Invert      bool // Inverting transponder
+	Status      TransmitterStatus
+	Service     string // Amateur, Meteorological, Commercial...
+}
+
+// NewTransmitter creates a new Transmitter instance.
+func NewTransmitter(noradID string, externalID string, description string, transmitterType string, uplink *FrequencyRange, downlink *FrequencyRange, mode string, uplinkMode string, baud *float64, invert bool, status TransmitterStatus, service string) (Transmitter, error) {
+	nowUtc := time.Now().UTC()
+	transmitter := Transmitter{
+		ModelBase: ModelBase{
+			ID:          uuid.NewString(),
+			CreatedAt:   nowUtc,
+			UpdatedAt:   &nowUtc,
+			DisplayName: description,
+			IsActive:    status == TransmitterActive,
+			ProcessedAt: &nowUtc,
+		},
+		NoradID:     noradID,
+		ExternalID:  externalID,
+		Description: description,
+		Type:        transmitterType,
+		Uplink:      uplink,
+		Downlink:    downlink,
+		Mode:        mode,
+		UplinkMode:  uplinkMode,
+		Baud:        baud,
+		Invert:      invert,
+		Status:      status,
+		Service:     service,
+	}
+	if err := transmitter.Validate(); err != nil {
+		return Transmitter{}, err
+	}
+	return transmitter, nil
+}
+
+// Validate ensures that the Transmitter fields are valid.
+func (t *Transmitter) Validate() error {
+	if t.NoradID == "" {
+		return errors.New("NORAD ID cannot be empty")
+	}
+	if strings.TrimSpace(t.Description) == "" {
+		return errors.New("transmitter description cannot be empty")
+	}
+	if t.Uplink == nil && t.Downlink == nil {
+		return errors.New("transmitter requires an uplink or a downlink frequency")
+	}
+	if t.Uplink != nil {
+		if err := t.Uplink.Validate(); err != nil {
+			return fmt.Errorf("invalid uplink: %w", err)
+		}
+	}
+	if t.Downlink != nil {
+		if err := t.Downlink.Validate(); err != nil {
+			return fmt.Errorf("invalid downlink: %w", err)
+		}
+	}
+	if t.Baud != nil && *t.Baud < 0 {
+		return errors.New("baud cannot be negative")
+	}
+	return t.Status.IsValid()
+}
+
+// ListenableWith reports whether the downlink of the transmitter falls in one of the bands.
+// Any downlink is listenable when no band is given.
+func (t *Transmitter) ListenableWith(bands []Band) bool {
+	if t.Downlink == nil {
+		return false
+	}
+	if len(bands) == 0 {
+		return true
+	}
+	band, ok := BandOf(t.Downlink.Center())
+	if !ok {
+		return false
+	}
+	for _, b := range bands {
+		if b == band {
+			return true
+		}
+	}
+	return false
+}
+
+// TransmitterImport summarizes the import of a transmitter catalog.
+type TransmitterImport struct {
+	Imported int
+	Skipped  int // Invalid or duplicate entries and entries of unknown satellites
+}
+
+// TransmitterRepository defines the interface for Transmitter operations.
+type TransmitterRepository interface {
+	FindByNoradID(ctx context.Context, noradID string) ([]Transmitter, error)
+	FindByID(ctx context.Context, id string) (Transmitter, error)
+	Save(ctx context.Context, transmitter Transmitter) error
+	Update(ctx context.Context, transmitter Transmitter) error
+	DeleteByID(ctx context.Context, id string) error
+	// SaveBatch upserts imported transmitters on their external ID.
+	SaveBatch(ctx context.Context, transmitters []Transmitter) error
+}
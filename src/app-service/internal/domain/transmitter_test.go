@@ -0,0 +1,94 @@
+package domain
+
+import "testing"
+
+func frequency(hz int64) *int64 {
+	return &hz
+}
+
+func TestNewFrequencyRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		low      *int64
+		high     *int64
+		expected *FrequencyRange
+	}{
+		{name: "no frequency"},
+		{name: "zero low bound", low: frequency(0), high: frequency(145800000)},
+		{name: "single frequency", low: frequency(145800000), expected: &FrequencyRange{Low: 145800000}},
+		{name: "high bound equal to the low bound", low: frequency(145800000), high: frequency(145800000), expected: &FrequencyRange{Low: 145800000}},
+		{name: "range", low: frequency(435000000), high: frequency(438000000), expected: &FrequencyRange{Low: 435000000, High: 438000000}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			frequencies := NewFrequencyRange(tt.low, tt.high)
+			if tt.expected == nil {
+				if frequencies != nil {
+					t.Fatalf("Expected no range, got %+v", *frequencies)
+				}
+				return
+			}
+			if frequencies == nil || *frequencies != *tt.expected {
+				t.Fatalf("Expected %+v, got %v", *tt.expected, frequencies)
+			}
+		})
+	}
+}
+
+func TestBandOf(t *testing.T) {
+	tests := []struct {
+		name      string
+		frequency int64
+		expected  Band
+		ok        bool
+	}{
+		{name: "2m amateur", frequency: 145800000, expected: BandVHF, ok: true},
+		{name: "70cm amateur", frequency: 437800000, expected: BandUHF, ok: true},
+		{name: "lower VHF bound", frequency: 30000000, expected: BandVHF, ok: true},
+		{name: "upper UHF bound belongs to L band", frequency: 1000000000, expected: BandL, ok: true},
+		{name: "S band", frequency: 2245000000, expected: BandS, ok: true},
+		{name: "X band", frequency: 8025000000, expected: BandX, ok: true},
+		{name: "HF", frequency: 29400000},
+		{name: "gap between Ku and Ka bands", frequency: 20000000000},
+		{name: "above Ka band", frequency: 40000000000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			band, ok := BandOf(tt.frequency)
+			if band != tt.expected || ok != tt.ok {
+				t.Errorf("Expected %q, %v, got %q, %v", tt.expected, tt.ok, band, ok)
+			}
+		})
+	}
+}
+
+func TestTransmitterListenableWith(t *testing.T) {
+	tests := []struct {
+		name     string
+		uplink   *FrequencyRange
+		downlink *FrequencyRange
+		bands    []Band
+		expected bool
+	}{
+		{name: "any band", downlink: &FrequencyRange{Low: 145800000}, expected: true},
+		{name: "matching band", downlink: &FrequencyRange{Low: 437800000}, bands: []Band{BandVHF, BandUHF}, expected: true},
+		{name: "other band", downlink: &FrequencyRange{Low: 437800000}, bands: []Band{BandS}},
+		{name: "uplink only", uplink: &FrequencyRange{Low: 145800000}, bands: []Band{BandVHF}},
+		{name: "uplink only with any band", uplink: &FrequencyRange{Low: 145800000}},
+		// A transponder straddling two bands is classified by its center frequency
+		{name: "range centered in the band", downlink: &FrequencyRange{Low: 290000000, High: 320000000}, bands: []Band{BandUHF}, expected: true},
+		{name: "range centered outside the band", downlink: &FrequencyRange{Low: 290000000, High: 300000000}, bands: []Band{BandUHF}},
+		{name: "outside the supported bands", downlink: &FrequencyRange{Low: 29400000}, bands: []Band{BandVHF}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transmitter := Transmitter{Uplink: tt.uplink, Downlink: tt.downlink}
+			if listenable := transmitter.ListenableWith(tt.bands); listenable != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, listenable)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package proc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Elbujito/2112/src/app-service/internal/config"
+	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	repository "github.com/Elbujito/2112/src/app-service/internal/repositories"
+	"github.com/Elbujito/2112/src/app-service/internal/services"
+	logger "github.com/Elbujito/2112/src/app-service/pkg/log"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xutils"
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// DataQuality runs the data quality checks of a context, or of every context when contextName is empty,
+// and prints the findings.
+func DataQuality(ctx context.Context, contextName string, options domain.DataQualityOptions) {
+	database := data.NewDatabase()
+
+	dataQualityRepo := repository.NewDataQualityRepository(&database)
+	satelliteRepo := repository.NewSatelliteRepository(&database)
+	contextRepo := repository.NewContextRepository(&database)
+	dataQualityService := services.NewDataQualityService(dataQualityRepo, satelliteRepo, contextRepo)
+
+	report, err := dataQualityService.Run(ctx, domain.GameContextName(contextName), options)
+	if err != nil {
+		logger.Errorf("Data quality checks failed: %v", err)
+		return
+	}
+	PrintDataQualityReport(report)
+}
+
+// PrintDataQualityReport prints the findings of a data quality report as a table.
+func PrintDataQualityReport(report domain.DataQualityReport) {
+	t := table.NewWriter()
+	t.SetTitle(fmt.Sprintf("Data quality (stale after %d days)", report.StaleDays))
+	t.AppendHeader(table.Row{"Context", "Check", "Issues", "Fixed", "Pruned", "Sample"})
+	for _, finding := range report.Findings {
+		contextName := string(finding.Context)
+		if contextName == "" {
+			contextName = "(catalog)"
+		}
+		t.AppendRow(table.Row{
+			contextName,
+			finding.Check,
+			finding.Count,
+			finding.Fixed,
+			finding.Pruned,
+			strings.Join(finding.Sample, ", "),
+		})
+	}
+	t.AppendFooter(table.Row{"", "Total", report.Issues()})
+	xutils.SetTableBorderStyle(t, config.NoBorderFlag)
+	fmt.Println(t.Render())
+	fmt.Printf("\n")
+}
@@ -7,10 +7,13 @@ import (
 	"time"
 
 	"github.com/Elbujito/2112/src/app-service/internal/clients/celestrack"
+	"github.com/Elbujito/2112/src/app-service/internal/clients/elementsets"
 	propagator "github.com/Elbujito/2112/src/app-service/internal/clients/propagate"
 	"github.com/Elbujito/2112/src/app-service/internal/clients/redis"
+	"github.com/Elbujito/2112/src/app-service/internal/clients/spacetrack"
 	"github.com/Elbujito/2112/src/app-service/internal/config"
 	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
 	repository "github.com/Elbujito/2112/src/app-service/internal/repositories"
 	"github.com/Elbujito/2112/src/app-service/internal/services"
 	"github.com/Elbujito/2112/src/app-service/internal/tasks"
@@ -37,15 +40,60 @@ func TaskExec(ctx context.Context, args []string) {
 
 	tleRepo := repository.NewTLERepository(&database, redisClient, 3600*time.Hour)
 	celestrackClient := celestrack.NewCelestrackClient(config.Env)
+	elementSetSources, err := elementsets.NewRegistry(config.Env)
+	if err != nil {
+		log.Println(err.Error())
+		return
+	}
 	satelliteRepo := repository.NewSatelliteRepository(&database)
 	visibilityRepo := repository.NewTileSatelliteMappingRepository(&database)
 	tileRepo := repository.NewTileRepository(&database)
 	contextRepo := repository.NewContextRepository(&database)
+	geoRepo := repository.NewGeoRepository(&database)
+	decayRepo := repository.NewDecayPredictionRepository(&database)
+	groundStationRepo := repository.NewGroundStationRepository(&database)
+	contactPlanRepo := repository.NewContactPlanRepository(&database)
+	linkWindowRepo := repository.NewLinkWindowRepository(&database)
+	sensorRepo := repository.NewSatelliteSensorRepository(&database)
+	lightingRepo := repository.NewOrbitLightingRepository(&database)
+	constellationRepo := repository.NewConstellationRepository(&database)
+	tileCoverageRepo := repository.NewTileCoverageRepository(&database)
+	geofenceRepo := repository.NewGeofenceRepository(&database)
+	adminAreaRepo := repository.NewAdminAreaRepository(&database)
+	overflightRepo := repository.NewOverflightReportRepository(&database)
+	syncStateRepo := repository.NewSyncStateRepository(&database)
+	satelliteNameRepo := repository.NewSatelliteNameChangeRepository(&database)
+	elementSetOverrideRepo := repository.NewElementSetOverrideRepository(&database)
+	satelliteTagRepo := repository.NewSatelliteTagRepository(&database)
+	transmitterRepo := repository.NewTransmitterRepository(&database)
+	launchRepo := repository.NewLaunchRepository(&database)
+	fragmentationRepo := repository.NewFragmentationEventRepository(&database)
+	spaceTrackClient := spacetrack.NewSpaceTrackClient(config.Env)
+
+	elementSetPolicy, err := domain.ParseElementSetPolicy(config.Env.EnvVars.ElementSets.Precedence)
+	if err != nil {
+		log.Println(err.Error())
+		return
+	}
 
-	tleService := services.NewTleService(celestrackClient, tleRepo, contextRepo)
-	satService := services.NewSatelliteService(tleRepo, propagteClient, celestrackClient, satelliteRepo)
+	tleService := services.NewTleService(celestrackClient, elementSetSources, tleRepo, contextRepo, syncStateRepo)
+	satService := services.NewSatelliteService(tleRepo, propagteClient, celestrackClient, satelliteRepo, satelliteNameRepo)
+	geoService := services.NewGeoService(geoRepo, satelliteRepo, tleRepo)
+	decayService := services.NewDecayService(decayRepo, satelliteRepo, tleRepo)
+	contactSchedulerService := services.NewContactSchedulerService(contactPlanRepo, groundStationRepo, contextRepo, satelliteRepo, tleRepo)
+	linkService := services.NewLinkService(linkWindowRepo, contextRepo, satelliteRepo, tleRepo)
+	lightingService := services.NewOrbitLightingService(lightingRepo, satelliteRepo, tleRepo)
+	constellationService := services.NewConstellationService(constellationRepo, contextRepo, satelliteRepo, tileRepo, tleRepo)
+	tileCoverageService := services.NewTileCoverageService(tileCoverageRepo, contextRepo, tileRepo, satelliteRepo, tleRepo)
+	geofenceService := services.NewGeofenceService(geofenceRepo, contextRepo, tleRepo)
+	overflightService := services.NewOverflightService(overflightRepo, adminAreaRepo, contextRepo, satelliteRepo, tleRepo)
+	elementSetService := services.NewElementSetService(tleRepo, elementSetOverrideRepo, satelliteRepo, elementSetPolicy)
+	tagService := services.NewSatelliteTagService(satelliteTagRepo, satelliteRepo, contextRepo)
+	transmitterService := services.NewTransmitterService(transmitterRepo, satelliteRepo)
+	launchService := services.NewLaunchService(launchRepo, fragmentationRepo)
+	spaceTrackService := services.NewSpaceTrackService(spaceTrackClient, syncStateRepo, tleRepo, satelliteRepo, satelliteNameRepo, &elementSetService)
 
-	monitor, err := tasks.NewTaskMonitor(satelliteRepo, tleRepo, tileRepo, visibilityRepo, tleService, satService, redisClient)
+	monitor, err := tasks.NewTaskMonitor(satelliteRepo, tleRepo, tileRepo, visibilityRepo, sensorRepo, tleService, satService, geoService, decayService, contactSchedulerService, linkService, lightingService, constellationService, tileCoverageService, geofenceService, overflightService, spaceTrackService, elementSetService, tagService, transmitterService, launchService, redisClient)
 	if err != nil {
 		log.Println(err.Error())
 		return
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"gorm.io/gorm/clause"
+)
+
+// AdminAreaRepository manages the admin area boundaries data access.
+type AdminAreaRepository struct {
+	db *data.Database
+}
+
+// NewAdminAreaRepository creates a new AdminAreaRepository instance.
+func NewAdminAreaRepository(db *data.Database) domain.AdminAreaRepository {
+	return &AdminAreaRepository{db: db}
+}
+
+// SaveBatch upserts admin areas by code.
+func (r *AdminAreaRepository) SaveBatch(ctx context.Context, areas []domain.AdminArea) error {
+	if len(areas) == 0 {
+		return nil
+	}
+
+	var modelsBatch []models.AdminArea
+	for _, area := range areas {
+		modelsBatch = append(modelsBatch, models.MapToAdminAreaModel(area))
+	}
+
+	return r.db.DbHandler.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "code"}},
+			DoUpdates: clause.AssignmentColumns([]string{"name", "display_name", "level", "parent_code", "polygons_json", "min_lat", "min_lon", "max_lat", "max_lon", "updated_at"}),
+		}).
+		CreateInBatches(modelsBatch, 50).Error
+}
+
+// FindByCodes retrieves the admin areas matching the given codes, ordered by code.
+func (r *AdminAreaRepository) FindByCodes(ctx context.Context, codes []string) ([]domain.AdminArea, error) {
+	var areas []models.AdminArea
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("code IN ?", codes).
+		Order("code ASC").
+		Find(&areas).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find admin areas: %w", err)
+	}
+	return mapAdminAreas(areas), nil
+}
+
+// FindByLevel retrieves the admin areas of a level, ordered by name.
+func (r *AdminAreaRepository) FindByLevel(ctx context.Context, level int) ([]domain.AdminArea, error) {
+	var areas []models.AdminArea
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("level = ?", level).
+		Order("name ASC").
+		Find(&areas).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find admin areas of level %d: %w", level, err)
+	}
+	return mapAdminAreas(areas), nil
+}
+
+func mapAdminAreas(areas []models.AdminArea) []domain.AdminArea {
+	var domainAreas []domain.AdminArea
+	for _, area := range areas {
+		domainAreas = append(domainAreas, models.MapToAdminAreaDomain(area))
+	}
+	return domainAreas
+}
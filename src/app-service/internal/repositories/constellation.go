@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"gorm.io/gorm"
+)
+
+// ConstellationRepository manages the constellations and their coverage reports data access.
+type ConstellationRepository struct {
+	db *data.Database
+}
+
+// NewConstellationRepository creates a new ConstellationRepository instance.
+func NewConstellationRepository(db *data.Database) domain.ConstellationRepository {
+	return &ConstellationRepository{db: db}
+}
+
+// Save stores a constellation, its members and their synthetic satellites with their element sets in a single
+// transaction, assigning the satellites to the context of the constellation. It fails with ErrNoradIDTaken,
+// storing nothing, when one of the NORAD IDs is already cataloged.
+func (r *ConstellationRepository) Save(ctx context.Context, constellation domain.Constellation, satellites []domain.Satellite, tles []domain.TLE) error {
+	model, members := models.MapToConstellationModel(constellation)
+	return r.db.DbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := createSyntheticSatellites(tx, satellites, tles, constellation.ContextID); err != nil {
+			return err
+		}
+		if err := tx.Create(&model).Error; err != nil {
+			return fmt.Errorf("failed to save constellation: %w", err)
+		}
+		if len(members) == 0 {
+			return nil
+		}
+		if err := tx.CreateInBatches(members, 100).Error; err != nil {
+			return fmt.Errorf("failed to save constellation members: %w", err)
+		}
+		return nil
+	})
+}
+
+// ExistsByName checks whether a context already has a constellation with the given name.
+func (r *ConstellationRepository) ExistsByName(ctx context.Context, contextID string, name string) (bool, error) {
+	var count int64
+	err := r.db.DbHandler.WithContext(ctx).
+		Model(&models.Constellation{}).
+		Where("context_id = ? AND name = ?", contextID, name).
+		Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("failed to check constellation %s: %w", name, err)
+	}
+	return count > 0, nil
+}
+
+// FindByID retrieves a constellation by ID within a context and tenant.
+func (r *ConstellationRepository) FindByID(ctx context.Context, contextID string, tenantID domain.TenantID, id string) (domain.Constellation, error) {
+	var constellation models.Constellation
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("id = ? AND context_id = ? AND tenant_id = ?", id, contextID, string(tenantID)).
+		First(&constellation).Error
+	if err != nil {
+		return domain.Constellation{}, fmt.Errorf("failed to find constellation %s: %w", id, err)
+	}
+
+	members, err := r.findMembers(ctx, []string{constellation.ID})
+	if err != nil {
+		return domain.Constellation{}, err
+	}
+	return models.MapToConstellationDomain(constellation, members[constellation.ID]), nil
+}
+
+// FindAllByContext retrieves the constellations of a context and tenant, ordered by name.
+func (r *ConstellationRepository) FindAllByContext(ctx context.Context, contextID string, tenantID domain.TenantID) ([]domain.Constellation, error) {
+	var constellations []models.Constellation
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("context_id = ? AND tenant_id = ?", contextID, string(tenantID)).
+		Order("name ASC").
+		Find(&constellations).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find constellations: %w", err)
+	}
+
+	ids := make([]string, len(constellations))
+	for i, constellation := range constellations {
+		ids[i] = constellation.ID
+	}
+	members, err := r.findMembers(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var domainConstellations []domain.Constellation
+	for _, constellation := range constellations {
+		domainConstellations = append(domainConstellations, models.MapToConstellationDomain(constellation, members[constellation.ID]))
+	}
+	return domainConstellations, nil
+}
+
+// DeleteByID removes a constellation, its members and its coverage reports within a context and tenant.
+func (r *ConstellationRepository) DeleteByID(ctx context.Context, contextID string, tenantID domain.TenantID, id string) error {
+	return r.db.DbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND context_id = ? AND tenant_id = ?", id, contextID, string(tenantID)).
+			Delete(&models.Constellation{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete constellation: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("constellation %s not found", id)
+		}
+		if err := tx.Where("constellation_id = ?", id).Delete(&models.ConstellationSatellite{}).Error; err != nil {
+			return fmt.Errorf("failed to delete constellation members: %w", err)
+		}
+		if err := tx.Where("constellation_id = ?", id).Delete(&models.ConstellationCoverageReport{}).Error; err != nil {
+			return fmt.Errorf("failed to delete coverage reports: %w", err)
+		}
+		return nil
+	})
+}
+
+// SaveCoverageReport stores a coverage report.
+func (r *ConstellationRepository) SaveCoverageReport(ctx context.Context, report domain.ConstellationCoverageReport) error {
+	model := models.MapToConstellationCoverageReportModel(report)
+	return r.db.DbHandler.WithContext(ctx).Create(&model).Error
+}
+
+// FindLatestCoverageReport retrieves the most recent coverage report of a constellation, or nil if none was computed.
+func (r *ConstellationRepository) FindLatestCoverageReport(ctx context.Context, constellationID string) (*domain.ConstellationCoverageReport, error) {
+	var report models.ConstellationCoverageReport
+	result := r.db.DbHandler.WithContext(ctx).
+		Where("constellation_id = ?", constellationID).
+		Order("created_at DESC").
+		First(&report)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	} else if result.Error != nil {
+		return nil, result.Error
+	}
+
+	reportMapped := models.MapToConstellationCoverageReportDomain(report)
+	return &reportMapped, nil
+}
+
+// findMembers retrieves the member NORAD IDs of the given constellations, grouped by constellation.
+func (r *ConstellationRepository) findMembers(ctx context.Context, constellationIDs []string) (map[string][]models.ConstellationSatellite, error) {
+	grouped := make(map[string][]models.ConstellationSatellite)
+	if len(constellationIDs) == 0 {
+		return grouped, nil
+	}
+
+	var members []models.ConstellationSatellite
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("constellation_id IN ?", constellationIDs).
+		Order("norad_id ASC").
+		Find(&members).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find constellation members: %w", err)
+	}
+
+	for _, member := range members {
+		grouped[member.ConstellationID] = append(grouped[member.ConstellationID], member)
+	}
+	return grouped, nil
+}
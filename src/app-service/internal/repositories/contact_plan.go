@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"gorm.io/gorm"
+)
+
+// ContactPlanRepository manages the contact plans data access.
+type ContactPlanRepository struct {
+	db *data.Database
+}
+
+// NewContactPlanRepository creates a new ContactPlanRepository instance.
+func NewContactPlanRepository(db *data.Database) domain.ContactPlanRepository {
+	return &ContactPlanRepository{db: db}
+}
+
+// Save stores a plan and its contacts in a single transaction.
+func (r *ContactPlanRepository) Save(ctx context.Context, plan domain.ContactPlan) error {
+	planModel, contacts := models.MapToContactPlanModel(plan)
+	return r.db.DbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&planModel).Error; err != nil {
+			return fmt.Errorf("failed to save contact plan: %w", err)
+		}
+		if len(contacts) == 0 {
+			return nil
+		}
+		if err := tx.CreateInBatches(contacts, 100).Error; err != nil {
+			return fmt.Errorf("failed to save contacts: %w", err)
+		}
+		return nil
+	})
+}
+
+// FindLatestByContext retrieves the most recent plan of a context and tenant with its contacts.
+func (r *ContactPlanRepository) FindLatestByContext(ctx context.Context, contextID string, tenantID domain.TenantID) (domain.ContactPlan, error) {
+	var plan models.ContactPlan
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("context_id = ? AND tenant_id = ?", contextID, string(tenantID)).
+		Order("created_at DESC").
+		First(&plan).Error
+	if err != nil {
+		return domain.ContactPlan{}, fmt.Errorf("failed to find contact plan: %w", err)
+	}
+
+	var contacts []models.Contact
+	err = r.db.DbHandler.WithContext(ctx).
+		Where("plan_id = ?", plan.ID).
+		Order("aos ASC").
+		Find(&contacts).Error
+	if err != nil {
+		return domain.ContactPlan{}, fmt.Errorf("failed to find contacts: %w", err)
+	}
+
+	return models.MapToContactPlanDomain(plan, contacts), nil
+}
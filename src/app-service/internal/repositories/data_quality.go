@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+// orphanTLECondition matches the TLEs without a satellite row, deleted or not.
+const orphanTLECondition = "NOT EXISTS (SELECT 1 FROM satellites WHERE satellites.norad_id = tles.norad_id)"
+
+// danglingMappingCondition matches the mappings whose tile was deleted.
+const danglingMappingCondition = "NOT EXISTS (SELECT 1 FROM tiles WHERE tiles.id = tile_satellite_mappings.tile_id)"
+
+// DataQualityRepository runs the consistency queries of the catalog.
+type DataQualityRepository struct {
+	db *data.Database
+}
+
+// NewDataQualityRepository creates a new DataQualityRepository instance.
+func NewDataQualityRepository(db *data.Database) domain.DataQualityRepository {
+	return &DataQualityRepository{db: db}
+}
+
+// FindOrphanTLEs retrieves the latest TLE of each NORAD ID without a satellite.
+func (r *DataQualityRepository) FindOrphanTLEs(ctx context.Context) ([]domain.TLE, error) {
+	var tles []models.TLE
+	err := r.db.DbHandler.WithContext(ctx).
+		Raw("SELECT DISTINCT ON (norad_id) * FROM tles WHERE " + orphanTLECondition + " ORDER BY norad_id, epoch DESC").
+		Scan(&tles).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find orphan TLEs: %w", err)
+	}
+
+	var domainTLEs []domain.TLE
+	for _, tle := range tles {
+		domainTLEs = append(domainTLEs, models.MapToTLEDomain(tle))
+	}
+	return domainTLEs, nil
+}
+
+// DeleteOrphanTLEs removes the TLEs without a satellite and returns their number.
+func (r *DataQualityRepository) DeleteOrphanTLEs(ctx context.Context) (int64, error) {
+	result := r.db.DbHandler.WithContext(ctx).
+		Where(orphanTLECondition).
+		Delete(&models.TLE{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete orphan TLEs: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// FindSatellitesWithoutTLE retrieves the satellites of a context without any TLE.
+func (r *DataQualityRepository) FindSatellitesWithoutTLE(ctx context.Context, contextID string) ([]domain.Satellite, error) {
+	var satellites []models.Satellite
+	err := r.db.DbHandler.WithContext(ctx).
+		Joins("JOIN context_satellites ON satellites.id = context_satellites.satellite_id").
+		Where("context_satellites.context_id = ? AND satellites.deleted_at IS NULL", contextID).
+		Where("NOT EXISTS (SELECT 1 FROM tles WHERE tles.norad_id = satellites.norad_id)").
+		Order("satellites.norad_id ASC").
+		Find(&satellites).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find satellites without TLE: %w", err)
+	}
+
+	var domainSatellites []domain.Satellite
+	for _, satellite := range satellites {
+		domainSatellites = append(domainSatellites, models.MapToSatelliteDomain(satellite))
+	}
+	return domainSatellites, nil
+}
+
+// FindStaleSatellites retrieves the NORAD IDs of the satellites of a context whose current TLE has an epoch
+// before the given time.
+func (r *DataQualityRepository) FindStaleSatellites(ctx context.Context, contextID string, before time.Time) ([]string, error) {
+	var noradIDs []string
+	err := r.db.DbHandler.WithContext(ctx).
+		Table("satellites").
+		Joins("JOIN context_satellites ON satellites.id = context_satellites.satellite_id").
+		Joins("JOIN tles ON tles.norad_id = satellites.norad_id AND tles.current").
+		Where("context_satellites.context_id = ? AND satellites.deleted_at IS NULL", contextID).
+		Where("tles.epoch < ?", before).
+		Order("satellites.norad_id ASC").
+		Pluck("satellites.norad_id", &noradIDs).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find satellites with stale TLEs: %w", err)
+	}
+	return noradIDs, nil
+}
+
+// FindDanglingMappings retrieves the mappings of a context whose tile does not exist anymore.
+func (r *DataQualityRepository) FindDanglingMappings(ctx context.Context, contextID string) ([]domain.TileSatelliteMapping, error) {
+	var mappings []models.TileSatelliteMapping
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("context_id = ?", contextID).
+		Where(danglingMappingCondition).
+		Order("norad_id ASC").
+		Find(&mappings).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find dangling mappings: %w", err)
+	}
+
+	var domainMappings []domain.TileSatelliteMapping
+	for _, mapping := range mappings {
+		domainMapping, err := models.MapToTileSatelliteMappingDomain(mapping)
+		if err != nil {
+			return nil, err
+		}
+		domainMappings = append(domainMappings, domainMapping)
+	}
+	return domainMappings, nil
+}
+
+// DeleteDanglingMappings removes the mappings of a context whose tile does not exist anymore and returns their number.
+func (r *DataQualityRepository) DeleteDanglingMappings(ctx context.Context, contextID string) (int64, error) {
+	result := r.db.DbHandler.WithContext(ctx).
+		Where("context_id = ?", contextID).
+		Where(danglingMappingCondition).
+		Delete(&models.TileSatelliteMapping{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete dangling mappings: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
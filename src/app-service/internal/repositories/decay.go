@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"gorm.io/gorm/clause"
+)
+
+// DecayPredictionRepository manages the reentry predictions data access.
+type DecayPredictionRepository struct {
+	db *data.Database
+}
+
+// NewDecayPredictionRepository creates a new DecayPredictionRepository instance.
+func NewDecayPredictionRepository(db *data.Database) domain.DecayPredictionRepository {
+	return &DecayPredictionRepository{db: db}
+}
+
+// SaveBatch upserts the predictions by NORAD ID.
+func (r *DecayPredictionRepository) SaveBatch(ctx context.Context, predictions []domain.DecayPrediction) error {
+	if len(predictions) == 0 {
+		return nil
+	}
+
+	var modelsBatch []models.DecayPrediction
+	for _, prediction := range predictions {
+		modelsBatch = append(modelsBatch, models.MapToDecayPredictionModel(prediction))
+	}
+
+	return r.db.DbHandler.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "norad_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"name", "perigee", "tle_epoch", "decay_at", "window_start", "window_end", "ballistic", "f107", "ap", "updated_at", "processed_at"}),
+		}).
+		CreateInBatches(modelsBatch, 100).Error
+}
+
+// FindUpcoming retrieves the predictions with a reentry between from and until, soonest first.
+func (r *DecayPredictionRepository) FindUpcoming(ctx context.Context, from time.Time, until time.Time) ([]domain.DecayPrediction, error) {
+	var predictions []models.DecayPrediction
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("decay_at >= ? AND decay_at <= ?", from, until).
+		Order("decay_at ASC").
+		Find(&predictions).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find upcoming reentries: %w", err)
+	}
+
+	var domainPredictions []domain.DecayPrediction
+	for _, prediction := range predictions {
+		domainPredictions = append(domainPredictions, models.MapToDecayPredictionDomain(prediction))
+	}
+	return domainPredictions, nil
+}
+
+// FindPassed retrieves the predictions whose whole reentry window ended before the given time.
+func (r *DecayPredictionRepository) FindPassed(ctx context.Context, before time.Time) ([]domain.DecayPrediction, error) {
+	var predictions []models.DecayPrediction
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("window_end < ?", before).
+		Find(&predictions).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find passed reentries: %w", err)
+	}
+
+	var domainPredictions []domain.DecayPrediction
+	for _, prediction := range predictions {
+		domainPredictions = append(domainPredictions, models.MapToDecayPredictionDomain(prediction))
+	}
+	return domainPredictions, nil
+}
+
+// DeleteByNoradID removes the prediction of a satellite.
+func (r *DecayPredictionRepository) DeleteByNoradID(ctx context.Context, noradID string) error {
+	return r.db.DbHandler.WithContext(ctx).
+		Where("norad_id = ?", noradID).
+		Delete(&models.DecayPrediction{}).Error
+}
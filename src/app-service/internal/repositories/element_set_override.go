@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ElementSetOverrideRepository manages the operator overrides of element set selection.
+type ElementSetOverrideRepository struct {
+	db *data.Database
+}
+
+// NewElementSetOverrideRepository creates a new ElementSetOverrideRepository instance.
+func NewElementSetOverrideRepository(db *data.Database) domain.ElementSetOverrideRepository {
+	return &ElementSetOverrideRepository{db: db}
+}
+
+// FindByNoradID retrieves the override of a satellite, a zero override when there is none.
+func (r *ElementSetOverrideRepository) FindByNoradID(ctx context.Context, noradID string) (domain.ElementSetOverride, error) {
+	var override models.ElementSetOverride
+	err := r.db.DbHandler.WithContext(ctx).Where("norad_id = ?", noradID).First(&override).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.ElementSetOverride{}, nil
+	}
+	if err != nil {
+		return domain.ElementSetOverride{}, fmt.Errorf("failed to find element set override of NORAD ID %s: %w", noradID, err)
+	}
+	return models.MapToElementSetOverrideDomain(override), nil
+}
+
+// FindByNoradIDs retrieves the overrides of several satellites by NORAD ID.
+func (r *ElementSetOverrideRepository) FindByNoradIDs(ctx context.Context, noradIDs []string) (map[string]domain.ElementSetOverride, error) {
+	overrides := make(map[string]domain.ElementSetOverride)
+	if len(noradIDs) == 0 {
+		return overrides, nil
+	}
+	var overrideModels []models.ElementSetOverride
+	if err := r.db.DbHandler.WithContext(ctx).Where("norad_id IN ?", noradIDs).Find(&overrideModels).Error; err != nil {
+		return nil, fmt.Errorf("failed to find element set overrides of %d satellites: %w", len(noradIDs), err)
+	}
+	for _, model := range overrideModels {
+		overrides[model.NoradID] = models.MapToElementSetOverrideDomain(model)
+	}
+	return overrides, nil
+}
+
+// Save creates or replaces the override of a satellite.
+func (r *ElementSetOverrideRepository) Save(ctx context.Context, override domain.ElementSetOverride) error {
+	model := models.MapToElementSetOverrideModel(override)
+	return r.db.DbHandler.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "norad_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"tle_id", "reason", "updated_at", "processed_at"}),
+	}).Create(&model).Error
+}
+
+// DeleteByNoradID removes the override of a satellite.
+func (r *ElementSetOverrideRepository) DeleteByNoradID(ctx context.Context, noradID string) error {
+	return r.db.DbHandler.WithContext(ctx).Where("norad_id = ?", noradID).Delete(&models.ElementSetOverride{}).Error
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"gorm.io/gorm"
+)
+
+// FragmentationEventRepository manages the fragmentation events data access.
+type FragmentationEventRepository struct {
+	db *data.Database
+}
+
+// NewFragmentationEventRepository creates a new FragmentationEventRepository instance.
+func NewFragmentationEventRepository(db *data.Database) domain.FragmentationEventRepository {
+	return &FragmentationEventRepository{db: db}
+}
+
+// FindOpenByLaunch retrieves the latest event of a launch whose window ended after the given time,
+// a zero event when there is none.
+func (r *FragmentationEventRepository) FindOpenByLaunch(ctx context.Context, launch string, since time.Time) (domain.FragmentationEvent, error) {
+	var event models.FragmentationEvent
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("launch = ? AND window_end >= ?", launch, since).
+		Order("window_end DESC").
+		First(&event).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.FragmentationEvent{}, nil
+	}
+	if err != nil {
+		return domain.FragmentationEvent{}, fmt.Errorf("failed to find fragmentation event of launch %s: %w", launch, err)
+	}
+	return models.MapToFragmentationEventDomain(event), nil
+}
+
+// FindSince retrieves the events detected after the given time, most recent first.
+func (r *FragmentationEventRepository) FindSince(ctx context.Context, since time.Time) ([]domain.FragmentationEvent, error) {
+	var events []models.FragmentationEvent
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("detected_at >= ?", since).
+		Order("detected_at DESC").
+		Find(&events).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find fragmentation events: %w", err)
+	}
+
+	var domainEvents []domain.FragmentationEvent
+	for _, event := range events {
+		domainEvents = append(domainEvents, models.MapToFragmentationEventDomain(event))
+	}
+	return domainEvents, nil
+}
+
+// Save creates or updates an event.
+func (r *FragmentationEventRepository) Save(ctx context.Context, event domain.FragmentationEvent) error {
+	model := models.MapToFragmentationEventModel(event)
+	return r.db.DbHandler.WithContext(ctx).Save(&model).Error
+}
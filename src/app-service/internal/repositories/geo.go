@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"gorm.io/gorm/clause"
+)
+
+// GeoRepository manages the GEO catalog data access.
+type GeoRepository struct {
+	db *data.Database
+}
+
+// NewGeoRepository creates a new GeoRepository instance.
+func NewGeoRepository(db *data.Database) domain.GeoRepository {
+	return &GeoRepository{db: db}
+}
+
+// SaveBatch upserts GEO catalog entries by NORAD ID.
+func (r *GeoRepository) SaveBatch(ctx context.Context, objects []domain.GeoObject) error {
+	if len(objects) == 0 {
+		return nil
+	}
+
+	var modelsBatch []models.GeoObject
+	for _, object := range objects {
+		modelsBatch = append(modelsBatch, models.MapToGeoObjectModel(object))
+	}
+
+	return r.db.DbHandler.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "norad_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"name", "longitude", "drift_rate", "inclination", "longitude_span", "status", "epoch", "updated_at", "processed_at"}),
+		}).
+		CreateInBatches(modelsBatch, 100).Error
+}
+
+// SaveObservations stores GEO observations, ignoring the ones already recorded for the same epoch.
+func (r *GeoRepository) SaveObservations(ctx context.Context, observations []domain.GeoObservation) error {
+	if len(observations) == 0 {
+		return nil
+	}
+
+	var modelsBatch []models.GeoObservation
+	for _, observation := range observations {
+		modelsBatch = append(modelsBatch, models.MapToGeoObservationModel(observation))
+	}
+
+	return r.db.DbHandler.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		CreateInBatches(modelsBatch, 500).Error
+}
+
+// FindNearLongitude retrieves the GEO objects within tolerance degrees of a longitude, closest first.
+func (r *GeoRepository) FindNearLongitude(ctx context.Context, longitude float64, tolerance float64) ([]domain.GeoObject, error) {
+	// Angular distance wrapped into [0, 180] to handle the antimeridian
+	distance := "ABS(MOD((longitude - ? + 540)::numeric, 360) - 180)"
+
+	var objects []models.GeoObject
+	err := r.db.DbHandler.WithContext(ctx).
+		Where(distance+" <= ?", longitude, tolerance).
+		Order(clause.OrderBy{Expression: clause.Expr{SQL: distance, Vars: []interface{}{longitude}}}).
+		Find(&objects).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find GEO objects near longitude %f: %w", longitude, err)
+	}
+
+	var domainObjects []domain.GeoObject
+	for _, object := range objects {
+		domainObjects = append(domainObjects, models.MapToGeoObjectDomain(object))
+	}
+	return domainObjects, nil
+}
+
+// FindObservationsByNoradID retrieves the GEO observations of a satellite since a given time, oldest first.
+func (r *GeoRepository) FindObservationsByNoradID(ctx context.Context, noradID string, since time.Time) ([]domain.GeoObservation, error) {
+	var observations []models.GeoObservation
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("norad_id = ? AND epoch >= ?", noradID, since).
+		Order("epoch ASC").
+		Find(&observations).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find GEO observations for NORAD ID %s: %w", noradID, err)
+	}
+
+	var domainObservations []domain.GeoObservation
+	for _, observation := range observations {
+		domainObservations = append(domainObservations, models.MapToGeoObservationDomain(observation))
+	}
+	return domainObservations, nil
+}
+
+// DeleteNotIn removes the GEO catalog entries of satellites that are no longer geosynchronous.
+func (r *GeoRepository) DeleteNotIn(ctx context.Context, noradIDs []string) error {
+	query := r.db.DbHandler.WithContext(ctx)
+	if len(noradIDs) == 0 {
+		return query.Where("1 = 1").Delete(&models.GeoObject{}).Error
+	}
+	return query.Where("norad_id NOT IN ?", noradIDs).Delete(&models.GeoObject{}).Error
+}
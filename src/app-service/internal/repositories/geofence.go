@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"gorm.io/gorm"
+)
+
+// GeofenceRepository manages the geofences and their crossing events data access.
+type GeofenceRepository struct {
+	db *data.Database
+}
+
+// NewGeofenceRepository creates a new GeofenceRepository instance.
+func NewGeofenceRepository(db *data.Database) domain.GeofenceRepository {
+	return &GeofenceRepository{db: db}
+}
+
+// Save stores a geofence and its watched satellites in a single transaction.
+func (r *GeofenceRepository) Save(ctx context.Context, geofence domain.Geofence) error {
+	model, members := models.MapToGeofenceModel(geofence)
+	return r.db.DbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&model).Error; err != nil {
+			return fmt.Errorf("failed to save geofence: %w", err)
+		}
+		if len(members) == 0 {
+			return nil
+		}
+		if err := tx.CreateInBatches(members, 100).Error; err != nil {
+			return fmt.Errorf("failed to save geofence satellites: %w", err)
+		}
+		return nil
+	})
+}
+
+// Update updates a geofence and replaces its watched satellites within its context and tenant.
+func (r *GeofenceRepository) Update(ctx context.Context, geofence domain.Geofence) error {
+	model, members := models.MapToGeofenceModel(geofence)
+	return r.db.DbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Geofence{}).
+			Where("id = ? AND context_id = ? AND tenant_id = ?", model.ID, model.ContextID, model.TenantID).
+			Updates(map[string]interface{}{
+				"name":         model.Name,
+				"display_name": model.DisplayName,
+				"polygon_json": model.PolygonJSON,
+				"updated_at":   model.UpdatedAt,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to update geofence: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("geofence %s not found", geofence.ID)
+		}
+		if err := tx.Where("geofence_id = ?", model.ID).Delete(&models.GeofenceSatellite{}).Error; err != nil {
+			return fmt.Errorf("failed to delete geofence satellites: %w", err)
+		}
+		if len(members) == 0 {
+			return nil
+		}
+		if err := tx.CreateInBatches(members, 100).Error; err != nil {
+			return fmt.Errorf("failed to save geofence satellites: %w", err)
+		}
+		return nil
+	})
+}
+
+// FindByID retrieves a geofence by ID within a context and tenant.
+func (r *GeofenceRepository) FindByID(ctx context.Context, contextID string, tenantID domain.TenantID, id string) (domain.Geofence, error) {
+	var geofence models.Geofence
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("id = ? AND context_id = ? AND tenant_id = ?", id, contextID, string(tenantID)).
+		First(&geofence).Error
+	if err != nil {
+		return domain.Geofence{}, fmt.Errorf("failed to find geofence %s: %w", id, err)
+	}
+
+	members, err := r.findMembers(ctx, []string{geofence.ID})
+	if err != nil {
+		return domain.Geofence{}, err
+	}
+	return models.MapToGeofenceDomain(geofence, members[geofence.ID]), nil
+}
+
+// FindAllByContext retrieves the geofences of a context and tenant, ordered by name.
+func (r *GeofenceRepository) FindAllByContext(ctx context.Context, contextID string, tenantID domain.TenantID) ([]domain.Geofence, error) {
+	var geofences []models.Geofence
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("context_id = ? AND tenant_id = ?", contextID, string(tenantID)).
+		Order("name ASC").
+		Find(&geofences).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find geofences: %w", err)
+	}
+	return r.withMembers(ctx, geofences)
+}
+
+// FindByNoradID retrieves the active geofences watching a satellite, across all contexts.
+func (r *GeofenceRepository) FindByNoradID(ctx context.Context, noradID string) ([]domain.Geofence, error) {
+	var geofences []models.Geofence
+	err := r.db.DbHandler.WithContext(ctx).
+		Joins("JOIN geofence_satellites gs ON gs.geofence_id = geofences.id").
+		Where("gs.norad_id = ? AND geofences.is_active = ?", noradID, true).
+		Order("geofences.name ASC").
+		Find(&geofences).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find geofences watching %s: %w", noradID, err)
+	}
+	return r.withMembers(ctx, geofences)
+}
+
+// DeleteByID removes a geofence, its watched satellites and its events within a context and tenant.
+func (r *GeofenceRepository) DeleteByID(ctx context.Context, contextID string, tenantID domain.TenantID, id string) error {
+	return r.db.DbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND context_id = ? AND tenant_id = ?", id, contextID, string(tenantID)).
+			Delete(&models.Geofence{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete geofence: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("geofence %s not found", id)
+		}
+		if err := tx.Where("geofence_id = ?", id).Delete(&models.GeofenceSatellite{}).Error; err != nil {
+			return fmt.Errorf("failed to delete geofence satellites: %w", err)
+		}
+		if err := tx.Where("geofence_id = ?", id).Delete(&models.GeofenceEvent{}).Error; err != nil {
+			return fmt.Errorf("failed to delete geofence events: %w", err)
+		}
+		return nil
+	})
+}
+
+// ReplaceEvents replaces the events of a satellite on a geofence between start and end in a single transaction.
+func (r *GeofenceRepository) ReplaceEvents(ctx context.Context, geofenceID string, noradID string, start time.Time, end time.Time, events []domain.GeofenceEvent) error {
+	return r.db.DbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("geofence_id = ? AND norad_id = ? AND occurred_at >= ? AND occurred_at <= ?", geofenceID, noradID, start, end).
+			Delete(&models.GeofenceEvent{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete geofence events: %w", err)
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		var modelsBatch []models.GeofenceEvent
+		for _, event := range events {
+			modelsBatch = append(modelsBatch, models.MapToGeofenceEventModel(event))
+		}
+		if err := tx.CreateInBatches(modelsBatch, 100).Error; err != nil {
+			return fmt.Errorf("failed to save geofence events: %w", err)
+		}
+		return nil
+	})
+}
+
+// FindEvents retrieves the events of a geofence between start and end, oldest first.
+func (r *GeofenceRepository) FindEvents(ctx context.Context, geofenceID string, start time.Time, end time.Time) ([]domain.GeofenceEvent, error) {
+	var events []models.GeofenceEvent
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("geofence_id = ? AND occurred_at >= ? AND occurred_at <= ?", geofenceID, start, end).
+		Order("occurred_at ASC").
+		Find(&events).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find geofence events: %w", err)
+	}
+
+	var domainEvents []domain.GeofenceEvent
+	for _, event := range events {
+		domainEvents = append(domainEvents, models.MapToGeofenceEventDomain(event))
+	}
+	return domainEvents, nil
+}
+
+// withMembers maps geofences to domain models along with their watched satellites.
+func (r *GeofenceRepository) withMembers(ctx context.Context, geofences []models.Geofence) ([]domain.Geofence, error) {
+	ids := make([]string, len(geofences))
+	for i, geofence := range geofences {
+		ids[i] = geofence.ID
+	}
+	members, err := r.findMembers(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var domainGeofences []domain.Geofence
+	for _, geofence := range geofences {
+		domainGeofences = append(domainGeofences, models.MapToGeofenceDomain(geofence, members[geofence.ID]))
+	}
+	return domainGeofences, nil
+}
+
+// findMembers retrieves the watched NORAD IDs of the given geofences, grouped by geofence.
+func (r *GeofenceRepository) findMembers(ctx context.Context, geofenceIDs []string) (map[string][]models.GeofenceSatellite, error) {
+	grouped := make(map[string][]models.GeofenceSatellite)
+	if len(geofenceIDs) == 0 {
+		return grouped, nil
+	}
+
+	var members []models.GeofenceSatellite
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("geofence_id IN ?", geofenceIDs).
+		Order("norad_id ASC").
+		Find(&members).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find geofence satellites: %w", err)
+	}
+
+	for _, member := range members {
+		grouped[member.GeofenceID] = append(grouped[member.GeofenceID], member)
+	}
+	return grouped, nil
+}
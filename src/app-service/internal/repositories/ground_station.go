@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+// GroundStationRepository manages the ground stations data access.
+type GroundStationRepository struct {
+	db *data.Database
+}
+
+// NewGroundStationRepository creates a new GroundStationRepository instance.
+func NewGroundStationRepository(db *data.Database) domain.GroundStationRepository {
+	return &GroundStationRepository{db: db}
+}
+
+// Save creates a new ground station.
+func (r *GroundStationRepository) Save(ctx context.Context, station domain.GroundStation) error {
+	model := models.MapToGroundStationModel(station)
+	return r.db.DbHandler.WithContext(ctx).Create(&model).Error
+}
+
+// Update updates an existing ground station within its context and tenant.
+func (r *GroundStationRepository) Update(ctx context.Context, station domain.GroundStation) error {
+	model := models.MapToGroundStationModel(station)
+	result := r.db.DbHandler.WithContext(ctx).
+		Model(&models.GroundStation{}).
+		Where("id = ? AND context_id = ? AND tenant_id = ?", model.ID, model.ContextID, model.TenantID).
+		Updates(map[string]interface{}{
+			"name":              model.Name,
+			"display_name":      model.DisplayName,
+			"latitude":          model.Latitude,
+			"longitude":         model.Longitude,
+			"altitude":          model.Altitude,
+			"min_elevation":     model.MinElevation,
+			"horizon_mask_json": model.HorizonMaskJSON,
+			"bands_json":        model.BandsJSON,
+			"pressure_mbar":     model.PressureMbar,
+			"temperature_c":     model.TemperatureC,
+			"updated_at":        model.UpdatedAt,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update ground station: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("ground station %s not found", station.ID)
+	}
+	return nil
+}
+
+// FindByID retrieves a ground station by ID within a context and tenant.
+func (r *GroundStationRepository) FindByID(ctx context.Context, contextID string, tenantID domain.TenantID, id string) (domain.GroundStation, error) {
+	var station models.GroundStation
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("id = ? AND context_id = ? AND tenant_id = ?", id, contextID, string(tenantID)).
+		First(&station).Error
+	if err != nil {
+		return domain.GroundStation{}, fmt.Errorf("failed to find ground station %s: %w", id, err)
+	}
+	return models.MapToGroundStationDomain(station), nil
+}
+
+// FindAllByContext retrieves the ground stations of a context and tenant, ordered by name.
+func (r *GroundStationRepository) FindAllByContext(ctx context.Context, contextID string, tenantID domain.TenantID) ([]domain.GroundStation, error) {
+	var stations []models.GroundStation
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("context_id = ? AND tenant_id = ?", contextID, string(tenantID)).
+		Order("name ASC").
+		Find(&stations).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find ground stations: %w", err)
+	}
+
+	var domainStations []domain.GroundStation
+	for _, station := range stations {
+		domainStations = append(domainStations, models.MapToGroundStationDomain(station))
+	}
+	return domainStations, nil
+}
+
+// DeleteByID removes a ground station within a context and tenant.
+func (r *GroundStationRepository) DeleteByID(ctx context.Context, contextID string, tenantID domain.TenantID, id string) error {
+	result := r.db.DbHandler.WithContext(ctx).
+		Where("id = ? AND context_id = ? AND tenant_id = ?", id, contextID, string(tenantID)).
+		Delete(&models.GroundStation{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete ground station: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("ground station %s not found", id)
+	}
+	return nil
+}
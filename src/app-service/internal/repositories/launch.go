@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"gorm.io/gorm"
+)
+
+// launchSummaryColumns aggregates the satellites of a launch. Object types are spelled "PAY", "R/B" and "DEB"
+// by CelesTrak and "PAYLOAD", "ROCKET BODY" and "DEBRIS" by Space-Track.
+const launchSummaryColumns = `
+	launch_year, launch_number,
+	MIN(launch_date) AS launch_date,
+	MIN(launch_site) AS launch_site,
+	COUNT(*) AS objects,
+	COUNT(*) FILTER (WHERE object_type IN ('PAY', 'PAYLOAD')) AS payloads,
+	COUNT(*) FILTER (WHERE object_type IN ('R/B', 'ROCKET BODY')) AS rocket_bodies,
+	COUNT(*) FILTER (WHERE object_type IN ('DEB', 'DEBRIS')) AS debris,
+	COUNT(*) FILTER (WHERE decay_date IS NOT NULL) AS decayed`
+
+// launchSummary is a row of the launches view.
+type launchSummary struct {
+	LaunchYear   int        `gorm:"column:launch_year"`
+	LaunchNumber int        `gorm:"column:launch_number"`
+	LaunchDate   *time.Time `gorm:"column:launch_date"`
+	LaunchSite   string     `gorm:"column:launch_site"`
+	Objects      int        `gorm:"column:objects"`
+	Payloads     int        `gorm:"column:payloads"`
+	RocketBodies int        `gorm:"column:rocket_bodies"`
+	Debris       int        `gorm:"column:debris"`
+	Decayed      int        `gorm:"column:decayed"`
+}
+
+func (s launchSummary) toDomain() domain.Launch {
+	return domain.Launch{
+		Designator:   domain.LaunchDesignator(s.LaunchYear, s.LaunchNumber),
+		Year:         s.LaunchYear,
+		Number:       s.LaunchNumber,
+		LaunchDate:   s.LaunchDate,
+		LaunchSite:   s.LaunchSite,
+		Objects:      s.Objects,
+		Payloads:     s.Payloads,
+		RocketBodies: s.RocketBodies,
+		Debris:       s.Debris,
+		Decayed:      s.Decayed,
+	}
+}
+
+// LaunchRepository groups the satellites of the catalog by launch.
+type LaunchRepository struct {
+	db *data.Database
+}
+
+// NewLaunchRepository creates a new LaunchRepository instance.
+func NewLaunchRepository(db *data.Database) domain.LaunchRepository {
+	return &LaunchRepository{db: db}
+}
+
+// cataloged restricts a query to the satellites with a parsed launch, excluding deleted ones.
+func (r *LaunchRepository) cataloged(ctx context.Context) *gorm.DB {
+	return r.db.DbHandler.WithContext(ctx).Model(&models.Satellite{}).
+		Where("deleted_at IS NULL AND launch_year > 0")
+}
+
+// FindLaunches retrieves the launches of a year, or of all years when year is zero, with pagination.
+func (r *LaunchRepository) FindLaunches(ctx context.Context, year int, page int, pageSize int) ([]domain.Launch, int64, error) {
+	offset := (page - 1) * pageSize
+	if offset < 0 {
+		offset = 0
+	}
+
+	query := r.cataloged(ctx)
+	if year > 0 {
+		query = query.Where("launch_year = ?", year)
+	}
+	query = query.Select(launchSummaryColumns).Group("launch_year, launch_number")
+
+	var total int64
+	if err := r.db.DbHandler.WithContext(ctx).Table("(?) AS launches", query).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count launches: %w", err)
+	}
+
+	var summaries []launchSummary
+	err := query.Session(&gorm.Session{}).
+		Order("launch_year DESC, launch_number DESC").
+		Limit(pageSize).Offset(offset).
+		Scan(&summaries).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to find launches: %w", err)
+	}
+
+	launches := make([]domain.Launch, 0, len(summaries))
+	for _, summary := range summaries {
+		launches = append(launches, summary.toDomain())
+	}
+	return launches, total, nil
+}
+
+// FindLaunch retrieves the summary of a launch, a zero launch when none of its objects is cataloged.
+func (r *LaunchRepository) FindLaunch(ctx context.Context, year int, number int) (domain.Launch, error) {
+	var summaries []launchSummary
+	err := r.cataloged(ctx).
+		Where("launch_year = ? AND launch_number = ?", year, number).
+		Select(launchSummaryColumns).
+		Group("launch_year, launch_number").
+		Scan(&summaries).Error
+	if err != nil {
+		return domain.Launch{}, fmt.Errorf("failed to find launch %s: %w", domain.LaunchDesignator(year, number), err)
+	}
+	if len(summaries) == 0 {
+		return domain.Launch{}, nil
+	}
+	return summaries[0].toDomain(), nil
+}
+
+// FindLaunchObjects retrieves the satellites of a launch ordered by piece, "A" to "Z" then "AA" onwards.
+func (r *LaunchRepository) FindLaunchObjects(ctx context.Context, year int, number int) ([]domain.Satellite, error) {
+	var satellites []models.Satellite
+	err := r.cataloged(ctx).
+		Where("launch_year = ? AND launch_number = ?", year, number).
+		Order("LENGTH(launch_piece) ASC, launch_piece ASC").
+		Find(&satellites).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find objects of launch %s: %w", domain.LaunchDesignator(year, number), err)
+	}
+
+	var domainSatellites []domain.Satellite
+	for _, satellite := range satellites {
+		domainSatellites = append(domainSatellites, models.MapToSatelliteDomain(satellite))
+	}
+	return domainSatellites, nil
+}
+
+// FindLaunchesWithNewPieces retrieves the launches with at least minPieces objects created since the given time.
+func (r *LaunchRepository) FindLaunchesWithNewPieces(ctx context.Context, since time.Time, minPieces int) ([]domain.CosparID, error) {
+	var rows []launchSummary
+	err := r.cataloged(ctx).
+		Select("launch_year, launch_number").
+		Where("created_at >= ?", since).
+		Group("launch_year, launch_number").
+		Having("COUNT(*) >= ?", minPieces).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find launches with new pieces: %w", err)
+	}
+
+	launches := make([]domain.CosparID, 0, len(rows))
+	for _, row := range rows {
+		launches = append(launches, domain.CosparID{Year: row.LaunchYear, LaunchNumber: row.LaunchNumber})
+	}
+	return launches, nil
+}
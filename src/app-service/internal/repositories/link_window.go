@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"gorm.io/gorm"
+)
+
+// LinkWindowRepository manages the inter-satellite link windows data access.
+type LinkWindowRepository struct {
+	db *data.Database
+}
+
+// NewLinkWindowRepository creates a new LinkWindowRepository instance.
+func NewLinkWindowRepository(db *data.Database) domain.LinkWindowRepository {
+	return &LinkWindowRepository{db: db}
+}
+
+// ReplaceForContext replaces the windows of a context starting within [from, until] in a single transaction.
+func (r *LinkWindowRepository) ReplaceForContext(ctx context.Context, contextID string, from time.Time, until time.Time, windows []domain.LinkWindow) error {
+	var modelsBatch []models.LinkWindow
+	for _, window := range windows {
+		modelsBatch = append(modelsBatch, models.MapToLinkWindowModel(window))
+	}
+
+	return r.db.DbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("context_id = ? AND window_start >= ? AND window_start <= ?", contextID, from, until).
+			Delete(&models.LinkWindow{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete link windows: %w", err)
+		}
+		if len(modelsBatch) == 0 {
+			return nil
+		}
+		if err := tx.CreateInBatches(modelsBatch, 100).Error; err != nil {
+			return fmt.Errorf("failed to save link windows: %w", err)
+		}
+		return nil
+	})
+}
+
+// FindByContext retrieves the windows of a context overlapping [from, until], earliest first.
+func (r *LinkWindowRepository) FindByContext(ctx context.Context, contextID string, from time.Time, until time.Time) ([]domain.LinkWindow, error) {
+	var windows []models.LinkWindow
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("context_id = ? AND window_end >= ? AND window_start <= ?", contextID, from, until).
+		Order("window_start ASC").
+		Find(&windows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find link windows: %w", err)
+	}
+
+	var domainWindows []domain.LinkWindow
+	for _, window := range windows {
+		domainWindows = append(domainWindows, models.MapToLinkWindowDomain(window))
+	}
+	return domainWindows, nil
+}
+
+// FindByNoradID retrieves the windows of a context involving a satellite and overlapping [from, until], earliest first.
+func (r *LinkWindowRepository) FindByNoradID(ctx context.Context, contextID string, noradID string, from time.Time, until time.Time) ([]domain.LinkWindow, error) {
+	var windows []models.LinkWindow
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("context_id = ? AND (norad_id_a = ? OR norad_id_b = ?) AND window_end >= ? AND window_start <= ?", contextID, noradID, noradID, from, until).
+		Order("window_start ASC").
+		Find(&windows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find link windows for NORAD ID %s: %w", noradID, err)
+	}
+
+	var domainWindows []domain.LinkWindow
+	for _, window := range windows {
+		domainWindows = append(domainWindows, models.MapToLinkWindowDomain(window))
+	}
+	return domainWindows, nil
+}
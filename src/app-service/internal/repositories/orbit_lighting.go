@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"gorm.io/gorm/clause"
+)
+
+// OrbitLightingRepository manages the orbit lighting time series data access.
+type OrbitLightingRepository struct {
+	db *data.Database
+}
+
+// NewOrbitLightingRepository creates a new OrbitLightingRepository instance.
+func NewOrbitLightingRepository(db *data.Database) domain.OrbitLightingRepository {
+	return &OrbitLightingRepository{db: db}
+}
+
+// SaveBatch upserts the samples by NORAD ID and sample time.
+func (r *OrbitLightingRepository) SaveBatch(ctx context.Context, samples []domain.OrbitLightingSample) error {
+	if len(samples) == 0 {
+		return nil
+	}
+
+	var modelsBatch []models.OrbitLightingSample
+	for _, sample := range samples {
+		modelsBatch = append(modelsBatch, models.MapToOrbitLightingSampleModel(sample))
+	}
+
+	return r.db.DbHandler.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "norad_id"}, {Name: "sampled_at"}},
+			DoUpdates: clause.AssignmentColumns([]string{"tle_epoch", "raan", "ltan", "ltdn", "beta_angle", "eclipse_fraction", "eclipse_minutes", "updated_at", "processed_at"}),
+		}).
+		CreateInBatches(modelsBatch, 100).Error
+}
+
+// FindByNoradID retrieves the samples of a satellite between from and until, oldest first.
+func (r *OrbitLightingRepository) FindByNoradID(ctx context.Context, noradID string, from time.Time, until time.Time) ([]domain.OrbitLightingSample, error) {
+	var samples []models.OrbitLightingSample
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("norad_id = ? AND sampled_at >= ? AND sampled_at <= ?", noradID, from, until).
+		Order("sampled_at ASC").
+		Find(&samples).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find orbit lighting samples: %w", err)
+	}
+
+	var domainSamples []domain.OrbitLightingSample
+	for _, sample := range samples {
+		domainSamples = append(domainSamples, models.MapToOrbitLightingSampleDomain(sample))
+	}
+	return domainSamples, nil
+}
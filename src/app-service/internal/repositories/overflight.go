@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"gorm.io/gorm"
+)
+
+// OverflightReportRepository manages the overflight reports data access.
+type OverflightReportRepository struct {
+	db *data.Database
+}
+
+// NewOverflightReportRepository creates a new OverflightReportRepository instance.
+func NewOverflightReportRepository(db *data.Database) domain.OverflightReportRepository {
+	return &OverflightReportRepository{db: db}
+}
+
+// Save stores a report and its records in a single transaction.
+func (r *OverflightReportRepository) Save(ctx context.Context, report domain.OverflightReport) error {
+	model, records := models.MapToOverflightReportModel(report)
+	return r.db.DbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&model).Error; err != nil {
+			return fmt.Errorf("failed to save overflight report: %w", err)
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		if err := tx.CreateInBatches(records, 500).Error; err != nil {
+			return fmt.Errorf("failed to save overflight records: %w", err)
+		}
+		return nil
+	})
+}
+
+// FindByID retrieves a report and its records, ordered by satellite and entry time, within a context and tenant.
+func (r *OverflightReportRepository) FindByID(ctx context.Context, contextID string, tenantID domain.TenantID, id string) (domain.OverflightReport, error) {
+	var report models.OverflightReport
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("id = ? AND context_id = ? AND tenant_id = ?", id, contextID, string(tenantID)).
+		First(&report).Error
+	if err != nil {
+		return domain.OverflightReport{}, fmt.Errorf("failed to find overflight report %s: %w", id, err)
+	}
+
+	var records []models.OverflightRecord
+	err = r.db.DbHandler.WithContext(ctx).
+		Where("report_id = ?", report.ID).
+		Order("norad_id ASC, entry_at ASC").
+		Find(&records).Error
+	if err != nil {
+		return domain.OverflightReport{}, fmt.Errorf("failed to find overflight records: %w", err)
+	}
+	return models.MapToOverflightReportDomain(report, records), nil
+}
+
+// FindAllByContext retrieves the reports of a context and tenant without their records, newest first.
+func (r *OverflightReportRepository) FindAllByContext(ctx context.Context, contextID string, tenantID domain.TenantID) ([]domain.OverflightReport, error) {
+	var reports []models.OverflightReport
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("context_id = ? AND tenant_id = ?", contextID, string(tenantID)).
+		Order("created_at DESC").
+		Find(&reports).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find overflight reports: %w", err)
+	}
+
+	var domainReports []domain.OverflightReport
+	for _, report := range reports {
+		domainReports = append(domainReports, models.MapToOverflightReportDomain(report, nil))
+	}
+	return domainReports, nil
+}
+
+// DeleteByID removes a report and its records within a context and tenant.
+func (r *OverflightReportRepository) DeleteByID(ctx context.Context, contextID string, tenantID domain.TenantID, id string) error {
+	return r.db.DbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND context_id = ? AND tenant_id = ?", id, contextID, string(tenantID)).
+			Delete(&models.OverflightReport{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete overflight report: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("overflight report %s not found", id)
+		}
+		if err := tx.Where("report_id = ?", id).Delete(&models.OverflightRecord{}).Error; err != nil {
+			return fmt.Errorf("failed to delete overflight records: %w", err)
+		}
+		return nil
+	})
+}
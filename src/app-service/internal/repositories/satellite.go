@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/Elbujito/2112/src/app-service/internal/data"
@@ -29,6 +30,7 @@ type SatelliteTLEAggregate struct {
 	Perigee        *float64   `gorm:"column:perigee"`
 	RCS            *float64   `gorm:"column:rcs"`
 	Altitude       *float64   `gorm:"column:altitude"`
+	OrbitRegime    string     `gorm:"column:orbit_regime"`
 	IsActive       bool       `gorm:"column:is_active"`
 	CreatedAt      time.Time  `gorm:"column:created_at"`
 	UpdatedAt      *time.Time `gorm:"column:updated_at"`
@@ -61,6 +63,26 @@ func (r *SatelliteRepository) FindByNoradID(ctx context.Context, noradID string)
 	return models.MapToSatelliteDomain(satellite), result.Error
 }
 
+// FindByNoradIDs retrieves the satellites of the given NORAD IDs, excluding deleted ones.
+func (r *SatelliteRepository) FindByNoradIDs(ctx context.Context, noradIDs []string) ([]domain.Satellite, error) {
+	if len(noradIDs) == 0 {
+		return nil, nil
+	}
+	var satellites []models.Satellite
+	result := r.db.DbHandler.WithContext(ctx).
+		Where("norad_id IN ? AND deleted_at IS NULL", noradIDs).
+		Find(&satellites)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	var domainSatellites []domain.Satellite
+	for _, satellite := range satellites {
+		domainSatellites = append(domainSatellites, models.MapToSatelliteDomain(satellite))
+	}
+	return domainSatellites, nil
+}
+
 // FindAll retrieves all satellites excluding deleted ones.
 func (r *SatelliteRepository) FindAll(ctx context.Context) ([]domain.Satellite, error) {
 	var satellites []models.Satellite
@@ -114,6 +136,231 @@ func (r *SatelliteRepository) SaveBatch(ctx context.Context, satellites []domain
 		CreateInBatches(modelsBatch, 100).Error
 }
 
+// SaveCatalogBatch upserts catalog entries by NORAD ID. Existing satellites only get their catalog fields
+// updated: the orbital parameters, regime, type and flags derived from TLEs or set by users are kept, except
+// that a satellite the catalog reports decayed is deactivated.
+func (r *SatelliteRepository) SaveCatalogBatch(ctx context.Context, satellites []domain.Satellite) error {
+	if len(satellites) == 0 {
+		return nil
+	}
+
+	var modelsBatch []models.Satellite
+	var decayed []string
+	for _, satellite := range satellites {
+		modelsBatch = append(modelsBatch, models.MapToSatelliteModel(satellite))
+		if satellite.DecayDate != nil {
+			decayed = append(decayed, satellite.NoradID)
+		}
+	}
+
+	return r.db.DbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "norad_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"name", "display_name", "launch_date", "decay_date", "intl_designator", "owner", "object_type", "rcs",
+				"ops_status", "launch_site", "orbit_center", "orbit_type", "launch_year", "launch_number", "launch_piece",
+				"updated_at",
+			}),
+		}).CreateInBatches(modelsBatch, 100).Error
+		if err != nil || len(decayed) == 0 {
+			return err
+		}
+		return tx.Model(&models.Satellite{}).
+			Where("norad_id IN ?", decayed).
+			Update("is_active", false).Error
+	})
+}
+
+// UpdateOrbitalParameters stores the orbit parameters and regime derived from the latest TLE.
+func (r *SatelliteRepository) UpdateOrbitalParameters(ctx context.Context, noradID string, params domain.OrbitalParameters) error {
+	return r.db.DbHandler.WithContext(ctx).Model(&models.Satellite{}).
+		Where("norad_id = ? AND deleted_at IS NULL", noradID).
+		Updates(map[string]interface{}{
+			"period":       params.Period,
+			"inclination":  params.Inclination,
+			"apogee":       params.Apogee,
+			"perigee":      params.Perigee,
+			"orbit_regime": string(params.Regime),
+		}).Error
+}
+
+// FindByOrbitRegime retrieves all satellites in a given orbit regime, excluding deleted ones.
+func (r *SatelliteRepository) FindByOrbitRegime(ctx context.Context, regime domain.OrbitRegime) ([]domain.Satellite, error) {
+	var satellites []models.Satellite
+	result := r.db.DbHandler.WithContext(ctx).
+		Where("orbit_regime = ? AND deleted_at IS NULL", string(regime)).
+		Find(&satellites)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	var domainSatellites []domain.Satellite
+	for _, satellite := range satellites {
+		domainSatellites = append(domainSatellites, models.MapToSatelliteDomain(satellite))
+	}
+	return domainSatellites, nil
+}
+
+// FindWithPerigeeBelow retrieves the active satellites with a perigee altitude below the given kilometers.
+func (r *SatelliteRepository) FindWithPerigeeBelow(ctx context.Context, perigee float64) ([]domain.Satellite, error) {
+	var satellites []models.Satellite
+	result := r.db.DbHandler.WithContext(ctx).
+		Where("perigee IS NOT NULL AND perigee < ? AND decay_date IS NULL AND deleted_at IS NULL", perigee).
+		Find(&satellites)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	var domainSatellites []domain.Satellite
+	for _, satellite := range satellites {
+		domainSatellites = append(domainSatellites, models.MapToSatelliteDomain(satellite))
+	}
+	return domainSatellites, nil
+}
+
+// FindSynthetic retrieves the user-defined satellites, excluding deleted ones.
+func (r *SatelliteRepository) FindSynthetic(ctx context.Context) ([]domain.Satellite, error) {
+	var satellites []models.Satellite
+	result := r.db.DbHandler.WithContext(ctx).
+		Where("synthetic = ? AND deleted_at IS NULL", true).
+		Order("norad_id ASC").
+		Find(&satellites)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	var domainSatellites []domain.Satellite
+	for _, satellite := range satellites {
+		domainSatellites = append(domainSatellites, models.MapToSatelliteDomain(satellite))
+	}
+	return domainSatellites, nil
+}
+
+// FindHighestSyntheticNoradID returns the highest catalog number of the synthetic range, deleted satellites
+// included since they keep their NORAD ID, or zero when the range is empty.
+func (r *SatelliteRepository) FindHighestSyntheticNoradID(ctx context.Context) (int, error) {
+	var highest int
+	err := r.db.DbHandler.WithContext(ctx).
+		Raw(`SELECT COALESCE(MAX(id), 0) FROM (
+			SELECT CASE WHEN norad_id ~ '^[0-9]{1,9}$' THEN norad_id::int END AS id FROM satellites
+		) ids WHERE id BETWEEN ? AND ?`, domain.SyntheticNoradIDMin, domain.SyntheticNoradIDMax).
+		Scan(&highest).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to find the highest synthetic NORAD ID: %w", err)
+	}
+	return highest, nil
+}
+
+// CreateSynthetic inserts synthetic satellites with their current element sets in one transaction and
+// assigns them to a context when contextID is set.
+func (r *SatelliteRepository) CreateSynthetic(ctx context.Context, satellites []domain.Satellite, tles []domain.TLE, contextID string) error {
+	return r.db.DbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return createSyntheticSatellites(tx, satellites, tles, contextID)
+	})
+}
+
+// createSyntheticSatellites inserts synthetic satellites, their element sets and their context assignments
+// within a transaction. A NORAD ID already cataloged is never overwritten: its insert is skipped and
+// ErrNoradIDTaken returned, so that the transaction is rolled back and the allocation retried.
+func createSyntheticSatellites(tx *gorm.DB, satellites []domain.Satellite, tles []domain.TLE, contextID string) error {
+	if len(satellites) == 0 {
+		return nil
+	}
+
+	satelliteModels := make([]models.Satellite, len(satellites))
+	for i, satellite := range satellites {
+		satelliteModels[i] = models.MapToSatelliteModel(satellite)
+	}
+	result := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "norad_id"}},
+		DoNothing: true,
+	}).CreateInBatches(satelliteModels, 100)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save synthetic satellites: %w", result.Error)
+	}
+	if result.RowsAffected != int64(len(satellites)) {
+		return domain.ErrNoradIDTaken
+	}
+
+	if len(tles) > 0 {
+		tleModels := make([]models.TLE, len(tles))
+		for i, tle := range tles {
+			tleModels[i] = mapToModelTLE(tle)
+		}
+		if err := tx.CreateInBatches(tleModels, 100).Error; err != nil {
+			return fmt.Errorf("failed to save synthetic TLEs: %w", err)
+		}
+	}
+
+	if contextID == "" {
+		return nil
+	}
+	associations := make([]models.ContextSatellite, len(satellites))
+	for i, satellite := range satellites {
+		associations[i] = models.ContextSatellite{ContextID: contextID, SatelliteID: satellite.ID}
+	}
+	if err := tx.CreateInBatches(associations, 100).Error; err != nil {
+		return fmt.Errorf("failed to assign synthetic satellites to context: %w", err)
+	}
+	return nil
+}
+
+// FindByTags retrieves the satellites having any of the tags, excluding deleted ones.
+func (r *SatelliteRepository) FindByTags(ctx context.Context, tags []string) ([]domain.Satellite, error) {
+	var satellites []models.Satellite
+	result := r.db.DbHandler.WithContext(ctx).
+		Where("deleted_at IS NULL").
+		Where(taggedSatellitesCondition("id"), normalizeTagNames(tags)).
+		Order("norad_id ASC").
+		Find(&satellites)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	var domainSatellites []domain.Satellite
+	for _, satellite := range satellites {
+		domainSatellites = append(domainSatellites, models.MapToSatelliteDomain(satellite))
+	}
+	return domainSatellites, nil
+}
+
+// taggedSatellitesCondition restricts the satellite ID column to the satellites having any of the tags
+// bound to its placeholder.
+func taggedSatellitesCondition(idColumn string) string {
+	return idColumn + ` IN (
+		SELECT satellite_tag_assignments.satellite_id FROM satellite_tag_assignments
+		JOIN satellite_tags ON satellite_tags.id = satellite_tag_assignments.tag_id
+		WHERE satellite_tags.name IN ?)`
+}
+
+func normalizeTagNames(tags []string) []string {
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = domain.NormalizeTagName(tag)
+	}
+	return names
+}
+
+// MarkDecayed sets the decay date of a satellite and deactivates it.
+func (r *SatelliteRepository) MarkDecayed(ctx context.Context, noradID string, decayDate time.Time) error {
+	return r.db.DbHandler.WithContext(ctx).Model(&models.Satellite{}).
+		Where("norad_id = ? AND decay_date IS NULL", noradID).
+		Updates(map[string]interface{}{
+			"decay_date": decayDate,
+			"is_active":  false,
+		}).Error
+}
+
+// UpdateName renames a satellite.
+func (r *SatelliteRepository) UpdateName(ctx context.Context, noradID string, name string) error {
+	return r.db.DbHandler.WithContext(ctx).Model(&models.Satellite{}).
+		Where("norad_id = ? AND deleted_at IS NULL", noradID).
+		Updates(map[string]interface{}{
+			"name":         name,
+			"display_name": name,
+		}).Error
+}
+
 // FindSatelliteInfoWithPagination retrieves satellites and their TLEs with pagination.
 func (r *SatelliteRepository) FindSatelliteInfoWithPagination(ctx context.Context, page, pageSize int, searchRequest *domain.SearchRequest) ([]domain.SatelliteInfo, int64, error) {
 	var results []SatelliteTLEAggregate
@@ -131,7 +378,7 @@ func (r *SatelliteRepository) FindSatelliteInfoWithPagination(ctx context.Contex
 			satellites.id, satellites.name, satellites.norad_id, satellites.owner,
 			satellites.launch_date, satellites.decay_date, satellites.international_designator,
 			satellites.object_type, satellites.period, satellites.inclination, satellites.apogee,
-			satellites.perigee, satellites.rcs, satellites.altitude, satellites.is_active,
+			satellites.perigee, satellites.rcs, satellites.altitude, satellites.orbit_regime, satellites.is_active,
 			satellites.created_at, satellites.updated_at, satellites.processed_at, satellites.is_favourite,
 			latest_tles.line1, latest_tles.line2, latest_tles.updated_at AS tle_updated_at
 		`).
@@ -152,6 +399,16 @@ func (r *SatelliteRepository) FindSatelliteInfoWithPagination(ctx context.Contex
 		query = query.Where("LOWER(satellites.name) LIKE LOWER(?) OR LOWER(satellites.norad_id) LIKE LOWER(?)", wildcard, wildcard)
 	}
 
+	// Apply orbit regime filter if provided
+	if searchRequest != nil && searchRequest.OrbitRegime != "" {
+		query = query.Where("satellites.orbit_regime = ?", string(searchRequest.OrbitRegime))
+	}
+
+	// Apply tag filter if provided
+	if searchRequest != nil && len(searchRequest.Tags) > 0 {
+		query = query.Where(taggedSatellitesCondition("satellites.id"), normalizeTagNames(searchRequest.Tags))
+	}
+
 	// Count total records
 	if err := query.Count(&totalRecords).Error; err != nil {
 		return nil, 0, err
@@ -180,6 +437,7 @@ func (r *SatelliteRepository) FindSatelliteInfoWithPagination(ctx context.Contex
 			Perigee:        result.Perigee,
 			RCS:            result.RCS,
 			Altitude:       result.Altitude,
+			OrbitRegime:    domain.OrbitRegime(result.OrbitRegime),
 			ModelBase: domain.ModelBase{
 				ID:          result.ID,
 				IsActive:    result.IsActive,
@@ -279,6 +537,16 @@ func (r *SatelliteRepository) FindAllWithPagination(ctx context.Context, page in
 		)
 	}
 
+	// Apply orbit regime filter if provided
+	if searchRequest != nil && searchRequest.OrbitRegime != "" {
+		query = query.Where("orbit_regime = ?", string(searchRequest.OrbitRegime))
+	}
+
+	// Apply tag filter if provided
+	if searchRequest != nil && len(searchRequest.Tags) > 0 {
+		query = query.Where(taggedSatellitesCondition("id"), normalizeTagNames(searchRequest.Tags))
+	}
+
 	// Count total records
 	if err := query.Count(&totalRecords).Error; err != nil {
 		return nil, 0, err
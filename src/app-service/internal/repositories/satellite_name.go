@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+// SatelliteNameChangeRepository manages the name history of satellites.
+type SatelliteNameChangeRepository struct {
+	db *data.Database
+}
+
+// NewSatelliteNameChangeRepository creates a new SatelliteNameChangeRepository instance.
+func NewSatelliteNameChangeRepository(db *data.Database) domain.SatelliteNameChangeRepository {
+	return &SatelliteNameChangeRepository{db: db}
+}
+
+// Save records a name change.
+func (r *SatelliteNameChangeRepository) Save(ctx context.Context, change domain.SatelliteNameChange) error {
+	model := models.MapToSatelliteNameChangeModel(change)
+	return r.db.DbHandler.WithContext(ctx).Create(&model).Error
+}
+
+// FindByNoradID retrieves the name changes of a satellite, oldest first.
+func (r *SatelliteNameChangeRepository) FindByNoradID(ctx context.Context, noradID string) ([]domain.SatelliteNameChange, error) {
+	var changes []models.SatelliteNameChange
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("norad_id = ?", noradID).
+		Order("changed_at ASC").
+		Find(&changes).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find name changes of NORAD ID %s: %w", noradID, err)
+	}
+
+	var domainChanges []domain.SatelliteNameChange
+	for _, change := range changes {
+		domainChanges = append(domainChanges, models.MapToSatelliteNameChangeDomain(change))
+	}
+	return domainChanges, nil
+}
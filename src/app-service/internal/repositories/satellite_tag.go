@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"gorm.io/gorm/clause"
+)
+
+// SatelliteTagRepository manages satellite tags and their assignments.
+type SatelliteTagRepository struct {
+	db *data.Database
+}
+
+// NewSatelliteTagRepository creates a new SatelliteTagRepository instance.
+func NewSatelliteTagRepository(db *data.Database) domain.SatelliteTagRepository {
+	return &SatelliteTagRepository{db: db}
+}
+
+// FindAll retrieves all tags ordered by name.
+func (r *SatelliteTagRepository) FindAll(ctx context.Context) ([]domain.SatelliteTag, error) {
+	var tags []models.SatelliteTag
+	if err := r.db.DbHandler.WithContext(ctx).Order("name ASC").Find(&tags).Error; err != nil {
+		return nil, fmt.Errorf("failed to find satellite tags: %w", err)
+	}
+
+	var domainTags []domain.SatelliteTag
+	for _, tag := range tags {
+		domainTags = append(domainTags, models.MapToSatelliteTagDomain(tag))
+	}
+	return domainTags, nil
+}
+
+// FindByName retrieves a tag by name.
+func (r *SatelliteTagRepository) FindByName(ctx context.Context, name string) (domain.SatelliteTag, error) {
+	var tag models.SatelliteTag
+	if err := r.db.DbHandler.WithContext(ctx).Where("name = ?", domain.NormalizeTagName(name)).First(&tag).Error; err != nil {
+		return domain.SatelliteTag{}, fmt.Errorf("failed to find satellite tag %s: %w", name, err)
+	}
+	return models.MapToSatelliteTagDomain(tag), nil
+}
+
+// Save creates a tag, or updates the description of the tag of the same name. It returns the stored tag.
+func (r *SatelliteTagRepository) Save(ctx context.Context, tag domain.SatelliteTag) (domain.SatelliteTag, error) {
+	model := models.MapToSatelliteTagModel(tag)
+	updates := []string{"updated_at"}
+	if tag.Description != "" {
+		updates = append(updates, "description")
+	}
+	if err := r.db.DbHandler.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns(updates),
+	}).Create(&model).Error; err != nil {
+		return domain.SatelliteTag{}, fmt.Errorf("failed to save satellite tag %s: %w", tag.Name, err)
+	}
+	return r.FindByName(ctx, tag.Name)
+}
+
+// DeleteByName removes a tag, its assignments are removed by cascade.
+func (r *SatelliteTagRepository) DeleteByName(ctx context.Context, name string) error {
+	return r.db.DbHandler.WithContext(ctx).Where("name = ?", domain.NormalizeTagName(name)).Delete(&models.SatelliteTag{}).Error
+}
+
+// TagSatellites tags the satellites of the NORAD IDs, ignoring unknown NORAD IDs and existing assignments.
+func (r *SatelliteTagRepository) TagSatellites(ctx context.Context, tagID string, noradIDs []string, origin domain.SatelliteTagOrigin) error {
+	if len(noradIDs) == 0 {
+		return nil
+	}
+	return r.db.DbHandler.WithContext(ctx).Exec(`
+		INSERT INTO satellite_tag_assignments (satellite_id, tag_id, origin)
+		SELECT id, ?, ? FROM satellites WHERE norad_id IN ? AND deleted_at IS NULL
+		ON CONFLICT (satellite_id, tag_id) DO NOTHING`,
+		tagID, string(origin), noradIDs).Error
+}
+
+// UntagSatellites removes a tag from the satellites of the NORAD IDs.
+func (r *SatelliteTagRepository) UntagSatellites(ctx context.Context, tagID string, noradIDs []string) error {
+	if len(noradIDs) == 0 {
+		return nil
+	}
+	return r.db.DbHandler.WithContext(ctx).
+		Where("tag_id = ? AND satellite_id IN (SELECT id FROM satellites WHERE norad_id IN ?)", tagID, noradIDs).
+		Delete(&models.SatelliteTagAssignment{}).Error
+}
+
+// UntagSourceSatellitesExcept removes a tag set from a source group from the satellites not in noradIDs,
+// keeping the assignments made by users.
+func (r *SatelliteTagRepository) UntagSourceSatellitesExcept(ctx context.Context, tagID string, noradIDs []string) error {
+	query := r.db.DbHandler.WithContext(ctx).Where("tag_id = ? AND origin = ?", tagID, string(domain.TagOriginSource))
+	if len(noradIDs) > 0 {
+		query = query.Where("satellite_id NOT IN (SELECT id FROM satellites WHERE norad_id IN ?)", noradIDs)
+	}
+	return query.Delete(&models.SatelliteTagAssignment{}).Error
+}
+
+// FindByNoradID retrieves the tags of a satellite ordered by name.
+func (r *SatelliteTagRepository) FindByNoradID(ctx context.Context, noradID string) ([]domain.SatelliteTag, error) {
+	var tags []models.SatelliteTag
+	err := r.db.DbHandler.WithContext(ctx).Table("satellite_tags").
+		Joins("JOIN satellite_tag_assignments ON satellite_tags.id = satellite_tag_assignments.tag_id").
+		Joins("JOIN satellites ON satellites.id = satellite_tag_assignments.satellite_id").
+		Where("satellites.norad_id = ?", noradID).
+		Order("satellite_tags.name ASC").
+		Find(&tags).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tags of NORAD ID %s: %w", noradID, err)
+	}
+
+	var domainTags []domain.SatelliteTag
+	for _, tag := range tags {
+		domainTags = append(domainTags, models.MapToSatelliteTagDomain(tag))
+	}
+	return domainTags, nil
+}
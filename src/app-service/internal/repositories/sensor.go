@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SatelliteSensorRepository manages the satellite sensors data access.
+type SatelliteSensorRepository struct {
+	db *data.Database
+}
+
+// NewSatelliteSensorRepository creates a new SatelliteSensorRepository instance.
+func NewSatelliteSensorRepository(db *data.Database) domain.SatelliteSensorRepository {
+	return &SatelliteSensorRepository{db: db}
+}
+
+// Save upserts the sensor of a satellite by NORAD ID.
+func (r *SatelliteSensorRepository) Save(ctx context.Context, sensor domain.SatelliteSensor) error {
+	model := models.MapToSatelliteSensorModel(sensor)
+	return r.db.DbHandler.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "norad_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"type", "swath_width_km", "half_angle", "max_off_nadir", "updated_at", "processed_at"}),
+		}).
+		Create(&model).Error
+}
+
+// FindByNoradID retrieves the sensor of a satellite, or nil if the satellite has none.
+func (r *SatelliteSensorRepository) FindByNoradID(ctx context.Context, noradID string) (*domain.SatelliteSensor, error) {
+	var sensor models.SatelliteSensor
+	result := r.db.DbHandler.WithContext(ctx).Where("norad_id = ?", noradID).First(&sensor)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	} else if result.Error != nil {
+		return nil, result.Error
+	}
+
+	sensorMapped := models.MapToSatelliteSensorDomain(sensor)
+	return &sensorMapped, nil
+}
+
+// DeleteByNoradID detaches the sensor from a satellite.
+func (r *SatelliteSensorRepository) DeleteByNoradID(ctx context.Context, noradID string) error {
+	return r.db.DbHandler.WithContext(ctx).
+		Where("norad_id = ?", noradID).
+		Delete(&models.SatelliteSensor{}).Error
+}
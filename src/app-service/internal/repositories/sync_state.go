@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SyncStateRepository manages the cursors of incremental synchronizations.
+type SyncStateRepository struct {
+	db *data.Database
+}
+
+// NewSyncStateRepository creates a new SyncStateRepository instance.
+func NewSyncStateRepository(db *data.Database) domain.SyncStateRepository {
+	return &SyncStateRepository{db: db}
+}
+
+// Find retrieves the state of a stream. A stream never synchronized yields a zero state.
+func (r *SyncStateRepository) Find(ctx context.Context, source string, scope string) (domain.SyncState, error) {
+	var state models.SyncState
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("source = ? AND scope = ?", source, scope).
+		First(&state).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.SyncState{}, nil
+	}
+	if err != nil {
+		return domain.SyncState{}, fmt.Errorf("failed to find sync state %s:%s: %w", source, scope, err)
+	}
+	return models.MapToSyncStateDomain(state), nil
+}
+
+// Save creates or replaces the state of a stream.
+func (r *SyncStateRepository) Save(ctx context.Context, state domain.SyncState) error {
+	model := models.MapToSyncStateModel(state)
+	return r.db.DbHandler.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "source"}, {Name: "scope"}},
+			DoUpdates: clause.AssignmentColumns([]string{"cursor", "synced_at", "e_tag", "last_modified", "payload_hash", "updated_at"}),
+		}).
+		Create(&model).Error
+}
@@ -229,22 +229,49 @@ func (r *TileRepository) FindTilesVisibleFromLine(ctx context.Context, sat domai
 	}
 	lineString := fmt.Sprintf("LINESTRING(%s)", strings.Join(wktPoints, ", "))
 
+	return r.findMappingsIntersectingGeometry(ctx, sat, lineString)
+}
+
+// FindTilesVisibleFromSwath retrieves Tiles intersecting the ground swath polygons of a satellite sensor.
+func (r *TileRepository) FindTilesVisibleFromSwath(ctx context.Context, sat domain.Satellite, polygons [][]domain.Point) ([]domain.TileSatelliteMapping, error) {
+	if len(polygons) == 0 {
+		return nil, fmt.Errorf("at least one polygon is required")
+	}
+
+	wktPolygons := make([]string, len(polygons))
+	for i, polygon := range polygons {
+		if len(polygon) < 4 {
+			return nil, fmt.Errorf("a polygon requires at least four vertices")
+		}
+		wktPoints := make([]string, len(polygon))
+		for j, point := range polygon {
+			wktPoints[j] = fmt.Sprintf("%f %f", point.Longitude, point.Latitude)
+		}
+		wktPolygons[i] = fmt.Sprintf("((%s))", strings.Join(wktPoints, ", "))
+	}
+	multiPolygon := fmt.Sprintf("MULTIPOLYGON(%s)", strings.Join(wktPolygons, ", "))
+
+	return r.findMappingsIntersectingGeometry(ctx, sat, multiPolygon)
+}
+
+// findMappingsIntersectingGeometry maps a satellite to every Tile intersecting a WKT geometry.
+func (r *TileRepository) findMappingsIntersectingGeometry(ctx context.Context, sat domain.Satellite, wkt string) ([]domain.TileSatelliteMapping, error) {
 	query := `
-        WITH line_geom AS (
+        WITH search_geom AS (
             SELECT ST_GeomFromText(?, 4326) AS geom
         )
         SELECT 
             tiles.*,
-            ST_AsText(ST_PointOnSurface(ST_Intersection(line_geom.geom, spatial_index))) AS intersection_geom
-        FROM tiles, line_geom
-        WHERE ST_Intersects(spatial_index, line_geom.geom)
+            ST_AsText(ST_PointOnSurface(ST_Intersection(search_geom.geom, spatial_index))) AS intersection_geom
+        FROM tiles, search_geom
+        WHERE ST_Intersects(spatial_index, search_geom.geom)
     `
 
 	var results []struct {
 		models.Tile
 		IntersectionGeom string `gorm:"column:intersection_geom"`
 	}
-	result := r.db.DbHandler.Raw(query, lineString).Scan(&results)
+	result := r.db.DbHandler.WithContext(ctx).Raw(query, wkt).Scan(&results)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"gorm.io/gorm"
+)
+
+// TileCoverageRepository manages the materialized tile coverage statistics.
+type TileCoverageRepository struct {
+	db *data.Database
+}
+
+// NewTileCoverageRepository creates a new TileCoverageRepository instance.
+func NewTileCoverageRepository(db *data.Database) domain.TileCoverageRepository {
+	return &TileCoverageRepository{db: db}
+}
+
+// ReplaceForContext replaces the coverage statistics of a context in a single transaction.
+func (r *TileCoverageRepository) ReplaceForContext(ctx context.Context, contextID string, stats []domain.TileCoverageStats) error {
+	return r.db.DbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("context_id = ?", contextID).Delete(&models.TileCoverageStat{}).Error; err != nil {
+			return fmt.Errorf("failed to delete tile coverage for context %s: %w", contextID, err)
+		}
+		if len(stats) == 0 {
+			return nil
+		}
+
+		var modelsBatch []models.TileCoverageStat
+		for _, stat := range stats {
+			modelsBatch = append(modelsBatch, models.MapToTileCoverageStatModel(stat))
+		}
+		if err := tx.CreateInBatches(modelsBatch, 500).Error; err != nil {
+			return fmt.Errorf("failed to save tile coverage for context %s: %w", contextID, err)
+		}
+		return nil
+	})
+}
+
+// FindByContext retrieves the coverage statistics of a context ordered by quadkey.
+func (r *TileCoverageRepository) FindByContext(ctx context.Context, contextID string) ([]domain.TileCoverageStats, error) {
+	var stats []models.TileCoverageStat
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("context_id = ?", contextID).
+		Order("quadkey ASC").
+		Find(&stats).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tile coverage for context %s: %w", contextID, err)
+	}
+
+	var domainStats []domain.TileCoverageStats
+	for _, stat := range stats {
+		domainStats = append(domainStats, models.MapToTileCoverageStatDomain(stat))
+	}
+	return domainStats, nil
+}
@@ -13,7 +13,7 @@ import (
 	"github.com/Elbujito/2112/src/app-service/internal/data"
 	"github.com/Elbujito/2112/src/app-service/internal/data/models"
 	"github.com/Elbujito/2112/src/app-service/internal/domain"
-	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xtime"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
@@ -31,22 +31,41 @@ func NewTLERepository(db *data.Database, redisClient *redis.RedisClient, cacheTT
 
 // mapToDomainTLE converts a models.TLE to a domain.TLE.
 func mapToDomainTLE(model models.TLE) domain.TLE {
+	var fetchedAt time.Time
+	if model.FetchedAt != nil {
+		fetchedAt = *model.FetchedAt
+	}
 	return domain.TLE{
-		ID:      model.ID,
-		NoradID: model.NoradID,
-		Line1:   model.Line1,
-		Line2:   model.Line2,
-		Epoch:   model.Epoch,
+		ID:          model.ID,
+		NoradID:     model.NoradID,
+		Name:        model.Name,
+		Line1:       model.Line1,
+		Line2:       model.Line2,
+		Epoch:       model.Epoch,
+		Source:      model.Source,
+		FetchedAt:   fetchedAt,
+		PayloadHash: model.PayloadHash,
+		Current:     model.Current,
 	}
 }
 
 // mapToModelTLE converts a domain.TLE to a models.TLE.
 func mapToModelTLE(domainTLE domain.TLE) models.TLE {
+	var fetchedAt *time.Time
+	if !domainTLE.FetchedAt.IsZero() {
+		fetchedAt = &domainTLE.FetchedAt
+	}
 	return models.TLE{
-		NoradID: domainTLE.NoradID,
-		Line1:   domainTLE.Line1,
-		Line2:   domainTLE.Line2,
-		Epoch:   domainTLE.Epoch,
+		ModelBase:   models.ModelBase{ID: domainTLE.ID},
+		NoradID:     domainTLE.NoradID,
+		Name:        domainTLE.Name,
+		Line1:       domainTLE.Line1,
+		Line2:       domainTLE.Line2,
+		Epoch:       domainTLE.Epoch,
+		Source:      domainTLE.Source,
+		FetchedAt:   fetchedAt,
+		PayloadHash: domainTLE.PayloadHash,
+		Current:     domainTLE.Current,
 	}
 }
 
@@ -57,20 +76,14 @@ func (r *TleRepository) GetTle(ctx context.Context, noradID string) (domain.TLE,
 	// Check Redis cache
 	data, err := r.redisClient.HGetAll(ctx, key)
 	if err == nil && len(data) > 0 {
-		epoch, parseErr := xtime.ParseEpoch(data["epoch"])
-		if parseErr == nil {
-			return domain.TLE{
-				ID:    noradID,
-				Line1: data["line_1"],
-				Line2: data["line_2"],
-				Epoch: epoch,
-			}, nil
+		if tle, parseErr := tleFromCache(data); parseErr == nil {
+			return tle, nil
 		}
 	}
 
-	// Fallback to database
+	// Fallback to database, preferring the element set selected for propagation
 	var modelTLE models.TLE
-	result := r.db.DbHandler.First(&modelTLE, "norad_id = ?", noradID)
+	result := r.db.DbHandler.Order("current DESC, epoch DESC").First(&modelTLE, "norad_id = ?", noradID)
 	if result.Error != nil {
 		return domain.TLE{}, result.Error
 	}
@@ -83,6 +96,23 @@ func (r *TleRepository) GetTle(ctx context.Context, noradID string) (domain.TLE,
 	return tle, nil
 }
 
+// GetTleHistory retrieves the TLEs of a satellite with an epoch after since, oldest first.
+func (r *TleRepository) GetTleHistory(ctx context.Context, noradID string, since time.Time) ([]domain.TLE, error) {
+	var modelTLEs []models.TLE
+	if err := r.db.DbHandler.WithContext(ctx).
+		Where("norad_id = ? AND epoch >= ?", noradID, since).
+		Order("epoch ASC").
+		Find(&modelTLEs).Error; err != nil {
+		return nil, fmt.Errorf("failed to retrieve TLE history for NORAD ID %s: %w", noradID, err)
+	}
+
+	tles := make([]domain.TLE, len(modelTLEs))
+	for i, modelTLE := range modelTLEs {
+		tles[i] = mapToDomainTLE(modelTLE)
+	}
+	return tles, nil
+}
+
 // SaveTle saves a TLE to the database and updates the cache.
 func (r *TleRepository) SaveTle(ctx context.Context, tle domain.TLE) error {
 	modelTLE := mapToModelTLE(tle)
@@ -96,6 +126,8 @@ func (r *TleRepository) SaveTle(ctx context.Context, tle domain.TLE) error {
 	return r.publishTleToBroker(ctx, tle)
 }
 
+// UpdateTleBatch stores element sets as candidates. They are cached and published once selected
+// as current with SetCurrentTle.
 func (r *TleRepository) UpdateTleBatch(ctx context.Context, tles []domain.TLE) error {
 	if len(tles) == 0 {
 		return fmt.Errorf("no TLEs to update")
@@ -123,32 +155,81 @@ func (r *TleRepository) UpdateTleBatch(ctx context.Context, tles []domain.TLE) e
 			log.Printf("Failed to batch upsert TLEs: %v\n", err)
 			return err
 		}
+	}
+
+	return nil
+}
+
+// GetTleByID retrieves a stored element set by ID.
+func (r *TleRepository) GetTleByID(ctx context.Context, id string) (domain.TLE, error) {
+	var modelTLE models.TLE
+	if err := r.db.DbHandler.WithContext(ctx).First(&modelTLE, "id = ?", id).Error; err != nil {
+		return domain.TLE{}, fmt.Errorf("failed to find element set %s: %w", id, err)
+	}
+	return mapToDomainTLE(modelTLE), nil
+}
+
+// GetLatestTlesPerSource retrieves the candidate element sets of several satellites by NORAD ID, the newest epoch
+// of each source. Element sets of the same epoch are told apart by the most recent fetch, then by ID.
+func (r *TleRepository) GetLatestTlesPerSource(ctx context.Context, noradIDs []string) (map[string][]domain.TLE, error) {
+	candidates := make(map[string][]domain.TLE, len(noradIDs))
+	if len(noradIDs) == 0 {
+		return candidates, nil
+	}
+
+	var modelTLEs []models.TLE
+	if err := r.db.DbHandler.WithContext(ctx).
+		Raw(`SELECT DISTINCT ON (norad_id, source) * FROM tles WHERE norad_id IN ?
+			ORDER BY norad_id, source, epoch DESC, fetched_at DESC NULLS LAST, id`, noradIDs).
+		Scan(&modelTLEs).Error; err != nil {
+		return nil, fmt.Errorf("failed to retrieve candidate TLEs of %d satellites: %w", len(noradIDs), err)
+	}
+
+	for _, modelTLE := range modelTLEs {
+		candidates[modelTLE.NoradID] = append(candidates[modelTLE.NoradID], mapToDomainTLE(modelTLE))
+	}
+	return candidates, nil
+}
+
+// SetCurrentTle selects a stored element set for propagation of its satellite, then updates the cache
+// and publishes the element set to the message broker.
+func (r *TleRepository) SetCurrentTle(ctx context.Context, tle domain.TLE) error {
+	return r.SetCurrentTles(ctx, []domain.TLE{tle})
+}
+
+// SetCurrentTles selects stored element sets for propagation of their satellites in one transaction, then
+// updates the cache and publishes each element set to the message broker.
+func (r *TleRepository) SetCurrentTles(ctx context.Context, tles []domain.TLE) error {
+	if len(tles) == 0 {
+		return nil
+	}
+	noradIDs := make([]string, len(tles))
+	ids := make([]string, len(tles))
+	for i, tle := range tles {
+		noradIDs[i] = tle.NoradID
+		ids[i] = tle.ID
+	}
 
-		// Process Redis caching and broker publishing
-		for _, tle := range batch {
-			key := fmt.Sprintf("satellite:tle:%s", tle.ID)
-			cacheData := map[string]interface{}{
-				"line_1": tle.Line1,
-				"line_2": tle.Line2,
-				"epoch":  tle.Epoch,
-				"id":     tle.NoradID,
-			}
-
-			// Update Redis cache
-			if err := r.redisClient.HSet(ctx, key, cacheData); err != nil {
-				log.Printf("Failed to update Redis cache for key %s: %v\n", key, err)
-			}
-			if err := r.redisClient.Expire(ctx, key, r.cacheTTL); err != nil {
-				log.Printf("Failed to set expiration for Redis key %s: %v\n", key, err)
-			}
-
-			// Publish to the message broker
-			if err := r.publishTleToBroker(ctx, tle); err != nil {
-				log.Printf("Failed to publish TLE to message broker for NORAD ID %s: %v\n", tle.NoradID, err)
-			}
+	err := r.db.DbHandler.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.TLE{}).
+			Where("norad_id IN ? AND current = ? AND id NOT IN ?", noradIDs, true, ids).
+			Update("current", false).Error; err != nil {
+			return err
 		}
+		return tx.Model(&models.TLE{}).Where("id IN ?", ids).Update("current", true).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to select the element sets of %d satellites: %w", len(tles), err)
 	}
 
+	for _, tle := range tles {
+		key := fmt.Sprintf("satellite:tle:%s", tle.NoradID)
+		r.updateCache(ctx, key, tle)
+
+		if err := r.publishTleToBroker(ctx, tle); err != nil {
+			log.Printf("Failed to publish TLE to message broker for NORAD ID %s: %v\n", tle.NoradID, err)
+		}
+	}
 	return nil
 }
 
@@ -252,13 +333,7 @@ func (r *TleRepository) QuerySatellitePositions(ctx context.Context, satelliteID
 
 // updateCache updates the Redis cache for a TLE.
 func (r *TleRepository) updateCache(ctx context.Context, key string, tle domain.TLE) {
-	cacheData := map[string]interface{}{
-		"line_1": tle.Line1,
-		"line_2": tle.Line2,
-		"epoch":  tle.Epoch,
-		"id":     tle.NoradID,
-	}
-	if err := r.redisClient.HSet(ctx, key, cacheData); err != nil {
+	if err := r.redisClient.HSet(ctx, key, tleCacheFields(tle)); err != nil {
 		log.Printf("Failed to update Redis cache for key %s: %v\n", key, err)
 	}
 	if err := r.redisClient.Expire(ctx, key, r.cacheTTL); err != nil {
@@ -266,6 +341,53 @@ func (r *TleRepository) updateCache(ctx context.Context, key string, tle domain.
 	}
 }
 
+// tleCacheFields returns the cached hash of a TLE. Times are stored in RFC 3339 so that tleFromCache can read
+// them back, "id" keeps the NORAD ID as in the broker messages.
+func tleCacheFields(tle domain.TLE) map[string]interface{} {
+	fields := map[string]interface{}{
+		"id":      tle.NoradID,
+		"tle_id":  tle.ID,
+		"name":    tle.Name,
+		"line_1":  tle.Line1,
+		"line_2":  tle.Line2,
+		"epoch":   tle.Epoch.UTC().Format(time.RFC3339Nano),
+		"source":  tle.Source,
+		"current": strconv.FormatBool(tle.Current),
+	}
+	if !tle.FetchedAt.IsZero() {
+		fields["fetched_at"] = tle.FetchedAt.UTC().Format(time.RFC3339Nano)
+	}
+	return fields
+}
+
+// tleFromCache reads a TLE from its cached hash, failing on entries written in an older format.
+func tleFromCache(data map[string]string) (domain.TLE, error) {
+	if data["id"] == "" || data["line_1"] == "" || data["line_2"] == "" {
+		return domain.TLE{}, fmt.Errorf("incomplete cached TLE")
+	}
+	epoch, err := time.Parse(time.RFC3339Nano, data["epoch"])
+	if err != nil {
+		return domain.TLE{}, fmt.Errorf("invalid cached epoch: %w", err)
+	}
+
+	tle := domain.TLE{
+		ID:      data["tle_id"],
+		NoradID: data["id"],
+		Name:    data["name"],
+		Line1:   data["line_1"],
+		Line2:   data["line_2"],
+		Epoch:   epoch,
+		Source:  data["source"],
+		Current: data["current"] == "true",
+	}
+	if data["fetched_at"] != "" {
+		if tle.FetchedAt, err = time.Parse(time.RFC3339Nano, data["fetched_at"]); err != nil {
+			return domain.TLE{}, fmt.Errorf("invalid cached fetch time: %w", err)
+		}
+	}
+	return tle, nil
+}
+
 // publishTleToBroker sends TLE updates to the message broker.
 func (r *TleRepository) publishTleToBroker(ctx context.Context, tle domain.TLE) error {
 	message := map[string]interface{}{
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+// cachedHash returns the hash as Redis serves it back, every field as a string.
+func cachedHash(t *testing.T, fields map[string]interface{}) map[string]string {
+	data := make(map[string]string, len(fields))
+	for key, value := range fields {
+		text, ok := value.(string)
+		if !ok {
+			t.Fatalf("Cached field %s is a %T, Redis would not serve it back as written", key, value)
+		}
+		data[key] = text
+	}
+	return data
+}
+
+func TestTleCacheRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		tle  domain.TLE
+	}{
+		{
+			name: "current element set",
+			tle: domain.TLE{
+				ID:        "tle-1",
+				NoradID:   "25544",
+				Name:      "ISS (ZARYA)",
+				Line1:     "1 25544U 98067A   24061.50000000  .00016717  00000-0  30270-3 0  9993",
+				Line2:     "2 25544  51.6416 247.4627 0006703 130.5360 325.0288 15.49815508441330",
+				Epoch:     time.Date(2024, time.March, 1, 12, 0, 0, 123456000, time.UTC),
+				Source:    "spacetrack",
+				FetchedAt: time.Date(2024, time.March, 1, 13, 30, 0, 0, time.UTC),
+				Current:   true,
+			},
+		},
+		{
+			name: "legacy element set without provenance",
+			tle: domain.TLE{
+				ID:      "tle-2",
+				NoradID: "20580",
+				Line1:   "1 20580U 90037B   24061.50000000  .00001264  00000-0  64328-4 0  9997",
+				Line2:   "2 20580  28.4699 288.8102 0002526 321.7771 171.5855 15.24467449 61123",
+				Epoch:   time.Date(2024, time.March, 1, 12, 0, 0, 0, time.FixedZone("CET", 3600)),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cached, err := tleFromCache(cachedHash(t, tleCacheFields(tt.tle)))
+			if err != nil {
+				t.Fatalf("tleFromCache returned an error: %v", err)
+			}
+			if !cached.Epoch.Equal(tt.tle.Epoch) || !cached.FetchedAt.Equal(tt.tle.FetchedAt) {
+				t.Errorf("Times not kept: %v %v, expected %v %v", cached.Epoch, cached.FetchedAt, tt.tle.Epoch, tt.tle.FetchedAt)
+			}
+			cached.Epoch, cached.FetchedAt = tt.tle.Epoch, tt.tle.FetchedAt
+			if cached != tt.tle {
+				t.Errorf("Expected %+v, got %+v", tt.tle, cached)
+			}
+		})
+	}
+}
+
+func TestTleFromCacheRejectsOldEntries(t *testing.T) {
+	tests := []struct {
+		name string
+		data map[string]string
+	}{
+		{name: "binary epoch", data: map[string]string{"id": "25544", "line_1": "1", "line_2": "2", "epoch": "\x01\x00\x00\x00\x0e"}},
+		{name: "missing lines", data: map[string]string{"id": "25544", "epoch": "2024-03-01T12:00:00Z"}},
+		{name: "missing NORAD ID", data: map[string]string{"line_1": "1", "line_2": "2", "epoch": "2024-03-01T12:00:00Z"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tle, err := tleFromCache(tt.data); err == nil {
+				t.Errorf("Expected an error, got %+v", tle)
+			}
+		})
+	}
+}
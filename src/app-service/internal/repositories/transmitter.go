@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/data/models"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"gorm.io/gorm/clause"
+)
+
+// TransmitterRepository manages the radio transmitters of satellites.
+type TransmitterRepository struct {
+	db *data.Database
+}
+
+// NewTransmitterRepository creates a new TransmitterRepository instance.
+func NewTransmitterRepository(db *data.Database) domain.TransmitterRepository {
+	return &TransmitterRepository{db: db}
+}
+
+// FindByNoradID retrieves the transmitters of a satellite, active ones first.
+func (r *TransmitterRepository) FindByNoradID(ctx context.Context, noradID string) ([]domain.Transmitter, error) {
+	var transmitters []models.Transmitter
+	err := r.db.DbHandler.WithContext(ctx).
+		Where("norad_id = ?", noradID).
+		Order("is_active DESC, description ASC").
+		Find(&transmitters).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transmitters of NORAD ID %s: %w", noradID, err)
+	}
+
+	var domainTransmitters []domain.Transmitter
+	for _, transmitter := range transmitters {
+		domainTransmitters = append(domainTransmitters, models.MapToTransmitterDomain(transmitter))
+	}
+	return domainTransmitters, nil
+}
+
+// FindByID retrieves a transmitter by ID.
+func (r *TransmitterRepository) FindByID(ctx context.Context, id string) (domain.Transmitter, error) {
+	var transmitter models.Transmitter
+	if err := r.db.DbHandler.WithContext(ctx).Where("id = ?", id).First(&transmitter).Error; err != nil {
+		return domain.Transmitter{}, fmt.Errorf("failed to find transmitter %s: %w", id, err)
+	}
+	return models.MapToTransmitterDomain(transmitter), nil
+}
+
+// Save creates a new transmitter.
+func (r *TransmitterRepository) Save(ctx context.Context, transmitter domain.Transmitter) error {
+	model := models.MapToTransmitterModel(transmitter)
+	return r.db.DbHandler.WithContext(ctx).Create(&model).Error
+}
+
+// Update updates an existing transmitter.
+func (r *TransmitterRepository) Update(ctx context.Context, transmitter domain.Transmitter) error {
+	model := models.MapToTransmitterModel(transmitter)
+	result := r.db.DbHandler.WithContext(ctx).
+		Model(&models.Transmitter{}).
+		Where("id = ?", model.ID).
+		Updates(map[string]interface{}{
+			"display_name":  model.DisplayName,
+			"description":   model.Description,
+			"type":          model.Type,
+			"uplink_low":    model.UplinkLow,
+			"uplink_high":   model.UplinkHigh,
+			"downlink_low":  model.DownlinkLow,
+			"downlink_high": model.DownlinkHigh,
+			"mode":          model.Mode,
+			"uplink_mode":   model.UplinkMode,
+			"baud":          model.Baud,
+			"invert":        model.Invert,
+			"status":        model.Status,
+			"service":       model.Service,
+			"is_active":     model.IsActive,
+			"updated_at":    model.UpdatedAt,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update transmitter: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("transmitter %s not found", transmitter.ID)
+	}
+	return nil
+}
+
+// DeleteByID removes a transmitter.
+func (r *TransmitterRepository) DeleteByID(ctx context.Context, id string) error {
+	result := r.db.DbHandler.WithContext(ctx).Where("id = ?", id).Delete(&models.Transmitter{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete transmitter: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("transmitter %s not found", id)
+	}
+	return nil
+}
+
+// SaveBatch upserts imported transmitters on their external ID.
+func (r *TransmitterRepository) SaveBatch(ctx context.Context, transmitters []domain.Transmitter) error {
+	if len(transmitters) == 0 {
+		return nil
+	}
+
+	modelTransmitters := make([]models.Transmitter, len(transmitters))
+	for i, transmitter := range transmitters {
+		modelTransmitters[i] = models.MapToTransmitterModel(transmitter)
+	}
+
+	return r.db.DbHandler.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "external_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"norad_id", "display_name", "description", "type", "uplink_low", "uplink_high", "downlink_low", "downlink_high",
+			"mode", "uplink_mode", "baud", "invert", "status", "service", "is_active", "updated_at",
+		}),
+	}).CreateInBatches(&modelTransmitters, 500).Error
+}
@@ -0,0 +1,183 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	repository "github.com/Elbujito/2112/src/app-service/internal/repositories"
+	"github.com/Elbujito/2112/src/app-service/pkg/tracing"
+)
+
+// ConstellationService manages constellations of synthetic satellites and their coverage statistics.
+type ConstellationService struct {
+	repo          domain.ConstellationRepository
+	contextRepo   domain.GameContextRepository
+	satelliteRepo domain.SatelliteRepository
+	tileRepo      domain.TileRepository
+	tleRepo       repository.TleRepository
+}
+
+// NewConstellationService creates a new instance of ConstellationService.
+func NewConstellationService(repo domain.ConstellationRepository, contextRepo domain.GameContextRepository, satelliteRepo domain.SatelliteRepository, tileRepo domain.TileRepository, tleRepo repository.TleRepository) ConstellationService {
+	return ConstellationService{repo: repo, contextRepo: contextRepo, satelliteRepo: satelliteRepo, tileRepo: tileRepo, tleRepo: tleRepo}
+}
+
+// Create generates the synthetic satellites of a constellation, attaches them to the context and stores the constellation,
+// all in one transaction so that a failure leaves no orphan satellite behind.
+func (s *ConstellationService) Create(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, input domain.Constellation) (constellation domain.Constellation, err error) {
+	ctx, span := tracing.NewSpan(ctx, "CreateConstellation")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return domain.Constellation{}, err
+	}
+
+	constellation, err = domain.NewConstellation(gameContext.ID, gameContext.TenantID, input.Name, input.Kind, input.Walker, input.Planes, input.Epoch)
+	if err != nil {
+		return domain.Constellation{}, err
+	}
+
+	exists, err := s.repo.ExistsByName(ctx, gameContext.ID, constellation.Name)
+	if err != nil {
+		return domain.Constellation{}, err
+	}
+	if exists {
+		return domain.Constellation{}, fmt.Errorf("constellation %s already exists in context %s", constellation.Name, contextName)
+	}
+
+	var tles []domain.TLE
+	err = allocateSyntheticNoradIDs(ctx, s.satelliteRepo, constellation.SatelliteCount(), func(firstNoradID int) error {
+		var satellites []domain.Satellite
+		satellites, tles, err = constellation.GenerateSatellites(firstNoradID)
+		if err != nil {
+			return err
+		}
+		return s.repo.Save(ctx, constellation, satellites, tles)
+	})
+	if err != nil {
+		return domain.Constellation{}, err
+	}
+	publishSyntheticTLEs(ctx, s.tleRepo, tles)
+
+	return constellation, nil
+}
+
+// List retrieves the constellations of a context.
+func (s *ConstellationService) List(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID) (constellations []domain.Constellation, err error) {
+	ctx, span := tracing.NewSpan(ctx, "ListConstellations")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.FindAllByContext(ctx, gameContext.ID, gameContext.TenantID)
+}
+
+// Get retrieves a constellation of a context by ID.
+func (s *ConstellationService) Get(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, id string) (constellation domain.Constellation, err error) {
+	ctx, span := tracing.NewSpan(ctx, "GetConstellation")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return domain.Constellation{}, err
+	}
+	return s.repo.FindByID(ctx, gameContext.ID, gameContext.TenantID, id)
+}
+
+// Delete removes a constellation and retires its synthetic satellites.
+func (s *ConstellationService) Delete(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, id string) (err error) {
+	ctx, span := tracing.NewSpan(ctx, "DeleteConstellation")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return err
+	}
+
+	constellation, err := s.repo.FindByID(ctx, gameContext.ID, gameContext.TenantID, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.DeleteByID(ctx, gameContext.ID, gameContext.TenantID, id); err != nil {
+		return err
+	}
+
+	for _, noradID := range constellation.NoradIDs {
+		if err := s.satelliteRepo.DeleteByNoradID(ctx, noradID); err != nil {
+			log.Printf("Failed to retire synthetic satellite %s: %v", noradID, err)
+		}
+	}
+	return nil
+}
+
+// ComputeCoverage evaluates and stores the coverage of the context tiles by a constellation between start and end.
+func (s *ConstellationService) ComputeCoverage(
+	ctx context.Context,
+	contextName domain.GameContextName,
+	tenantID domain.TenantID,
+	id string,
+	minElevation float64,
+	bandWidth float64,
+	start time.Time,
+	end time.Time,
+	step time.Duration,
+) (report domain.ConstellationCoverageReport, err error) {
+	ctx, span := tracing.NewSpan(ctx, "ComputeConstellationCoverage")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return domain.ConstellationCoverageReport{}, err
+	}
+
+	constellation, err := s.repo.FindByID(ctx, gameContext.ID, gameContext.TenantID, id)
+	if err != nil {
+		return domain.ConstellationCoverageReport{}, err
+	}
+
+	tiles, err := s.tileRepo.GetTilesByContext(ctx, gameContext.ID)
+	if err != nil {
+		return domain.ConstellationCoverageReport{}, fmt.Errorf("failed to fetch tiles of context %s: %w", contextName, err)
+	}
+
+	var tles []domain.TLE
+	for _, noradID := range constellation.NoradIDs {
+		tle, err := s.tleRepo.GetTle(ctx, noradID)
+		if err != nil {
+			log.Printf("Skipping NORAD ID %s in coverage computation: %v", noradID, err)
+			continue
+		}
+		tles = append(tles, tle)
+	}
+
+	report, err = domain.NewConstellationCoverageReport(constellation, tles, tiles, minElevation, bandWidth, start, end, step)
+	if err != nil {
+		return domain.ConstellationCoverageReport{}, err
+	}
+
+	if err := s.repo.SaveCoverageReport(ctx, report); err != nil {
+		return domain.ConstellationCoverageReport{}, fmt.Errorf("failed to save coverage report: %w", err)
+	}
+	return report, nil
+}
+
+// GetLatestCoverage retrieves the most recent coverage report of a constellation.
+func (s *ConstellationService) GetLatestCoverage(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, id string) (report *domain.ConstellationCoverageReport, err error) {
+	ctx, span := tracing.NewSpan(ctx, "GetLatestConstellationCoverage")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.FindByID(ctx, gameContext.ID, gameContext.TenantID, id); err != nil {
+		return nil, err
+	}
+	return s.repo.FindLatestCoverageReport(ctx, id)
+}
@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	repository "github.com/Elbujito/2112/src/app-service/internal/repositories"
+	"github.com/Elbujito/2112/src/app-service/pkg/tracing"
+)
+
+// defaultContactPriority is the priority of satellites scheduled without an explicit request.
+const defaultContactPriority = 1
+
+// ContactSchedulerService builds conflict-free contact plans between ground stations and satellites.
+type ContactSchedulerService struct {
+	repo          domain.ContactPlanRepository
+	stationRepo   domain.GroundStationRepository
+	contextRepo   domain.GameContextRepository
+	satelliteRepo domain.SatelliteRepository
+	tleRepo       repository.TleRepository
+}
+
+// NewContactSchedulerService creates a new instance of ContactSchedulerService.
+func NewContactSchedulerService(
+	repo domain.ContactPlanRepository,
+	stationRepo domain.GroundStationRepository,
+	contextRepo domain.GameContextRepository,
+	satelliteRepo domain.SatelliteRepository,
+	tleRepo repository.TleRepository,
+) ContactSchedulerService {
+	return ContactSchedulerService{
+		repo:          repo,
+		stationRepo:   stationRepo,
+		contextRepo:   contextRepo,
+		satelliteRepo: satelliteRepo,
+		tleRepo:       tleRepo,
+	}
+}
+
+// SchedulePlan predicts the passes of the requested satellites over the context stations and stores a conflict-free plan.
+// When no request is given, every satellite of the context is scheduled with the default priority.
+// When no station is given, every station of the context is used.
+func (s *ContactSchedulerService) SchedulePlan(
+	ctx context.Context,
+	contextName domain.GameContextName,
+	tenantID domain.TenantID,
+	requests []domain.ContactRequest,
+	stationIDs []string,
+	start time.Time,
+	end time.Time,
+	setupMargin time.Duration,
+	teardownMargin time.Duration,
+) (plan domain.ContactPlan, err error) {
+	ctx, span := tracing.NewSpan(ctx, "SchedulePlan")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return domain.ContactPlan{}, err
+	}
+
+	stations, err := s.findStations(ctx, gameContext, stationIDs)
+	if err != nil {
+		return domain.ContactPlan{}, err
+	}
+
+	if len(requests) == 0 {
+		satellites, err := s.satelliteRepo.FindSatellitesByContext(ctx, gameContext.ID)
+		if err != nil {
+			return domain.ContactPlan{}, fmt.Errorf("failed to fetch satellites of context %s: %w", contextName, err)
+		}
+		for _, satellite := range satellites {
+			requests = append(requests, domain.ContactRequest{NoradID: satellite.NoradID, Priority: defaultContactPriority})
+		}
+	}
+
+	var candidates []domain.ContactCandidate
+	for _, request := range requests {
+		tle, err := s.tleRepo.GetTle(ctx, request.NoradID)
+		if err != nil {
+			log.Printf("Skipping NORAD ID %s in contact plan: %v", request.NoradID, err)
+			continue
+		}
+		for _, station := range stations {
+			passes, err := station.PredictPasses(tle, start, end, passSearchStep)
+			if err != nil {
+				log.Printf("Skipping NORAD ID %s over station %s: %v", request.NoradID, station.Name, err)
+				continue
+			}
+			for _, pass := range passes {
+				candidates = append(candidates, domain.ContactCandidate{GroundStationPass: pass, Priority: request.Priority})
+			}
+		}
+	}
+
+	plan, err = domain.NewContactPlan(gameContext, start, end, setupMargin, teardownMargin, candidates)
+	if err != nil {
+		return domain.ContactPlan{}, err
+	}
+
+	if err := s.repo.Save(ctx, plan); err != nil {
+		return domain.ContactPlan{}, err
+	}
+	return plan, nil
+}
+
+// findStations retrieves the requested stations of a context, or all of them when none is requested.
+func (s *ContactSchedulerService) findStations(ctx context.Context, gameContext domain.GameContext, stationIDs []string) ([]domain.GroundStation, error) {
+	if len(stationIDs) == 0 {
+		stations, err := s.stationRepo.FindAllByContext(ctx, gameContext.ID, gameContext.TenantID)
+		if err != nil {
+			return nil, err
+		}
+		if len(stations) == 0 {
+			return nil, fmt.Errorf("context %s has no ground station", gameContext.Name)
+		}
+		return stations, nil
+	}
+
+	var stations []domain.GroundStation
+	for _, id := range stationIDs {
+		station, err := s.stationRepo.FindByID(ctx, gameContext.ID, gameContext.TenantID, id)
+		if err != nil {
+			return nil, err
+		}
+		stations = append(stations, station)
+	}
+	return stations, nil
+}
+
+// GetLatestPlan retrieves the most recent contact plan of a context.
+func (s *ContactSchedulerService) GetLatestPlan(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID) (plan domain.ContactPlan, err error) {
+	ctx, span := tracing.NewSpan(ctx, "GetLatestPlan")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return domain.ContactPlan{}, err
+	}
+	return s.repo.FindLatestByContext(ctx, gameContext.ID, gameContext.TenantID)
+}
@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"github.com/Elbujito/2112/src/app-service/pkg/tracing"
+)
+
+// DataQualityService checks the consistency of the catalog and optionally repairs or prunes it.
+type DataQualityService struct {
+	repo          domain.DataQualityRepository
+	satelliteRepo domain.SatelliteRepository
+	contextRepo   domain.GameContextRepository
+}
+
+// NewDataQualityService creates a new instance of DataQualityService.
+func NewDataQualityService(repo domain.DataQualityRepository, satelliteRepo domain.SatelliteRepository, contextRepo domain.GameContextRepository) DataQualityService {
+	return DataQualityService{repo: repo, satelliteRepo: satelliteRepo, contextRepo: contextRepo}
+}
+
+// Run checks the catalog wide consistency and then each context, or only the given one when not empty.
+// Orphan TLEs belong to no context, so they are only reported when a context is given: fixing or pruning
+// them would act on the whole catalog.
+func (s *DataQualityService) Run(ctx context.Context, contextName domain.GameContextName, options domain.DataQualityOptions) (report domain.DataQualityReport, err error) {
+	ctx, span := tracing.NewSpan(ctx, "RunDataQualityChecks")
+	defer span.EndWithError(err)
+
+	if err := options.Validate(); err != nil {
+		return domain.DataQualityReport{}, err
+	}
+
+	var contexts []domain.GameContext
+	if contextName != "" {
+		gameContext, err := resolveContext(ctx, s.contextRepo, contextName)
+		if err != nil {
+			return domain.DataQualityReport{}, err
+		}
+		contexts = append(contexts, gameContext)
+	} else {
+		contexts, err = s.contextRepo.FindAll(ctx)
+		if err != nil {
+			return domain.DataQualityReport{}, fmt.Errorf("failed to find contexts: %w", err)
+		}
+	}
+
+	now := time.Now().UTC()
+	report = domain.DataQualityReport{
+		GeneratedAt: now,
+		StaleDays:   int(options.StaleAfter / (24 * time.Hour)),
+		Fix:         options.Fix,
+		Prune:       options.Prune,
+	}
+
+	catalogOptions := options
+	if contextName != "" {
+		catalogOptions.Fix, catalogOptions.Prune = false, false
+	}
+	orphans, err := s.checkOrphanTLEs(ctx, catalogOptions)
+	if err != nil {
+		return domain.DataQualityReport{}, err
+	}
+	report.Findings = append(report.Findings, orphans)
+
+	for _, gameContext := range contexts {
+		withoutTLE, err := s.checkSatellitesWithoutTLE(ctx, gameContext, options)
+		if err != nil {
+			return domain.DataQualityReport{}, err
+		}
+
+		stale, err := s.repo.FindStaleSatellites(ctx, gameContext.ID, now.Add(-options.StaleAfter))
+		if err != nil {
+			return domain.DataQualityReport{}, err
+		}
+
+		dangling, err := s.checkDanglingMappings(ctx, gameContext, options)
+		if err != nil {
+			return domain.DataQualityReport{}, err
+		}
+
+		report.Findings = append(report.Findings,
+			withoutTLE,
+			domain.NewDataQualityFinding(gameContext.Name, domain.CheckStaleTLEs, stale),
+			dangling,
+		)
+	}
+
+	log.Printf("Data quality checks of %d contexts found %d issues", len(contexts), report.Issues())
+	return report, nil
+}
+
+// checkOrphanTLEs creates the satellites of the orphan TLEs when fixing, and deletes the orphan TLEs left when pruning.
+func (s *DataQualityService) checkOrphanTLEs(ctx context.Context, options domain.DataQualityOptions) (domain.DataQualityFinding, error) {
+	tles, err := s.repo.FindOrphanTLEs(ctx)
+	if err != nil {
+		return domain.DataQualityFinding{}, err
+	}
+
+	noradIDs := make([]string, 0, len(tles))
+	for _, tle := range tles {
+		noradIDs = append(noradIDs, tle.NoradID)
+	}
+	finding := domain.NewDataQualityFinding("", domain.CheckOrphanTLEs, noradIDs)
+
+	if options.Fix {
+		for _, tle := range tles {
+			name := tle.Name
+			if name == "" {
+				name = domain.UnknownSatelliteName(tle.NoradID)
+			}
+			satellite, err := domain.NewSatellite(name, tle.NoradID, domain.Active, false, true, time.Now().UTC())
+			if err != nil {
+				return domain.DataQualityFinding{}, err
+			}
+			if err := s.satelliteRepo.Save(ctx, satellite); err != nil {
+				return domain.DataQualityFinding{}, fmt.Errorf("failed to create satellite %s: %w", tle.NoradID, err)
+			}
+			finding.Fixed++
+		}
+	}
+
+	if options.Prune && finding.Fixed < finding.Count {
+		pruned, err := s.repo.DeleteOrphanTLEs(ctx)
+		if err != nil {
+			return domain.DataQualityFinding{}, err
+		}
+		finding.Pruned = int(pruned)
+	}
+	return finding, nil
+}
+
+// checkSatellitesWithoutTLE removes the satellites without TLE from the context when pruning.
+func (s *DataQualityService) checkSatellitesWithoutTLE(ctx context.Context, gameContext domain.GameContext, options domain.DataQualityOptions) (domain.DataQualityFinding, error) {
+	satellites, err := s.repo.FindSatellitesWithoutTLE(ctx, gameContext.ID)
+	if err != nil {
+		return domain.DataQualityFinding{}, err
+	}
+
+	noradIDs := make([]string, 0, len(satellites))
+	for _, satellite := range satellites {
+		noradIDs = append(noradIDs, satellite.NoradID)
+	}
+	finding := domain.NewDataQualityFinding(gameContext.Name, domain.CheckSatellitesWithoutTLE, noradIDs)
+
+	if options.Prune {
+		for _, satellite := range satellites {
+			if err := s.satelliteRepo.RemoveSatelliteFromContext(ctx, gameContext.ID, satellite.ID); err != nil {
+				return domain.DataQualityFinding{}, fmt.Errorf("failed to remove satellite %s from context %s: %w", satellite.NoradID, gameContext.Name, err)
+			}
+			finding.Pruned++
+		}
+	}
+	return finding, nil
+}
+
+// checkDanglingMappings deletes the mappings pointing to deleted tiles when pruning.
+func (s *DataQualityService) checkDanglingMappings(ctx context.Context, gameContext domain.GameContext, options domain.DataQualityOptions) (domain.DataQualityFinding, error) {
+	mappings, err := s.repo.FindDanglingMappings(ctx, gameContext.ID)
+	if err != nil {
+		return domain.DataQualityFinding{}, err
+	}
+
+	keys := make([]string, 0, len(mappings))
+	for _, mapping := range mappings {
+		keys = append(keys, mapping.NoradID+"/"+mapping.TileID)
+	}
+	finding := domain.NewDataQualityFinding(gameContext.Name, domain.CheckDanglingMappings, keys)
+
+	if options.Prune && finding.Count > 0 {
+		pruned, err := s.repo.DeleteDanglingMappings(ctx, gameContext.ID)
+		if err != nil {
+			return domain.DataQualityFinding{}, err
+		}
+		finding.Pruned = int(pruned)
+	}
+	return finding, nil
+}
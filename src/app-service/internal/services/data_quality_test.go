@@ -0,0 +1,216 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+// fakeDataQualityRepository serves a catalog with orphan TLEs and, per context, satellites without TLE,
+// stale satellites and dangling mappings.
+type fakeDataQualityRepository struct {
+	orphans          []domain.TLE
+	withoutTLE       map[string][]domain.Satellite
+	stale            map[string][]string
+	dangling         map[string][]domain.TileSatelliteMapping
+	orphansDeleted   int
+	danglingDeleted  []string
+	staleCheckedFrom time.Time
+}
+
+func (r *fakeDataQualityRepository) FindOrphanTLEs(ctx context.Context) ([]domain.TLE, error) {
+	return r.orphans, nil
+}
+
+func (r *fakeDataQualityRepository) DeleteOrphanTLEs(ctx context.Context) (int64, error) {
+	r.orphansDeleted++
+	return int64(len(r.orphans)), nil
+}
+
+func (r *fakeDataQualityRepository) FindSatellitesWithoutTLE(ctx context.Context, contextID string) ([]domain.Satellite, error) {
+	return r.withoutTLE[contextID], nil
+}
+
+func (r *fakeDataQualityRepository) FindStaleSatellites(ctx context.Context, contextID string, before time.Time) ([]string, error) {
+	r.staleCheckedFrom = before
+	return r.stale[contextID], nil
+}
+
+func (r *fakeDataQualityRepository) FindDanglingMappings(ctx context.Context, contextID string) ([]domain.TileSatelliteMapping, error) {
+	return r.dangling[contextID], nil
+}
+
+func (r *fakeDataQualityRepository) DeleteDanglingMappings(ctx context.Context, contextID string) (int64, error) {
+	r.danglingDeleted = append(r.danglingDeleted, contextID)
+	return int64(len(r.dangling[contextID])), nil
+}
+
+// fakeDataQualitySatelliteRepository records the satellites created and removed from contexts.
+type fakeDataQualitySatelliteRepository struct {
+	domain.SatelliteRepository
+	saved   []domain.Satellite
+	removed []string
+}
+
+func (r *fakeDataQualitySatelliteRepository) Save(ctx context.Context, satellite domain.Satellite) error {
+	r.saved = append(r.saved, satellite)
+	return nil
+}
+
+func (r *fakeDataQualitySatelliteRepository) RemoveSatelliteFromContext(ctx context.Context, contextID, satelliteID string) error {
+	r.removed = append(r.removed, contextID+"/"+satelliteID)
+	return nil
+}
+
+type fakeDataQualityContextRepository struct {
+	domain.GameContextRepository
+	contexts []domain.GameContext
+}
+
+func (r *fakeDataQualityContextRepository) FindAll(ctx context.Context) ([]domain.GameContext, error) {
+	return r.contexts, nil
+}
+
+func (r *fakeDataQualityContextRepository) FindByUniqueName(ctx context.Context, name domain.GameContextName) (domain.GameContext, error) {
+	for _, gameContext := range r.contexts {
+		if gameContext.Name == name {
+			return gameContext, nil
+		}
+	}
+	return domain.GameContext{}, errors.New("context not found")
+}
+
+func newDataQualityFixture() (*fakeDataQualityRepository, *fakeDataQualitySatelliteRepository, DataQualityService) {
+	repo := &fakeDataQualityRepository{
+		orphans: []domain.TLE{{NoradID: "25544", Name: "ISS (ZARYA)"}, {NoradID: "20580"}},
+		withoutTLE: map[string][]domain.Satellite{
+			"leo": {{ModelBase: domain.ModelBase{ID: "sat-1"}, NoradID: "1"}},
+			"geo": {{ModelBase: domain.ModelBase{ID: "sat-2"}, NoradID: "2"}},
+		},
+		stale: map[string][]string{"leo": {"3", "4"}},
+		dangling: map[string][]domain.TileSatelliteMapping{
+			"geo": {{NoradID: "5", TileID: "tile"}},
+		},
+	}
+	satelliteRepo := &fakeDataQualitySatelliteRepository{}
+	contextRepo := &fakeDataQualityContextRepository{contexts: []domain.GameContext{
+		{ModelBase: domain.ModelBase{ID: "leo"}, Name: "LEO"},
+		{ModelBase: domain.ModelBase{ID: "geo"}, Name: "GEO"},
+	}}
+	return repo, satelliteRepo, NewDataQualityService(repo, satelliteRepo, contextRepo)
+}
+
+func findingOf(report domain.DataQualityReport, contextName domain.GameContextName, check domain.DataQualityCheck) (domain.DataQualityFinding, bool) {
+	for _, finding := range report.Findings {
+		if finding.Context == contextName && finding.Check == check {
+			return finding, true
+		}
+	}
+	return domain.DataQualityFinding{}, false
+}
+
+func TestDataQualityRunReports(t *testing.T) {
+	repo, satelliteRepo, service := newDataQualityFixture()
+	options := domain.DataQualityOptions{StaleAfter: 10 * 24 * time.Hour}
+
+	report, err := service.Run(context.Background(), "", options)
+	if err != nil {
+		t.Fatalf("Run returned an error: %v", err)
+	}
+
+	// One catalog wide finding, then three findings per context
+	if len(report.Findings) != 7 || report.Issues() != 7 || report.StaleDays != 10 {
+		t.Errorf("Unexpected report %+v", report)
+	}
+	if orphans, _ := findingOf(report, "", domain.CheckOrphanTLEs); orphans.Count != 2 || orphans.Fixed != 0 || orphans.Pruned != 0 {
+		t.Errorf("Unexpected orphan TLEs finding %+v", orphans)
+	}
+	if stale, _ := findingOf(report, "LEO", domain.CheckStaleTLEs); stale.Count != 2 {
+		t.Errorf("Unexpected stale TLEs finding %+v", stale)
+	}
+	if dangling, _ := findingOf(report, "GEO", domain.CheckDanglingMappings); dangling.Count != 1 || dangling.Sample[0] != "5/tile" {
+		t.Errorf("Unexpected dangling mappings finding %+v", dangling)
+	}
+	if age := report.GeneratedAt.Sub(repo.staleCheckedFrom); age != options.StaleAfter {
+		t.Errorf("Expected stale TLEs older than %v, got %v", options.StaleAfter, age)
+	}
+
+	// Reporting changes nothing
+	if len(satelliteRepo.saved) != 0 || len(satelliteRepo.removed) != 0 || repo.orphansDeleted != 0 || len(repo.danglingDeleted) != 0 {
+		t.Errorf("Expected no change without fix or prune")
+	}
+}
+
+func TestDataQualityRunFixesAndPrunes(t *testing.T) {
+	repo, satelliteRepo, service := newDataQualityFixture()
+
+	report, err := service.Run(context.Background(), "", domain.DataQualityOptions{StaleAfter: time.Hour, Fix: true, Prune: true})
+	if err != nil {
+		t.Fatalf("Run returned an error: %v", err)
+	}
+
+	orphans, _ := findingOf(report, "", domain.CheckOrphanTLEs)
+	if orphans.Fixed != 2 || len(satelliteRepo.saved) != 2 {
+		t.Fatalf("Expected the satellites of both orphan TLEs, got %+v and %d satellites", orphans, len(satelliteRepo.saved))
+	}
+	if satelliteRepo.saved[0].Name != "ISS (ZARYA)" || satelliteRepo.saved[1].Name != domain.UnknownSatelliteName("20580") {
+		t.Errorf("Unexpected names %q and %q", satelliteRepo.saved[0].Name, satelliteRepo.saved[1].Name)
+	}
+	// Nothing left to prune once every orphan TLE is fixed
+	if repo.orphansDeleted != 0 {
+		t.Errorf("Expected no orphan TLE deleted after fixing all of them")
+	}
+
+	if len(satelliteRepo.removed) != 2 || satelliteRepo.removed[0] != "leo/sat-1" || satelliteRepo.removed[1] != "geo/sat-2" {
+		t.Errorf("Expected the satellites without TLE removed from their context, got %v", satelliteRepo.removed)
+	}
+	if len(repo.danglingDeleted) != 1 || repo.danglingDeleted[0] != "geo" {
+		t.Errorf("Expected the dangling mappings of GEO deleted, got %v", repo.danglingDeleted)
+	}
+}
+
+func TestDataQualityRunPrunesOrphanTLEs(t *testing.T) {
+	repo, _, service := newDataQualityFixture()
+
+	report, err := service.Run(context.Background(), "", domain.DataQualityOptions{StaleAfter: time.Hour, Prune: true})
+	if err != nil {
+		t.Fatalf("Run returned an error: %v", err)
+	}
+	if orphans, _ := findingOf(report, "", domain.CheckOrphanTLEs); repo.orphansDeleted != 1 || orphans.Pruned != 2 {
+		t.Errorf("Expected the orphan TLEs deleted, got %+v", orphans)
+	}
+}
+
+func TestDataQualityRunContext(t *testing.T) {
+	repo, satelliteRepo, service := newDataQualityFixture()
+
+	report, err := service.Run(context.Background(), "GEO", domain.DataQualityOptions{StaleAfter: time.Hour, Fix: true, Prune: true})
+	if err != nil {
+		t.Fatalf("Run returned an error: %v", err)
+	}
+	if len(report.Findings) != 4 {
+		t.Fatalf("Expected the orphan TLEs and the GEO findings, got %+v", report.Findings)
+	}
+	if _, ok := findingOf(report, "LEO", domain.CheckSatellitesWithoutTLE); ok {
+		t.Errorf("Expected LEO not to be checked")
+	}
+
+	// Orphan TLEs belong to no context, they are reported but left untouched
+	orphans, _ := findingOf(report, "", domain.CheckOrphanTLEs)
+	if orphans.Count != 2 || orphans.Fixed != 0 || orphans.Pruned != 0 || len(satelliteRepo.saved) != 0 || repo.orphansDeleted != 0 {
+		t.Errorf("Expected the orphan TLEs only reported, got %+v", orphans)
+	}
+	if len(satelliteRepo.removed) != 1 || satelliteRepo.removed[0] != "geo/sat-2" {
+		t.Errorf("Expected only GEO pruned, got %v", satelliteRepo.removed)
+	}
+
+	if _, err := service.Run(context.Background(), "MEO", domain.DataQualityOptions{StaleAfter: time.Hour}); err == nil {
+		t.Error("Expected an error for an unknown context")
+	}
+	if _, err := service.Run(context.Background(), "", domain.DataQualityOptions{}); err == nil {
+		t.Error("Expected an error without a stale limit")
+	}
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	repository "github.com/Elbujito/2112/src/app-service/internal/repositories"
+	"github.com/Elbujito/2112/src/app-service/pkg/tracing"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+)
+
+// spaceWeatherAveragingDays is the number of days averaged from a space weather file (three solar rotations).
+const spaceWeatherAveragingDays = 81
+
+// DecayService predicts satellite reentries.
+type DecayService struct {
+	repo          domain.DecayPredictionRepository
+	satelliteRepo domain.SatelliteRepository
+	tleRepo       repository.TleRepository
+}
+
+// NewDecayService creates a new instance of DecayService.
+func NewDecayService(repo domain.DecayPredictionRepository, satelliteRepo domain.SatelliteRepository, tleRepo repository.TleRepository) DecayService {
+	return DecayService{repo: repo, satelliteRepo: satelliteRepo, tleRepo: tleRepo}
+}
+
+// LoadSpaceWeather reads an offline space weather file and averages the recent activity.
+// The default space weather is used when no file is provided.
+func (s *DecayService) LoadSpaceWeather(path string) (xspace.SpaceWeather, error) {
+	if path == "" {
+		return xspace.DefaultSpaceWeather, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return xspace.SpaceWeather{}, fmt.Errorf("failed to open space weather file: %w", err)
+	}
+	defer file.Close()
+
+	records, err := xspace.ParseSpaceWeatherCSV(file)
+	if err != nil {
+		return xspace.SpaceWeather{}, err
+	}
+	return xspace.AverageSpaceWeather(records, time.Now().UTC(), spaceWeatherAveragingDays), nil
+}
+
+// PredictDecays estimates the reentry window of every satellite with a perigee below maxPerigee.
+// A satellite whose TLE history cannot be loaded or parsed is logged and skipped, so it does not abort the run.
+func (s *DecayService) PredictDecays(ctx context.Context, maxPerigee float64, historyWindow time.Duration, weather xspace.SpaceWeather) (predictions []domain.DecayPrediction, err error) {
+	ctx, span := tracing.NewSpan(ctx, "PredictDecays")
+	defer span.EndWithError(err)
+
+	satellites, err := s.satelliteRepo.FindWithPerigeeBelow(ctx, maxPerigee)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch low perigee satellites: %w", err)
+	}
+
+	since := time.Now().UTC().Add(-historyWindow)
+	for _, satellite := range satellites {
+		tles, err := s.tleRepo.GetTleHistory(ctx, satellite.NoradID, since)
+		if err != nil {
+			log.Printf("Skipping decay prediction for NORAD ID %s: failed to fetch TLE history: %v", satellite.NoradID, err)
+			continue
+		}
+
+		prediction, err := domain.NewDecayPrediction(satellite, tles, weather)
+		if errors.Is(err, xspace.ErrNoDecay) {
+			// Drop a stale prediction after a reboost
+			if err := s.repo.DeleteByNoradID(ctx, satellite.NoradID); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			log.Printf("Skipping decay prediction for NORAD ID %s: %v", satellite.NoradID, err)
+			continue
+		}
+		predictions = append(predictions, prediction)
+	}
+
+	if err := s.repo.SaveBatch(ctx, predictions); err != nil {
+		return nil, fmt.Errorf("failed to save decay predictions: %w", err)
+	}
+	return predictions, nil
+}
+
+// MarkDecayedSatellites flags the satellites whose reentry window has passed as decayed.
+func (s *DecayService) MarkDecayedSatellites(ctx context.Context) (count int, err error) {
+	ctx, span := tracing.NewSpan(ctx, "MarkDecayedSatellites")
+	defer span.EndWithError(err)
+
+	passed, err := s.repo.FindPassed(ctx, time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+
+	for _, prediction := range passed {
+		if err := s.satelliteRepo.MarkDecayed(ctx, prediction.NoradID, prediction.DecayAt); err != nil {
+			return count, fmt.Errorf("failed to mark NORAD ID %s as decayed: %w", prediction.NoradID, err)
+		}
+		count++
+	}
+	return count, nil
+}
+
+// ListUpcomingReentries retrieves the predicted reentries within the given duration from now.
+func (s *DecayService) ListUpcomingReentries(ctx context.Context, within time.Duration) (predictions []domain.DecayPrediction, err error) {
+	ctx, span := tracing.NewSpan(ctx, "ListUpcomingReentries")
+	defer span.EndWithError(err)
+	if within <= 0 {
+		return nil, fmt.Errorf("duration must be greater than zero")
+	}
+	now := time.Now().UTC()
+	return s.repo.FindUpcoming(ctx, now, now.Add(within))
+}
@@ -0,0 +1,237 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	repository "github.com/Elbujito/2112/src/app-service/internal/repositories"
+	"github.com/Elbujito/2112/src/app-service/pkg/tracing"
+)
+
+// ElementSetService decides which stored element set of a satellite is current, that is used for propagation.
+// The candidates are the newest element set of each source, selected by the configured policy unless an
+// operator override pins one of them. Every ingestion path goes through it, so it also keeps the orbital
+// parameters of each satellite in line with its current element set.
+type ElementSetService struct {
+	tleRepo       repository.TleRepository
+	overrideRepo  domain.ElementSetOverrideRepository
+	satelliteRepo domain.SatelliteRepository
+	policy        domain.ElementSetPolicy
+}
+
+// NewElementSetService creates a new instance of ElementSetService.
+func NewElementSetService(tleRepo repository.TleRepository, overrideRepo domain.ElementSetOverrideRepository, satelliteRepo domain.SatelliteRepository, policy domain.ElementSetPolicy) ElementSetService {
+	return ElementSetService{tleRepo: tleRepo, overrideRepo: overrideRepo, satelliteRepo: satelliteRepo, policy: policy}
+}
+
+// resolveBatchSize is the number of satellites whose element sets are resolved with the same queries.
+const resolveBatchSize = 500
+
+// ResolveCurrent selects the current element set of each satellite after new element sets were stored.
+// Satellites whose selection did not change are left untouched, so only actual changes are re-propagated.
+func (s *ElementSetService) ResolveCurrent(ctx context.Context, noradIDs []string) (err error) {
+	ctx, span := tracing.NewSpan(ctx, "ResolveCurrentElementSets")
+	defer span.EndWithError(err)
+
+	changed := 0
+	for start := 0; start < len(noradIDs); start += resolveBatchSize {
+		end := min(start+resolveBatchSize, len(noradIDs))
+		count, err := s.resolveBatch(ctx, noradIDs[start:end])
+		if err != nil {
+			return err
+		}
+		changed += count
+	}
+
+	log.Printf("Resolved the current element set of %d satellites, %d changed", len(noradIDs), changed)
+	return nil
+}
+
+// resolveBatch selects the current element set of a batch of satellites, loading their candidates and storing
+// the changed selections at once. It returns the number of satellites whose selection changed.
+func (s *ElementSetService) resolveBatch(ctx context.Context, noradIDs []string) (int, error) {
+	batch, err := s.candidatesOf(ctx, noradIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	var selected []domain.TLE
+	for _, noradID := range noradIDs {
+		candidates := batch[noradID]
+		if len(candidates.Candidates) == 0 {
+			continue
+		}
+		tle, changed, err := s.policySelection(candidates)
+		if err != nil {
+			return 0, err
+		}
+		if changed {
+			selected = append(selected, tle)
+		}
+	}
+
+	if err := s.tleRepo.SetCurrentTles(ctx, selected); err != nil {
+		return 0, err
+	}
+	for _, tle := range selected {
+		if err := s.updateOrbitalParameters(ctx, tle); err != nil {
+			return 0, err
+		}
+	}
+	return len(selected), nil
+}
+
+// ListCandidates retrieves the candidate element sets of a satellite along with the one selected.
+func (s *ElementSetService) ListCandidates(ctx context.Context, noradID string) (candidates domain.ElementSetCandidates, err error) {
+	ctx, span := tracing.NewSpan(ctx, "ListElementSetCandidates")
+	defer span.EndWithError(err)
+	return s.candidates(ctx, noradID)
+}
+
+// SetOverride pins an element set for propagation of its satellite, whatever the policy would select.
+func (s *ElementSetService) SetOverride(ctx context.Context, noradID string, tleID string, reason string) (candidates domain.ElementSetCandidates, err error) {
+	ctx, span := tracing.NewSpan(ctx, "SetElementSetOverride")
+	defer span.EndWithError(err)
+
+	tle, err := s.tleRepo.GetTleByID(ctx, tleID)
+	if err != nil {
+		return domain.ElementSetCandidates{}, err
+	}
+	if tle.NoradID != noradID {
+		return domain.ElementSetCandidates{}, fmt.Errorf("element set %s does not belong to NORAD ID %s", tleID, noradID)
+	}
+
+	override, err := domain.NewElementSetOverride(noradID, tleID, reason)
+	if err != nil {
+		return domain.ElementSetCandidates{}, err
+	}
+	if err := s.overrideRepo.Save(ctx, override); err != nil {
+		return domain.ElementSetCandidates{}, fmt.Errorf("failed to save element set override: %w", err)
+	}
+	return s.reselect(ctx, noradID)
+}
+
+// ClearOverride removes the override of a satellite, handing the selection back to the policy.
+func (s *ElementSetService) ClearOverride(ctx context.Context, noradID string) (candidates domain.ElementSetCandidates, err error) {
+	ctx, span := tracing.NewSpan(ctx, "ClearElementSetOverride")
+	defer span.EndWithError(err)
+
+	if err := s.overrideRepo.DeleteByNoradID(ctx, noradID); err != nil {
+		return domain.ElementSetCandidates{}, fmt.Errorf("failed to delete element set override: %w", err)
+	}
+	return s.reselect(ctx, noradID)
+}
+
+// reselect selects the current element set of a satellite and returns its candidates as stored afterwards.
+func (s *ElementSetService) reselect(ctx context.Context, noradID string) (domain.ElementSetCandidates, error) {
+	candidates, err := s.candidates(ctx, noradID)
+	if err != nil {
+		return domain.ElementSetCandidates{}, err
+	}
+	if len(candidates.Candidates) == 0 {
+		return candidates, nil
+	}
+	if _, err := s.selectCurrent(ctx, candidates); err != nil {
+		return domain.ElementSetCandidates{}, err
+	}
+	return s.candidates(ctx, noradID)
+}
+
+// candidates loads the newest element set of each source of a satellite, plus the pinned one when older.
+func (s *ElementSetService) candidates(ctx context.Context, noradID string) (domain.ElementSetCandidates, error) {
+	batch, err := s.candidatesOf(ctx, []string{noradID})
+	if err != nil {
+		return domain.ElementSetCandidates{}, err
+	}
+	return batch[noradID], nil
+}
+
+// candidatesOf loads the candidates of several satellites by NORAD ID.
+func (s *ElementSetService) candidatesOf(ctx context.Context, noradIDs []string) (map[string]domain.ElementSetCandidates, error) {
+	tles, err := s.tleRepo.GetLatestTlesPerSource(ctx, noradIDs)
+	if err != nil {
+		return nil, err
+	}
+	overrides, err := s.overrideRepo.FindByNoradIDs(ctx, noradIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := make(map[string]domain.ElementSetCandidates, len(noradIDs))
+	for _, noradID := range noradIDs {
+		candidates := domain.ElementSetCandidates{NoradID: noradID, Policy: s.policy, Candidates: tles[noradID]}
+		if override, ok := overrides[noradID]; ok && override.TleID != "" {
+			candidates.Override = &override
+			if !containsTle(candidates.Candidates, override.TleID) {
+				pinned, err := s.tleRepo.GetTleByID(ctx, override.TleID)
+				if err != nil {
+					log.Printf("Ignoring override of NORAD ID %s: %v", noradID, err)
+				} else {
+					candidates.Candidates = append(candidates.Candidates, pinned)
+				}
+			}
+		}
+		for _, tle := range candidates.Candidates {
+			if tle.Current {
+				candidates.CurrentID = tle.ID
+			}
+		}
+		batch[noradID] = candidates
+	}
+	return batch, nil
+}
+
+// selectCurrent applies the policy to the candidates, marks the selected element set as current and derives
+// the orbital parameters and regime of the satellite from it. It reports whether the selection changed.
+func (s *ElementSetService) selectCurrent(ctx context.Context, candidates domain.ElementSetCandidates) (bool, error) {
+	selected, changed, err := s.policySelection(candidates)
+	if err != nil || !changed {
+		return false, err
+	}
+	if err := s.tleRepo.SetCurrentTle(ctx, selected); err != nil {
+		return false, err
+	}
+	if err := s.updateOrbitalParameters(ctx, selected); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// policySelection applies the policy, or the override, to the candidates and reports whether the selected
+// element set differs from the current one.
+func (s *ElementSetService) policySelection(candidates domain.ElementSetCandidates) (domain.TLE, bool, error) {
+	pinnedID := ""
+	if candidates.Override != nil {
+		pinnedID = candidates.Override.TleID
+	}
+	selected, err := s.policy.Select(candidates.Candidates, pinnedID)
+	if err != nil {
+		return domain.TLE{}, false, err
+	}
+	return selected, !selected.Current, nil
+}
+
+// updateOrbitalParameters stores the orbital parameters and regime derived from the current element set.
+// An element set the parameters cannot be derived from is logged and skipped.
+func (s *ElementSetService) updateOrbitalParameters(ctx context.Context, tle domain.TLE) error {
+	params, err := domain.NewOrbitalParametersFromTLE(tle)
+	if err != nil {
+		log.Printf("Failed to derive orbital parameters for NORAD ID %s: %v", tle.NoradID, err)
+		return nil
+	}
+	if err := s.satelliteRepo.UpdateOrbitalParameters(ctx, tle.NoradID, params); err != nil {
+		return fmt.Errorf("failed to update orbital parameters for NORAD ID %s: %w", tle.NoradID, err)
+	}
+	return nil
+}
+
+func containsTle(tles []domain.TLE, id string) bool {
+	for _, tle := range tles {
+		if tle.ID == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	repository "github.com/Elbujito/2112/src/app-service/internal/repositories"
+	"github.com/Elbujito/2112/src/app-service/pkg/tracing"
+)
+
+// GeoService maintains the GEO belt catalog.
+type GeoService struct {
+	repo          domain.GeoRepository
+	satelliteRepo domain.SatelliteRepository
+	tleRepo       repository.TleRepository
+}
+
+// NewGeoService creates a new instance of GeoService.
+func NewGeoService(repo domain.GeoRepository, satelliteRepo domain.SatelliteRepository, tleRepo repository.TleRepository) GeoService {
+	return GeoService{repo: repo, satelliteRepo: satelliteRepo, tleRepo: tleRepo}
+}
+
+// UpdateGeoCatalog recomputes the GEO catalog from the TLE history of the geosynchronous satellites.
+func (s *GeoService) UpdateGeoCatalog(ctx context.Context, historyWindow time.Duration) (objects []domain.GeoObject, err error) {
+	ctx, span := tracing.NewSpan(ctx, "UpdateGeoCatalog")
+	defer span.EndWithError(err)
+
+	satellites, err := s.satelliteRepo.FindByOrbitRegime(ctx, domain.OrbitRegimeGEO)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch GEO satellites: %w", err)
+	}
+
+	since := time.Now().UTC().Add(-historyWindow)
+	var noradIDs []string
+	for _, satellite := range satellites {
+		tles, err := s.tleRepo.GetTleHistory(ctx, satellite.NoradID, since)
+		if err != nil {
+			return nil, err
+		}
+
+		var observations []domain.GeoObservation
+		for _, tle := range tles {
+			observation, err := domain.NewGeoObservationFromTLE(tle)
+			if err != nil {
+				log.Printf("Failed to compute GEO observation for NORAD ID %s: %v", satellite.NoradID, err)
+				continue
+			}
+			observations = append(observations, observation)
+		}
+
+		if err := s.repo.SaveObservations(ctx, observations); err != nil {
+			return nil, fmt.Errorf("failed to save GEO observations for NORAD ID %s: %w", satellite.NoradID, err)
+		}
+
+		history, err := s.repo.FindObservationsByNoradID(ctx, satellite.NoradID, since)
+		if err != nil {
+			return nil, err
+		}
+
+		object, err := domain.NewGeoObject(satellite, history)
+		if err != nil {
+			log.Printf("Skipping NORAD ID %s: %v", satellite.NoradID, err)
+			continue
+		}
+		objects = append(objects, object)
+		noradIDs = append(noradIDs, satellite.NoradID)
+	}
+
+	if err := s.repo.SaveBatch(ctx, objects); err != nil {
+		return nil, fmt.Errorf("failed to save GEO catalog: %w", err)
+	}
+
+	// Drop satellites that left the GEO regime
+	if err := s.repo.DeleteNotIn(ctx, noradIDs); err != nil {
+		return nil, fmt.Errorf("failed to prune GEO catalog: %w", err)
+	}
+
+	return objects, nil
+}
+
+// ListObjectsNearLongitude retrieves the GEO objects within tolerance degrees of a longitude.
+func (s *GeoService) ListObjectsNearLongitude(ctx context.Context, longitude float64, tolerance float64) (objects []domain.GeoObject, err error) {
+	ctx, span := tracing.NewSpan(ctx, "ListObjectsNearLongitude")
+	defer span.EndWithError(err)
+	if longitude < -180 || longitude > 180 {
+		return nil, fmt.Errorf("longitude must be between -180 and 180")
+	}
+	if tolerance <= 0 || tolerance > 180 {
+		return nil, fmt.Errorf("tolerance must be between 0 and 180")
+	}
+	return s.repo.FindNearLongitude(ctx, longitude, tolerance)
+}
+
+// GetObservations retrieves the longitude, drift and inclination history of a GEO object.
+func (s *GeoService) GetObservations(ctx context.Context, noradID string, since time.Time) (observations []domain.GeoObservation, err error) {
+	ctx, span := tracing.NewSpan(ctx, "GetObservations")
+	defer span.EndWithError(err)
+	if noradID == "" {
+		return nil, fmt.Errorf("NORAD ID is required")
+	}
+	return s.repo.FindObservationsByNoradID(ctx, noradID, since)
+}
@@ -0,0 +1,183 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	repository "github.com/Elbujito/2112/src/app-service/internal/repositories"
+	"github.com/Elbujito/2112/src/app-service/pkg/tracing"
+)
+
+// GeofenceService manages the geofences of contexts and predicts their boundary crossings.
+type GeofenceService struct {
+	repo        domain.GeofenceRepository
+	contextRepo domain.GameContextRepository
+	tleRepo     repository.TleRepository
+}
+
+// NewGeofenceService creates a new instance of GeofenceService.
+func NewGeofenceService(repo domain.GeofenceRepository, contextRepo domain.GameContextRepository, tleRepo repository.TleRepository) GeofenceService {
+	return GeofenceService{repo: repo, contextRepo: contextRepo, tleRepo: tleRepo}
+}
+
+// List retrieves the geofences of a context.
+func (s *GeofenceService) List(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID) (geofences []domain.Geofence, err error) {
+	ctx, span := tracing.NewSpan(ctx, "ListGeofences")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.FindAllByContext(ctx, gameContext.ID, gameContext.TenantID)
+}
+
+// Get retrieves a geofence of a context by ID.
+func (s *GeofenceService) Get(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, id string) (geofence domain.Geofence, err error) {
+	ctx, span := tracing.NewSpan(ctx, "GetGeofence")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return domain.Geofence{}, err
+	}
+	return s.repo.FindByID(ctx, gameContext.ID, gameContext.TenantID, id)
+}
+
+// Create adds a geofence to a context.
+func (s *GeofenceService) Create(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, input domain.Geofence) (geofence domain.Geofence, err error) {
+	ctx, span := tracing.NewSpan(ctx, "CreateGeofence")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return domain.Geofence{}, err
+	}
+
+	geofence, err = domain.NewGeofence(gameContext.ID, gameContext.TenantID, input.Name, input.Polygon, input.NoradIDs)
+	if err != nil {
+		return domain.Geofence{}, err
+	}
+
+	if err := s.repo.Save(ctx, geofence); err != nil {
+		return domain.Geofence{}, fmt.Errorf("failed to save geofence: %w", err)
+	}
+	return geofence, nil
+}
+
+// Update replaces the name, polygon and watched satellites of a geofence.
+// Events already predicted are kept until the next evaluation of each satellite.
+func (s *GeofenceService) Update(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, id string, input domain.Geofence) (geofence domain.Geofence, err error) {
+	ctx, span := tracing.NewSpan(ctx, "UpdateGeofence")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return domain.Geofence{}, err
+	}
+
+	geofence, err = s.repo.FindByID(ctx, gameContext.ID, gameContext.TenantID, id)
+	if err != nil {
+		return domain.Geofence{}, err
+	}
+
+	updated, err := domain.NewGeofence(gameContext.ID, gameContext.TenantID, input.Name, input.Polygon, input.NoradIDs)
+	if err != nil {
+		return domain.Geofence{}, err
+	}
+
+	nowUtc := time.Now().UTC()
+	geofence.Name = updated.Name
+	geofence.DisplayName = updated.Name
+	geofence.Polygon = updated.Polygon
+	geofence.NoradIDs = updated.NoradIDs
+	geofence.UpdatedAt = &nowUtc
+
+	if err := s.repo.Update(ctx, geofence); err != nil {
+		return domain.Geofence{}, err
+	}
+	return geofence, nil
+}
+
+// Delete removes a geofence and its events from a context.
+func (s *GeofenceService) Delete(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, id string) (err error) {
+	ctx, span := tracing.NewSpan(ctx, "DeleteGeofence")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return err
+	}
+	return s.repo.DeleteByID(ctx, gameContext.ID, gameContext.TenantID, id)
+}
+
+// EvaluateSatellite predicts the crossings of every geofence watching a satellite from its propagated positions
+// between start and end, and replaces the events previously predicted over that window.
+// It returns the evaluated geofences and the events that were not known before the evaluation.
+func (s *GeofenceService) EvaluateSatellite(ctx context.Context, noradID string, start time.Time, end time.Time) (geofences []domain.Geofence, newEvents []domain.GeofenceEvent, err error) {
+	ctx, span := tracing.NewSpan(ctx, "EvaluateGeofences")
+	defer span.EndWithError(err)
+
+	geofences, err = s.repo.FindByNoradID(ctx, noradID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(geofences) == 0 {
+		return nil, nil, nil
+	}
+
+	positions, err := s.tleRepo.QuerySatellitePositions(ctx, noradID, start, end)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query positions of %s: %w", noradID, err)
+	}
+
+	for _, geofence := range geofences {
+		previous, err := s.repo.FindEvents(ctx, geofence.ID, start, end)
+		if err != nil {
+			return nil, nil, err
+		}
+		known := make(map[string]bool, len(previous))
+		for _, event := range previous {
+			known[geofenceEventKey(event)] = true
+		}
+
+		events := domain.EvaluateGeofenceCrossings(geofence, noradID, positions)
+		if err := s.repo.ReplaceEvents(ctx, geofence.ID, noradID, start, end, events); err != nil {
+			return nil, nil, err
+		}
+		for _, event := range events {
+			if !known[geofenceEventKey(event)] {
+				newEvents = append(newEvents, event)
+			}
+		}
+	}
+	return geofences, newEvents, nil
+}
+
+// GetUpcomingCrossings retrieves the crossings of a geofence of a context from now until the given horizon.
+func (s *GeofenceService) GetUpcomingCrossings(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, id string, horizon time.Duration) (events []domain.GeofenceEvent, err error) {
+	ctx, span := tracing.NewSpan(ctx, "GetUpcomingGeofenceCrossings")
+	defer span.EndWithError(err)
+
+	geofence, err := s.Get(ctx, contextName, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.FindUpcomingCrossings(ctx, geofence.ID, horizon)
+}
+
+// FindUpcomingCrossings retrieves the crossings of a geofence from now until the given horizon, regardless of its context.
+func (s *GeofenceService) FindUpcomingCrossings(ctx context.Context, geofenceID string, horizon time.Duration) (events []domain.GeofenceEvent, err error) {
+	ctx, span := tracing.NewSpan(ctx, "FindUpcomingGeofenceCrossings")
+	defer span.EndWithError(err)
+
+	start := time.Now().UTC()
+	return s.repo.FindEvents(ctx, geofenceID, start, start.Add(horizon))
+}
+
+// geofenceEventKey identifies an event across evaluations of the same positions.
+func geofenceEventKey(event domain.GeofenceEvent) string {
+	return fmt.Sprintf("%s|%s|%d", event.NoradID, event.Kind, event.OccurredAt.Unix())
+}
@@ -0,0 +1,204 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	repository "github.com/Elbujito/2112/src/app-service/internal/repositories"
+	"github.com/Elbujito/2112/src/app-service/pkg/tracing"
+)
+
+const (
+	// passSearchStep is the sampling step used to detect visibility changes before refinement.
+	passSearchStep = 30 * time.Second
+	// interferenceStep is the sampling step used to find the closest approaches to the Sun and the Moon during a pass.
+	interferenceStep = 10 * time.Second
+)
+
+// GroundStationService manages ground stations and their pass predictions.
+type GroundStationService struct {
+	repo            domain.GroundStationRepository
+	contextRepo     domain.GameContextRepository
+	tleRepo         repository.TleRepository
+	transmitterRepo domain.TransmitterRepository
+}
+
+// NewGroundStationService creates a new instance of GroundStationService.
+func NewGroundStationService(repo domain.GroundStationRepository, contextRepo domain.GameContextRepository, tleRepo repository.TleRepository, transmitterRepo domain.TransmitterRepository) GroundStationService {
+	return GroundStationService{repo: repo, contextRepo: contextRepo, tleRepo: tleRepo, transmitterRepo: transmitterRepo}
+}
+
+// resolveTenantContext retrieves a context and checks that it belongs to the tenant, which is required.
+func resolveTenantContext(ctx context.Context, contextRepo domain.GameContextRepository, contextName domain.GameContextName, tenantID domain.TenantID) (domain.GameContext, error) {
+	if tenantID == "" {
+		return domain.GameContext{}, fmt.Errorf("a tenant is required to access context %s", contextName)
+	}
+	gameContext, err := resolveContext(ctx, contextRepo, contextName)
+	if err != nil {
+		return domain.GameContext{}, err
+	}
+	if gameContext.TenantID != tenantID {
+		return domain.GameContext{}, fmt.Errorf("context %s does not belong to tenant %s", contextName, tenantID)
+	}
+	return gameContext, nil
+}
+
+// resolveContext retrieves a context whatever its tenant. It is meant for internal callers only, the
+// requests of a tenant go through resolveTenantContext.
+func resolveContext(ctx context.Context, contextRepo domain.GameContextRepository, contextName domain.GameContextName) (domain.GameContext, error) {
+	gameContext, err := contextRepo.FindByUniqueName(ctx, contextName)
+	if err != nil {
+		return domain.GameContext{}, fmt.Errorf("failed to find context %s: %w", contextName, err)
+	}
+	return gameContext, nil
+}
+
+// List retrieves the ground stations of a context.
+func (s *GroundStationService) List(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID) (stations []domain.GroundStation, err error) {
+	ctx, span := tracing.NewSpan(ctx, "ListGroundStations")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.FindAllByContext(ctx, gameContext.ID, gameContext.TenantID)
+}
+
+// Get retrieves a ground station of a context by ID.
+func (s *GroundStationService) Get(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, id string) (station domain.GroundStation, err error) {
+	ctx, span := tracing.NewSpan(ctx, "GetGroundStation")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return domain.GroundStation{}, err
+	}
+	return s.repo.FindByID(ctx, gameContext.ID, gameContext.TenantID, id)
+}
+
+// Create adds a ground station to a context.
+func (s *GroundStationService) Create(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, input domain.GroundStation) (station domain.GroundStation, err error) {
+	ctx, span := tracing.NewSpan(ctx, "CreateGroundStation")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return domain.GroundStation{}, err
+	}
+
+	station, err = domain.NewGroundStation(
+		gameContext.ID,
+		gameContext.TenantID,
+		input.Name,
+		input.Latitude,
+		input.Longitude,
+		input.Altitude,
+		input.MinElevation,
+		input.HorizonMask,
+		input.Bands,
+		input.Refraction,
+	)
+	if err != nil {
+		return domain.GroundStation{}, err
+	}
+
+	if err := s.repo.Save(ctx, station); err != nil {
+		return domain.GroundStation{}, fmt.Errorf("failed to save ground station: %w", err)
+	}
+	return station, nil
+}
+
+// Update replaces the location, mask, bands and refraction conditions of a ground station.
+func (s *GroundStationService) Update(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, id string, input domain.GroundStation) (station domain.GroundStation, err error) {
+	ctx, span := tracing.NewSpan(ctx, "UpdateGroundStation")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return domain.GroundStation{}, err
+	}
+
+	station, err = s.repo.FindByID(ctx, gameContext.ID, gameContext.TenantID, id)
+	if err != nil {
+		return domain.GroundStation{}, err
+	}
+
+	nowUtc := time.Now().UTC()
+	station.Name = input.Name
+	station.DisplayName = input.Name
+	station.Latitude = input.Latitude
+	station.Longitude = input.Longitude
+	station.Altitude = input.Altitude
+	station.MinElevation = input.MinElevation
+	station.HorizonMask = input.HorizonMask
+	station.Bands = input.Bands
+	station.Refraction = input.Refraction
+	station.UpdatedAt = &nowUtc
+	if err := station.Validate(); err != nil {
+		return domain.GroundStation{}, err
+	}
+
+	if err := s.repo.Update(ctx, station); err != nil {
+		return domain.GroundStation{}, err
+	}
+	return station, nil
+}
+
+// Delete removes a ground station from a context.
+func (s *GroundStationService) Delete(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, id string) (err error) {
+	ctx, span := tracing.NewSpan(ctx, "DeleteGroundStation")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return err
+	}
+	return s.repo.DeleteByID(ctx, gameContext.ID, gameContext.TenantID, id)
+}
+
+// PredictPasses computes the passes of a satellite over a ground station between start and end.
+// The minimum elevation and terrain mask of the station are honored. When interferenceDeg is positive,
+// passes coming within that many degrees of the Sun or the Moon are flagged. Each pass lists the active
+// transmitters of the satellite the station can listen to with its bands.
+func (s *GroundStationService) PredictPasses(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, stationID string, noradID string, start time.Time, end time.Time, interferenceDeg float64) (passes []domain.GroundStationPass, err error) {
+	ctx, span := tracing.NewSpan(ctx, "PredictGroundStationPasses")
+	defer span.EndWithError(err)
+
+	station, err := s.Get(ctx, contextName, tenantID, stationID)
+	if err != nil {
+		return nil, err
+	}
+
+	tle, err := s.tleRepo.GetTle(ctx, noradID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch TLE for NORAD ID %s: %w", noradID, err)
+	}
+
+	passes, err = station.PredictPasses(tle, start, end, passSearchStep)
+	if err != nil {
+		return nil, err
+	}
+	if interferenceDeg > 0 {
+		if err := station.FlagInterference(tle, passes, interferenceDeg, interferenceStep); err != nil {
+			return nil, err
+		}
+	}
+
+	transmitters, err := s.transmitterRepo.FindByNoradID(ctx, noradID)
+	if err != nil {
+		return nil, err
+	}
+	var listenable []domain.Transmitter
+	for _, transmitter := range transmitters {
+		if transmitter.Status == domain.TransmitterActive && transmitter.ListenableWith(station.Bands) {
+			listenable = append(listenable, transmitter)
+		}
+	}
+	for i := range passes {
+		passes[i].Transmitters = listenable
+	}
+	return passes, nil
+}
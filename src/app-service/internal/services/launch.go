@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"github.com/Elbujito/2112/src/app-service/pkg/tracing"
+)
+
+// LaunchService groups the catalog by launch and flags the fragmentation of launches.
+type LaunchService struct {
+	repo              domain.LaunchRepository
+	fragmentationRepo domain.FragmentationEventRepository
+}
+
+// NewLaunchService creates a new instance of LaunchService.
+func NewLaunchService(repo domain.LaunchRepository, fragmentationRepo domain.FragmentationEventRepository) LaunchService {
+	return LaunchService{repo: repo, fragmentationRepo: fragmentationRepo}
+}
+
+// ListLaunches retrieves the launches of a year, or of all years when year is zero, most recent first.
+func (s *LaunchService) ListLaunches(ctx context.Context, year int, page int, pageSize int) (launches []domain.Launch, count int64, err error) {
+	ctx, span := tracing.NewSpan(ctx, "ListLaunches")
+	defer span.EndWithError(err)
+
+	if page <= 0 {
+		return nil, 0, fmt.Errorf("page must be greater than 0")
+	}
+	if pageSize <= 0 {
+		return nil, 0, fmt.Errorf("pageSize must be greater than 0")
+	}
+	return s.repo.FindLaunches(ctx, year, page, pageSize)
+}
+
+// GetLaunch retrieves the summary and the objects of the launch of a designator, for example "1999-025".
+func (s *LaunchService) GetLaunch(ctx context.Context, designator string) (launch domain.Launch, objects []domain.Satellite, err error) {
+	ctx, span := tracing.NewSpan(ctx, "GetLaunch")
+	defer span.EndWithError(err)
+
+	cospar, err := domain.ParseCosparID(designator)
+	if err != nil {
+		return domain.Launch{}, nil, err
+	}
+
+	launch, err = s.repo.FindLaunch(ctx, cospar.Year, cospar.LaunchNumber)
+	if err != nil {
+		return domain.Launch{}, nil, err
+	}
+	if launch.Objects == 0 {
+		return domain.Launch{}, nil, fmt.Errorf("%w: %s", domain.ErrLaunchNotFound, cospar.Launch())
+	}
+
+	objects, err = s.repo.FindLaunchObjects(ctx, cospar.Year, cospar.LaunchNumber)
+	if err != nil {
+		return domain.Launch{}, nil, err
+	}
+	return launch, objects, nil
+}
+
+// ListFragmentations retrieves the fragmentation events detected within the given period, most recent first.
+func (s *LaunchService) ListFragmentations(ctx context.Context, period time.Duration) (events []domain.FragmentationEvent, err error) {
+	ctx, span := tracing.NewSpan(ctx, "ListFragmentations")
+	defer span.EndWithError(err)
+	return s.fragmentationRepo.FindSince(ctx, time.Now().UTC().Add(-period))
+}
+
+// DetectFragmentations flags the launches with many pieces cataloged within the policy window. An event
+// still open for a launch, that is whose window overlaps the current one, is updated rather than duplicated.
+// The returned events are the created and grown ones.
+func (s *LaunchService) DetectFragmentations(ctx context.Context, policy domain.FragmentationPolicy) (events []domain.FragmentationEvent, err error) {
+	ctx, span := tracing.NewSpan(ctx, "DetectFragmentations")
+	defer span.EndWithError(err)
+
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	at := time.Now().UTC()
+	since := at.Add(-policy.Window)
+	launches, err := s.repo.FindLaunchesWithNewPieces(ctx, since, policy.MinPieces)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cospar := range launches {
+		objects, err := s.repo.FindLaunchObjects(ctx, cospar.Year, cospar.LaunchNumber)
+		if err != nil {
+			return nil, err
+		}
+		event, ok := domain.DetectFragmentation(cospar, objects, policy, at)
+		if !ok {
+			continue
+		}
+
+		open, err := s.fragmentationRepo.FindOpenByLaunch(ctx, event.Launch, since)
+		if err != nil {
+			return nil, err
+		}
+		if open.ID != "" {
+			if open.NewPieces >= event.NewPieces {
+				continue
+			}
+			event.ID = open.ID
+			event.CreatedAt = open.CreatedAt
+		}
+
+		if err := s.fragmentationRepo.Save(ctx, event); err != nil {
+			return nil, fmt.Errorf("failed to save fragmentation event of launch %s: %w", event.Launch, err)
+		}
+		events = append(events, event)
+	}
+
+	log.Printf("Checked %d launches with new pieces, flagged %d fragmentation events", len(launches), len(events))
+	return events, nil
+}
@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	repository "github.com/Elbujito/2112/src/app-service/internal/repositories"
+	"github.com/Elbujito/2112/src/app-service/pkg/tracing"
+)
+
+// linkSearchStep is the sampling step used to detect link changes before refinement.
+const linkSearchStep = time.Minute
+
+// LinkService computes inter-satellite link windows within a context.
+type LinkService struct {
+	repo          domain.LinkWindowRepository
+	contextRepo   domain.GameContextRepository
+	satelliteRepo domain.SatelliteRepository
+	tleRepo       repository.TleRepository
+}
+
+// NewLinkService creates a new instance of LinkService.
+func NewLinkService(repo domain.LinkWindowRepository, contextRepo domain.GameContextRepository, satelliteRepo domain.SatelliteRepository, tleRepo repository.TleRepository) LinkService {
+	return LinkService{repo: repo, contextRepo: contextRepo, satelliteRepo: satelliteRepo, tleRepo: tleRepo}
+}
+
+// ComputeLinkWindows computes and stores the link windows of every satellite pair of a context between start and end.
+func (s *LinkService) ComputeLinkWindows(ctx context.Context, contextName domain.GameContextName, grazingAltitudeKm float64, maxRangeKm float64, start time.Time, end time.Time) (windows []domain.LinkWindow, err error) {
+	ctx, span := tracing.NewSpan(ctx, "ComputeLinkWindows")
+	defer span.EndWithError(err)
+
+	gameContext, err := s.contextRepo.FindByUniqueName(ctx, contextName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find context %s: %w", contextName, err)
+	}
+
+	satellites, err := s.satelliteRepo.FindSatellitesByContext(ctx, gameContext.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch satellites of context %s: %w", contextName, err)
+	}
+
+	var tles []domain.TLE
+	for _, satellite := range satellites {
+		tle, err := s.tleRepo.GetTle(ctx, satellite.NoradID)
+		if err != nil {
+			log.Printf("Skipping NORAD ID %s in link computation: %v", satellite.NoradID, err)
+			continue
+		}
+		tles = append(tles, tle)
+	}
+
+	for i := 0; i < len(tles); i++ {
+		for j := i + 1; j < len(tles); j++ {
+			pairWindows, err := domain.NewLinkWindows(gameContext.ID, tles[i], tles[j], grazingAltitudeKm, maxRangeKm, start, end, linkSearchStep)
+			if err != nil {
+				log.Printf("Skipping link %s-%s: %v", tles[i].NoradID, tles[j].NoradID, err)
+				continue
+			}
+			windows = append(windows, pairWindows...)
+		}
+	}
+
+	if err := s.repo.ReplaceForContext(ctx, gameContext.ID, start, end, windows); err != nil {
+		return nil, err
+	}
+	return windows, nil
+}
+
+// ListLinkWindows retrieves the stored windows of a context overlapping [from, until], optionally for a single satellite.
+func (s *LinkService) ListLinkWindows(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, noradID string, from time.Time, until time.Time) (windows []domain.LinkWindow, err error) {
+	ctx, span := tracing.NewSpan(ctx, "ListLinkWindows")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if noradID != "" {
+		return s.repo.FindByNoradID(ctx, gameContext.ID, noradID, from, until)
+	}
+	return s.repo.FindByContext(ctx, gameContext.ID, from, until)
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	repository "github.com/Elbujito/2112/src/app-service/internal/repositories"
+	"github.com/Elbujito/2112/src/app-service/pkg/tracing"
+)
+
+// lightingSampleStep is the interval between two samples of the orbit lighting time series.
+const lightingSampleStep = 24 * time.Hour
+
+// OrbitLightingService tracks the node local times, beta angle and eclipse fraction of satellites.
+type OrbitLightingService struct {
+	repo          domain.OrbitLightingRepository
+	satelliteRepo domain.SatelliteRepository
+	tleRepo       repository.TleRepository
+}
+
+// NewOrbitLightingService creates a new instance of OrbitLightingService.
+func NewOrbitLightingService(repo domain.OrbitLightingRepository, satelliteRepo domain.SatelliteRepository, tleRepo repository.TleRepository) OrbitLightingService {
+	return OrbitLightingService{repo: repo, satelliteRepo: satelliteRepo, tleRepo: tleRepo}
+}
+
+// UpdateLightingSeries samples the orbit lighting of every satellite of a regime from today over the given number of days.
+// Samples are aligned on UTC days, so that daily runs extend the series and refresh the forecast with the latest TLE.
+func (s *OrbitLightingService) UpdateLightingSeries(ctx context.Context, regime domain.OrbitRegime, days int) (count int, err error) {
+	ctx, span := tracing.NewSpan(ctx, "UpdateLightingSeries")
+	defer span.EndWithError(err)
+
+	satellites, err := s.satelliteRepo.FindByOrbitRegime(ctx, regime)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch %s satellites: %w", regime, err)
+	}
+
+	start := time.Now().UTC().Truncate(24 * time.Hour)
+	end := start.Add(time.Duration(days) * lightingSampleStep)
+	for _, satellite := range satellites {
+		tle, err := s.tleRepo.GetTle(ctx, satellite.NoradID)
+		if err != nil {
+			log.Printf("Skipping orbit lighting for NORAD ID %s: %v", satellite.NoradID, err)
+			continue
+		}
+
+		samples, err := domain.NewOrbitLightingSeries(tle, start, end, lightingSampleStep)
+		if err != nil {
+			log.Printf("Skipping orbit lighting for NORAD ID %s: %v", satellite.NoradID, err)
+			continue
+		}
+
+		if err := s.repo.SaveBatch(ctx, samples); err != nil {
+			return count, fmt.Errorf("failed to save orbit lighting for NORAD ID %s: %w", satellite.NoradID, err)
+		}
+		count += len(samples)
+	}
+	return count, nil
+}
+
+// GetLightingSeries retrieves the orbit lighting time series of a satellite between from and until.
+func (s *OrbitLightingService) GetLightingSeries(ctx context.Context, noradID string, from time.Time, until time.Time) (samples []domain.OrbitLightingSample, err error) {
+	ctx, span := tracing.NewSpan(ctx, "GetLightingSeries")
+	defer span.EndWithError(err)
+	return s.repo.FindByNoradID(ctx, noradID, from, until)
+}
@@ -0,0 +1,146 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	repository "github.com/Elbujito/2112/src/app-service/internal/repositories"
+	"github.com/Elbujito/2112/src/app-service/pkg/tracing"
+)
+
+// overflightHistoryMargin is how long before the report window element sets are looked up, so that a window
+// between two element sets can still use the one preceding it.
+const overflightHistoryMargin = 7 * 24 * time.Hour
+
+// OverflightService reports the overflights of admin areas by the satellites of a context.
+type OverflightService struct {
+	repo          domain.OverflightReportRepository
+	areaRepo      domain.AdminAreaRepository
+	contextRepo   domain.GameContextRepository
+	satelliteRepo domain.SatelliteRepository
+	tleRepo       repository.TleRepository
+}
+
+// NewOverflightService creates a new instance of OverflightService.
+func NewOverflightService(repo domain.OverflightReportRepository, areaRepo domain.AdminAreaRepository, contextRepo domain.GameContextRepository, satelliteRepo domain.SatelliteRepository, tleRepo repository.TleRepository) OverflightService {
+	return OverflightService{repo: repo, areaRepo: areaRepo, contextRepo: contextRepo, satelliteRepo: satelliteRepo, tleRepo: tleRepo}
+}
+
+// ComputeReport intersects the ground tracks of the context satellites with the admin areas between start and end
+// and stores the resulting report. When noradIDs is not empty, only those satellites of the context are reported.
+// Each satellite is propagated from its element set whose epoch is closest to the middle of the window, so that
+// past windows are not propagated backwards from today's element set.
+func (s *OverflightService) ComputeReport(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, areaCodes []string, noradIDs []string, start time.Time, end time.Time, step time.Duration) (report domain.OverflightReport, err error) {
+	ctx, span := tracing.NewSpan(ctx, "ComputeOverflightReport")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return domain.OverflightReport{}, err
+	}
+
+	areas, err := s.areaRepo.FindByCodes(ctx, areaCodes)
+	if err != nil {
+		return domain.OverflightReport{}, err
+	}
+	if len(areas) != len(areaCodes) {
+		log.Printf("Found %d of the %d requested admin areas, missing codes are ignored", len(areas), len(areaCodes))
+	}
+
+	satellites, err := s.satelliteRepo.FindSatellitesByContext(ctx, gameContext.ID)
+	if err != nil {
+		return domain.OverflightReport{}, fmt.Errorf("failed to fetch satellites of context %s: %w", contextName, err)
+	}
+	if len(noradIDs) > 0 {
+		wanted := make(map[string]bool, len(noradIDs))
+		for _, noradID := range noradIDs {
+			wanted[noradID] = true
+		}
+		var filtered []domain.Satellite
+		for _, satellite := range satellites {
+			if wanted[satellite.NoradID] {
+				filtered = append(filtered, satellite)
+			}
+		}
+		satellites = filtered
+	}
+
+	middle := start.Add(end.Sub(start) / 2)
+	var tles []domain.TLE
+	for _, satellite := range satellites {
+		tle, err := s.closestTle(ctx, satellite.NoradID, start.Add(-overflightHistoryMargin), middle)
+		if err != nil {
+			log.Printf("Skipping NORAD ID %s in overflight report: %v", satellite.NoradID, err)
+			continue
+		}
+		tles = append(tles, tle)
+	}
+
+	report, err = domain.NewOverflightReport(gameContext.ID, gameContext.TenantID, areas, satellites, tles, start, end, step)
+	if err != nil {
+		return domain.OverflightReport{}, err
+	}
+	if err := s.repo.Save(ctx, report); err != nil {
+		return domain.OverflightReport{}, err
+	}
+	return report, nil
+}
+
+// closestTle returns the element set of a satellite whose epoch is closest to the given time among those stored
+// since the lookup start. Without any, the current element set predates the lookup and is the closest.
+func (s *OverflightService) closestTle(ctx context.Context, noradID string, since time.Time, at time.Time) (domain.TLE, error) {
+	history, err := s.tleRepo.GetTleHistory(ctx, noradID, since)
+	if err != nil {
+		return domain.TLE{}, err
+	}
+	if tle, ok := domain.ClosestTLE(history, at); ok {
+		return tle, nil
+	}
+	return s.tleRepo.GetTle(ctx, noradID)
+}
+
+// ListReports retrieves the overflight reports of a context without their records.
+func (s *OverflightService) ListReports(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID) (reports []domain.OverflightReport, err error) {
+	ctx, span := tracing.NewSpan(ctx, "ListOverflightReports")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.FindAllByContext(ctx, gameContext.ID, gameContext.TenantID)
+}
+
+// GetReport retrieves an overflight report of a context with its records.
+func (s *OverflightService) GetReport(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, id string) (report domain.OverflightReport, err error) {
+	ctx, span := tracing.NewSpan(ctx, "GetOverflightReport")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return domain.OverflightReport{}, err
+	}
+	return s.repo.FindByID(ctx, gameContext.ID, gameContext.TenantID, id)
+}
+
+// DeleteReport removes an overflight report from a context.
+func (s *OverflightService) DeleteReport(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, id string) (err error) {
+	ctx, span := tracing.NewSpan(ctx, "DeleteOverflightReport")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return err
+	}
+	return s.repo.DeleteByID(ctx, gameContext.ID, gameContext.TenantID, id)
+}
+
+// ListAreas retrieves the admin areas of a level loaded from the boundary datasets.
+func (s *OverflightService) ListAreas(ctx context.Context, level int) (areas []domain.AdminArea, err error) {
+	ctx, span := tracing.NewSpan(ctx, "ListAdminAreas")
+	defer span.EndWithError(err)
+	return s.areaRepo.FindByLevel(ctx, level)
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+// ensureSatellites creates the missing satellites of the TLEs, named after line 0 of their latest element set,
+// and renames the existing ones whose latest element set carries another name.
+func ensureSatellites(ctx context.Context, satelliteRepo domain.SatelliteRepository, nameRepo domain.SatelliteNameChangeRepository, tles []domain.TLE) error {
+	latest := domain.LatestTLEByNoradID(tles)
+	if len(latest) == 0 {
+		return nil
+	}
+	noradIDs := make([]string, 0, len(latest))
+	names := make(map[string]string, len(latest))
+	for noradID, tle := range latest {
+		noradIDs = append(noradIDs, noradID)
+		names[noradID] = tle.Name
+	}
+
+	stored, err := satelliteRepo.FindByNoradIDs(ctx, noradIDs)
+	if err != nil {
+		return fmt.Errorf("failed to load satellites: %w", err)
+	}
+	existing := make(map[string]bool, len(stored))
+	for _, satellite := range stored {
+		existing[satellite.NoradID] = true
+	}
+
+	nowUtc := time.Now().UTC()
+	var missing []domain.Satellite
+	for _, noradID := range noradIDs {
+		if existing[noradID] {
+			continue
+		}
+		name := names[noradID]
+		if name == "" {
+			name = domain.UnknownSatelliteName(noradID)
+		}
+		satellite, err := domain.NewSatellite(
+			name,
+			noradID,
+			domain.Active,
+			false, // not in favourite
+			true,  // active by default
+			nowUtc,
+		)
+		if err != nil {
+			return err
+		}
+		missing = append(missing, satellite)
+	}
+	if err := satelliteRepo.SaveBatch(ctx, missing); err != nil {
+		return fmt.Errorf("failed to create satellites: %w", err)
+	}
+
+	return renameSatellites(ctx, satelliteRepo, nameRepo, stored, names)
+}
+
+// renameSatellites gives the stored satellites the name found for their NORAD ID in names, when not empty and
+// different, and records each change. Replacing a placeholder or empty name is not recorded as a change.
+func renameSatellites(ctx context.Context, satelliteRepo domain.SatelliteRepository, nameRepo domain.SatelliteNameChangeRepository, stored []domain.Satellite, names map[string]string) error {
+	nowUtc := time.Now().UTC()
+	for _, satellite := range stored {
+		name := names[satellite.NoradID]
+		if name == "" || name == satellite.Name {
+			continue
+		}
+
+		if satellite.Name != "" && satellite.Name != domain.UnknownSatelliteName(satellite.NoradID) {
+			change, err := domain.NewSatelliteNameChange(satellite.NoradID, satellite.Name, name, nowUtc)
+			if err != nil {
+				return err
+			}
+			if err := nameRepo.Save(ctx, change); err != nil {
+				return fmt.Errorf("failed to record name change of satellite %s: %w", satellite.NoradID, err)
+			}
+			log.Printf("Satellite %s renamed from %s to %s", satellite.NoradID, satellite.Name, name)
+		}
+
+		if err := satelliteRepo.UpdateName(ctx, satellite.NoradID, name); err != nil {
+			return fmt.Errorf("failed to rename satellite %s: %w", satellite.NoradID, err)
+		}
+	}
+	return nil
+}
+
+// catalogNames returns the names of catalog entries by NORAD ID.
+func catalogNames(entries []domain.Satellite) map[string]string {
+	names := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		names[entry.NoradID] = entry.Name
+	}
+	return names
+}
+
+// catalogNoradIDs returns the NORAD IDs of catalog entries.
+func catalogNoradIDs(entries []domain.Satellite) []string {
+	noradIDs := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		noradIDs = append(noradIDs, entry.NoradID)
+	}
+	return noradIDs
+}
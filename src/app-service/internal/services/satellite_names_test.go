@@ -0,0 +1,193 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	repository "github.com/Elbujito/2112/src/app-service/internal/repositories"
+)
+
+// fakeCatalogSatelliteRepository keeps satellites by NORAD ID and records the renames.
+type fakeCatalogSatelliteRepository struct {
+	domain.SatelliteRepository
+	satellites map[string]domain.Satellite
+	created    []domain.Satellite
+	renamed    []string
+}
+
+func newFakeCatalogSatelliteRepository(satellites ...domain.Satellite) *fakeCatalogSatelliteRepository {
+	r := &fakeCatalogSatelliteRepository{satellites: make(map[string]domain.Satellite)}
+	for _, satellite := range satellites {
+		r.satellites[satellite.NoradID] = satellite
+	}
+	return r
+}
+
+func (r *fakeCatalogSatelliteRepository) FindByNoradIDs(ctx context.Context, noradIDs []string) ([]domain.Satellite, error) {
+	var found []domain.Satellite
+	for _, noradID := range noradIDs {
+		if satellite, ok := r.satellites[noradID]; ok {
+			found = append(found, satellite)
+		}
+	}
+	return found, nil
+}
+
+func (r *fakeCatalogSatelliteRepository) SaveBatch(ctx context.Context, satellites []domain.Satellite) error {
+	for _, satellite := range satellites {
+		r.satellites[satellite.NoradID] = satellite
+		r.created = append(r.created, satellite)
+	}
+	return nil
+}
+
+func (r *fakeCatalogSatelliteRepository) UpdateName(ctx context.Context, noradID string, name string) error {
+	satellite := r.satellites[noradID]
+	satellite.Name = name
+	r.satellites[noradID] = satellite
+	r.renamed = append(r.renamed, noradID)
+	return nil
+}
+
+// fakeSatelliteNameChangeRepository keeps the name changes in memory, oldest first.
+type fakeSatelliteNameChangeRepository struct {
+	changes []domain.SatelliteNameChange
+}
+
+func (r *fakeSatelliteNameChangeRepository) Save(ctx context.Context, change domain.SatelliteNameChange) error {
+	r.changes = append(r.changes, change)
+	return nil
+}
+
+func (r *fakeSatelliteNameChangeRepository) FindByNoradID(ctx context.Context, noradID string) ([]domain.SatelliteNameChange, error) {
+	var changes []domain.SatelliteNameChange
+	for _, change := range r.changes {
+		if change.NoradID == noradID {
+			changes = append(changes, change)
+		}
+	}
+	return changes, nil
+}
+
+func namedSatellite(t *testing.T, noradID string, name string) domain.Satellite {
+	t.Helper()
+	satellite, err := domain.NewSatellite(name, noradID, domain.Active, false, true, time.Now().UTC())
+	if err != nil {
+		t.Fatalf("NewSatellite(%s) failed: %v", noradID, err)
+	}
+	return satellite
+}
+
+func namedTLE(noradID string, name string, epoch time.Time) domain.TLE {
+	return domain.TLE{NoradID: noradID, Name: name, Epoch: epoch}
+}
+
+func TestEnsureSatellitesRecordsRename(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeCatalogSatelliteRepository(namedSatellite(t, "25544", "ISS (ZARYA)"))
+	nameRepo := &fakeSatelliteNameChangeRepository{}
+	service := NewSatelliteService(repository.TleRepository{}, nil, nil, repo, nameRepo)
+
+	epoch := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	tles := []domain.TLE{
+		namedTLE("25544", "ISS", epoch),
+		namedTLE("25544", "ISS (ZARYA)", epoch.Add(-24*time.Hour)),
+	}
+	if err := service.EnsureSatellites(ctx, tles); err != nil {
+		t.Fatalf("EnsureSatellites failed: %v", err)
+	}
+
+	if got := repo.satellites["25544"].Name; got != "ISS" {
+		t.Errorf("expected satellite renamed after the latest element set, got %q", got)
+	}
+	history, err := service.GetNameHistory(ctx, "25544")
+	if err != nil {
+		t.Fatalf("GetNameHistory failed: %v", err)
+	}
+	if len(history) != 1 {
+		t.Fatalf("expected 1 name change, got %d", len(history))
+	}
+	if history[0].OldName != "ISS (ZARYA)" || history[0].NewName != "ISS" {
+		t.Errorf("expected ISS (ZARYA) -> ISS, got %s -> %s", history[0].OldName, history[0].NewName)
+	}
+	if history[0].ChangedAt.IsZero() {
+		t.Error("expected the change time to be set")
+	}
+
+	if history, _ := service.GetNameHistory(ctx, "43013"); len(history) != 0 {
+		t.Errorf("expected no history for another satellite, got %d changes", len(history))
+	}
+}
+
+func TestEnsureSatellitesNames(t *testing.T) {
+	epoch := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		stored      []domain.Satellite
+		tle         domain.TLE
+		wantName    string
+		wantCreated int
+		wantRenamed int
+		wantChanges int
+	}{
+		{
+			name:     "Same name writes nothing",
+			stored:   []domain.Satellite{namedSatellite(t, "25544", "ISS (ZARYA)")},
+			tle:      namedTLE("25544", "ISS (ZARYA)", epoch),
+			wantName: "ISS (ZARYA)",
+		},
+		{
+			name:     "Two line element set keeps the name",
+			stored:   []domain.Satellite{namedSatellite(t, "25544", "ISS (ZARYA)")},
+			tle:      namedTLE("25544", "", epoch),
+			wantName: "ISS (ZARYA)",
+		},
+		{
+			name:        "Placeholder replaced without a change",
+			stored:      []domain.Satellite{namedSatellite(t, "25544", domain.UnknownSatelliteName("25544"))},
+			tle:         namedTLE("25544", "ISS (ZARYA)", epoch),
+			wantName:    "ISS (ZARYA)",
+			wantRenamed: 1,
+		},
+		{
+			name:        "Missing satellite created with its name",
+			tle:         namedTLE("25544", "ISS (ZARYA)", epoch),
+			wantName:    "ISS (ZARYA)",
+			wantCreated: 1,
+		},
+		{
+			name:        "Missing satellite without a name gets a placeholder",
+			tle:         namedTLE("25544", "", epoch),
+			wantName:    domain.UnknownSatelliteName("25544"),
+			wantCreated: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := newFakeCatalogSatelliteRepository(tt.stored...)
+			nameRepo := &fakeSatelliteNameChangeRepository{}
+			service := NewSatelliteService(repository.TleRepository{}, nil, nil, repo, nameRepo)
+
+			if err := service.EnsureSatellites(ctx, []domain.TLE{tt.tle}); err != nil {
+				t.Fatalf("EnsureSatellites failed: %v", err)
+			}
+			if got := repo.satellites[tt.tle.NoradID].Name; got != tt.wantName {
+				t.Errorf("expected name %q, got %q", tt.wantName, got)
+			}
+			if len(repo.created) != tt.wantCreated {
+				t.Errorf("expected %d satellites created, got %d", tt.wantCreated, len(repo.created))
+			}
+			if len(repo.renamed) != tt.wantRenamed {
+				t.Errorf("expected %d renames, got %d", tt.wantRenamed, len(repo.renamed))
+			}
+			if len(nameRepo.changes) != tt.wantChanges {
+				t.Errorf("expected %d name changes, got %d", tt.wantChanges, len(nameRepo.changes))
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"github.com/Elbujito/2112/src/app-service/pkg/tracing"
+)
+
+// SatelliteTagService manages satellite tags. Tags are populated from the groups of the element set
+// sources, such as the CelesTrak categories, and edited by users.
+type SatelliteTagService struct {
+	repo          domain.SatelliteTagRepository
+	satelliteRepo domain.SatelliteRepository
+	contextRepo   domain.GameContextRepository
+}
+
+// NewSatelliteTagService creates a new instance of SatelliteTagService.
+func NewSatelliteTagService(repo domain.SatelliteTagRepository, satelliteRepo domain.SatelliteRepository, contextRepo domain.GameContextRepository) SatelliteTagService {
+	return SatelliteTagService{repo: repo, satelliteRepo: satelliteRepo, contextRepo: contextRepo}
+}
+
+// ListTags retrieves all tags.
+func (s *SatelliteTagService) ListTags(ctx context.Context) (tags []domain.SatelliteTag, err error) {
+	ctx, span := tracing.NewSpan(ctx, "ListSatelliteTags")
+	defer span.EndWithError(err)
+	return s.repo.FindAll(ctx)
+}
+
+// CreateTag creates a tag, or updates the description of an existing tag of the same name.
+func (s *SatelliteTagService) CreateTag(ctx context.Context, name string, description string) (tag domain.SatelliteTag, err error) {
+	ctx, span := tracing.NewSpan(ctx, "CreateSatelliteTag")
+	defer span.EndWithError(err)
+
+	tag, err = domain.NewSatelliteTag(name, description)
+	if err != nil {
+		return domain.SatelliteTag{}, err
+	}
+	return s.repo.Save(ctx, tag)
+}
+
+// DeleteTag removes a tag from all its satellites and deletes it.
+func (s *SatelliteTagService) DeleteTag(ctx context.Context, name string) (err error) {
+	ctx, span := tracing.NewSpan(ctx, "DeleteSatelliteTag")
+	defer span.EndWithError(err)
+	return s.repo.DeleteByName(ctx, name)
+}
+
+// GetSatelliteTags retrieves the tags of a satellite.
+func (s *SatelliteTagService) GetSatelliteTags(ctx context.Context, noradID string) (tags []domain.SatelliteTag, err error) {
+	ctx, span := tracing.NewSpan(ctx, "GetSatelliteTags")
+	defer span.EndWithError(err)
+	return s.repo.FindByNoradID(ctx, noradID)
+}
+
+// ListSatellitesByTags retrieves the satellites having any of the tags.
+func (s *SatelliteTagService) ListSatellitesByTags(ctx context.Context, tags []string) (satellites []domain.Satellite, err error) {
+	ctx, span := tracing.NewSpan(ctx, "ListSatellitesByTags")
+	defer span.EndWithError(err)
+	return s.satelliteRepo.FindByTags(ctx, tags)
+}
+
+// TagSatellites tags satellites on behalf of a user, creating the tag when missing. The NORAD IDs of no
+// cataloged satellite are left out and returned.
+func (s *SatelliteTagService) TagSatellites(ctx context.Context, name string, noradIDs []string) (tag domain.SatelliteTag, unknown []string, err error) {
+	ctx, span := tracing.NewSpan(ctx, "TagSatellites")
+	defer span.EndWithError(err)
+
+	satellites, err := s.satelliteRepo.FindByNoradIDs(ctx, noradIDs)
+	if err != nil {
+		return domain.SatelliteTag{}, nil, fmt.Errorf("failed to find satellites to tag: %w", err)
+	}
+	known := make(map[string]bool, len(satellites))
+	for _, satellite := range satellites {
+		known[satellite.NoradID] = true
+	}
+	unknown = []string{}
+	for _, noradID := range noradIDs {
+		if !known[noradID] {
+			unknown = append(unknown, noradID)
+		}
+	}
+
+	tag, err = s.tag(ctx, name, noradIDs, domain.TagOriginUser)
+	return tag, unknown, err
+}
+
+// UntagSatellites removes a tag from satellites.
+func (s *SatelliteTagService) UntagSatellites(ctx context.Context, name string, noradIDs []string) (err error) {
+	ctx, span := tracing.NewSpan(ctx, "UntagSatellites")
+	defer span.EndWithError(err)
+
+	tag, err := s.repo.FindByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	return s.repo.UntagSatellites(ctx, tag.ID, noradIDs)
+}
+
+// TagFromSourceGroup tags the satellites fetched from a group of an element set source with the group name.
+// When the fetch holds the complete group, the satellites that left it lose the tag, unless a user set it.
+func (s *SatelliteTagService) TagFromSourceGroup(ctx context.Context, group string, noradIDs []string, complete bool) (err error) {
+	ctx, span := tracing.NewSpan(ctx, "TagFromSourceGroup")
+	defer span.EndWithError(err)
+
+	if domain.NormalizeTagName(group) == "" {
+		return nil
+	}
+	tag, err := s.tag(ctx, group, noradIDs, domain.TagOriginSource)
+	if err != nil || !complete {
+		return err
+	}
+	if err := s.repo.UntagSourceSatellitesExcept(ctx, tag.ID, noradIDs); err != nil {
+		return fmt.Errorf("failed to untag satellites that left group %s: %w", group, err)
+	}
+	return nil
+}
+
+// AddTaggedSatellitesToContext adds the satellites having a tag to a context and returns the satellites added.
+// Satellites already in the context are skipped.
+func (s *SatelliteTagService) AddTaggedSatellitesToContext(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, name string) (added []domain.Satellite, err error) {
+	ctx, span := tracing.NewSpan(ctx, "AddTaggedSatellitesToContext")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.FindByName(ctx, name); err != nil {
+		return nil, err
+	}
+
+	tagged, err := s.satelliteRepo.FindByTags(ctx, []string{name})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find satellites tagged %s: %w", name, err)
+	}
+	existing, err := s.satelliteRepo.FindSatellitesByContext(ctx, gameContext.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find satellites of context %s: %w", contextName, err)
+	}
+	inContext := make(map[string]bool, len(existing))
+	for _, satellite := range existing {
+		inContext[satellite.ID] = true
+	}
+
+	for _, satellite := range tagged {
+		if inContext[satellite.ID] {
+			continue
+		}
+		if err := s.satelliteRepo.AssignSatelliteToContext(ctx, gameContext.ID, satellite.ID); err != nil {
+			return added, fmt.Errorf("failed to assign %s to context %s: %w", satellite.NoradID, contextName, err)
+		}
+		added = append(added, satellite)
+	}
+
+	log.Printf("Added %d satellites tagged %s to context %s", len(added), name, contextName)
+	return added, nil
+}
+
+func (s *SatelliteTagService) tag(ctx context.Context, name string, noradIDs []string, origin domain.SatelliteTagOrigin) (domain.SatelliteTag, error) {
+	tag, err := domain.NewSatelliteTag(name, "")
+	if err != nil {
+		return domain.SatelliteTag{}, err
+	}
+	tag, err = s.repo.Save(ctx, tag)
+	if err != nil {
+		return domain.SatelliteTag{}, err
+	}
+	if err := s.repo.TagSatellites(ctx, tag.ID, noradIDs, origin); err != nil {
+		return domain.SatelliteTag{}, fmt.Errorf("failed to tag satellites %s: %w", tag.Name, err)
+	}
+	return tag, nil
+}
@@ -0,0 +1,225 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"gorm.io/gorm"
+)
+
+// fakeSatelliteTagRepository keeps tags by name and their assignments by tag ID, ignoring unknown satellites
+// like the database does.
+type fakeSatelliteTagRepository struct {
+	domain.SatelliteTagRepository
+	satellites  *fakeCatalogSatelliteRepository
+	tags        map[string]domain.SatelliteTag
+	assignments map[string]map[string]domain.SatelliteTagOrigin
+	untagged    int
+}
+
+func newFakeSatelliteTagRepository(satellites *fakeCatalogSatelliteRepository) *fakeSatelliteTagRepository {
+	return &fakeSatelliteTagRepository{
+		satellites:  satellites,
+		tags:        make(map[string]domain.SatelliteTag),
+		assignments: make(map[string]map[string]domain.SatelliteTagOrigin),
+	}
+}
+
+func (r *fakeSatelliteTagRepository) FindByName(ctx context.Context, name string) (domain.SatelliteTag, error) {
+	tag, ok := r.tags[domain.NormalizeTagName(name)]
+	if !ok {
+		return domain.SatelliteTag{}, gorm.ErrRecordNotFound
+	}
+	return tag, nil
+}
+
+func (r *fakeSatelliteTagRepository) Save(ctx context.Context, tag domain.SatelliteTag) (domain.SatelliteTag, error) {
+	if stored, ok := r.tags[tag.Name]; ok {
+		return stored, nil
+	}
+	r.tags[tag.Name] = tag
+	r.assignments[tag.ID] = make(map[string]domain.SatelliteTagOrigin)
+	return tag, nil
+}
+
+func (r *fakeSatelliteTagRepository) TagSatellites(ctx context.Context, tagID string, noradIDs []string, origin domain.SatelliteTagOrigin) error {
+	for _, noradID := range noradIDs {
+		if _, ok := r.satellites.satellites[noradID]; !ok {
+			continue
+		}
+		if _, ok := r.assignments[tagID][noradID]; !ok {
+			r.assignments[tagID][noradID] = origin
+		}
+	}
+	return nil
+}
+
+func (r *fakeSatelliteTagRepository) UntagSatellites(ctx context.Context, tagID string, noradIDs []string) error {
+	for _, noradID := range noradIDs {
+		delete(r.assignments[tagID], noradID)
+	}
+	return nil
+}
+
+func (r *fakeSatelliteTagRepository) UntagSourceSatellitesExcept(ctx context.Context, tagID string, noradIDs []string) error {
+	r.untagged++
+	keep := make(map[string]bool, len(noradIDs))
+	for _, noradID := range noradIDs {
+		keep[noradID] = true
+	}
+	for noradID, origin := range r.assignments[tagID] {
+		if origin == domain.TagOriginSource && !keep[noradID] {
+			delete(r.assignments[tagID], noradID)
+		}
+	}
+	return nil
+}
+
+// tagged returns the sorted NORAD IDs having a tag.
+func (r *fakeSatelliteTagRepository) tagged(name string) []string {
+	noradIDs := []string{}
+	for noradID := range r.assignments[r.tags[name].ID] {
+		noradIDs = append(noradIDs, noradID)
+	}
+	sort.Strings(noradIDs)
+	return noradIDs
+}
+
+func newTagTestService(t *testing.T, noradIDs ...string) (SatelliteTagService, *fakeSatelliteTagRepository) {
+	t.Helper()
+	satellites := newFakeCatalogSatelliteRepository()
+	for _, noradID := range noradIDs {
+		satellites.satellites[noradID] = namedSatellite(t, noradID, "SAT "+noradID)
+	}
+	repo := newFakeSatelliteTagRepository(satellites)
+	return NewSatelliteTagService(repo, satellites, nil), repo
+}
+
+func TestTagSatellitesReportsUnknownNoradIDs(t *testing.T) {
+	tests := []struct {
+		name        string
+		noradIDs    []string
+		wantTagged  []string
+		wantUnknown []string
+	}{
+		{
+			name:        "All known",
+			noradIDs:    []string{"25544", "43013"},
+			wantTagged:  []string{"25544", "43013"},
+			wantUnknown: []string{},
+		},
+		{
+			name:        "Some unknown",
+			noradIDs:    []string{"99999", "25544", "88888"},
+			wantTagged:  []string{"25544"},
+			wantUnknown: []string{"99999", "88888"},
+		},
+		{
+			name:        "All unknown",
+			noradIDs:    []string{"99999"},
+			wantTagged:  []string{},
+			wantUnknown: []string{"99999"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo := newTagTestService(t, "25544", "43013")
+
+			tag, unknown, err := service.TagSatellites(context.Background(), " Stations ", tt.noradIDs)
+			if err != nil {
+				t.Fatalf("TagSatellites failed: %v", err)
+			}
+			if tag.Name != "stations" {
+				t.Errorf("expected normalized tag name stations, got %q", tag.Name)
+			}
+			if !reflect.DeepEqual(unknown, tt.wantUnknown) {
+				t.Errorf("expected unknown %v, got %v", tt.wantUnknown, unknown)
+			}
+			if got := repo.tagged("stations"); !reflect.DeepEqual(got, tt.wantTagged) {
+				t.Errorf("expected tagged %v, got %v", tt.wantTagged, got)
+			}
+			for noradID, origin := range repo.assignments[tag.ID] {
+				if origin != domain.TagOriginUser {
+					t.Errorf("expected %s tagged by a user, got origin %s", noradID, origin)
+				}
+			}
+		})
+	}
+}
+
+func TestTagSatellitesRejectsInvalidName(t *testing.T) {
+	service, _ := newTagTestService(t, "25544")
+
+	if _, _, err := service.TagSatellites(context.Background(), "a,b", []string{"25544"}); err == nil {
+		t.Error("expected an error for a tag name with a comma")
+	}
+}
+
+func TestTagFromSourceGroup(t *testing.T) {
+	ctx := context.Background()
+	service, repo := newTagTestService(t, "25544", "43013", "48274")
+
+	if err := service.TagFromSourceGroup(ctx, "stations", []string{"25544", "43013"}, true); err != nil {
+		t.Fatalf("TagFromSourceGroup failed: %v", err)
+	}
+	if _, _, err := service.TagSatellites(ctx, "stations", []string{"48274"}); err != nil {
+		t.Fatalf("TagSatellites failed: %v", err)
+	}
+
+	// A partial fetch only adds the tag.
+	if err := service.TagFromSourceGroup(ctx, "stations", []string{"25544"}, false); err != nil {
+		t.Fatalf("TagFromSourceGroup failed: %v", err)
+	}
+	if repo.untagged != 1 {
+		t.Errorf("expected no untagging on a partial fetch, got %d untaggings", repo.untagged)
+	}
+	if got, want := repo.tagged("stations"), []string{"25544", "43013", "48274"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected tagged %v after a partial fetch, got %v", want, got)
+	}
+
+	// A complete fetch untags the satellites that left the group, but not the ones tagged by a user.
+	if err := service.TagFromSourceGroup(ctx, "stations", []string{"25544"}, true); err != nil {
+		t.Fatalf("TagFromSourceGroup failed: %v", err)
+	}
+	if repo.untagged != 2 {
+		t.Errorf("expected the complete fetch to untag, got %d untaggings", repo.untagged)
+	}
+	if got, want := repo.tagged("stations"), []string{"25544", "48274"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected tagged %v after a complete fetch, got %v", want, got)
+	}
+}
+
+func TestTagFromSourceGroupIgnoresEmptyGroup(t *testing.T) {
+	service, repo := newTagTestService(t, "25544")
+
+	if err := service.TagFromSourceGroup(context.Background(), "  ", []string{"25544"}, true); err != nil {
+		t.Fatalf("TagFromSourceGroup failed: %v", err)
+	}
+	if len(repo.tags) != 0 || repo.untagged != 0 {
+		t.Errorf("expected nothing written for an empty group, got %d tags and %d untaggings", len(repo.tags), repo.untagged)
+	}
+}
+
+func TestUntagSatellites(t *testing.T) {
+	ctx := context.Background()
+	service, repo := newTagTestService(t, "25544", "43013")
+
+	if _, _, err := service.TagSatellites(ctx, "stations", []string{"25544", "43013"}); err != nil {
+		t.Fatalf("TagSatellites failed: %v", err)
+	}
+	if err := service.UntagSatellites(ctx, "Stations", []string{"43013"}); err != nil {
+		t.Fatalf("UntagSatellites failed: %v", err)
+	}
+	if got, want := repo.tagged("stations"), []string{"25544"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected tagged %v, got %v", want, got)
+	}
+
+	if err := service.UntagSatellites(ctx, "debris", []string{"25544"}); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("expected a not found error for an unknown tag, got %v", err)
+	}
+}
@@ -2,10 +2,12 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/Elbujito/2112/src/app-service/internal/api/mappers"
 	propagator "github.com/Elbujito/2112/src/app-service/internal/clients/propagate"
 	"github.com/Elbujito/2112/src/app-service/internal/domain"
 	repository "github.com/Elbujito/2112/src/app-service/internal/repositories"
@@ -18,11 +20,12 @@ type SatelliteService struct {
 	propagateClient  *propagator.PropagatorClient
 	celestrackClient celestrackClient
 	repo             domain.SatelliteRepository
+	nameRepo         domain.SatelliteNameChangeRepository
 }
 
 // NewSatelliteService creates a new instance of SatelliteService.
-func NewSatelliteService(tleRepo repository.TleRepository, propagateClient *propagator.PropagatorClient, celestrackClient celestrackClient, repo domain.SatelliteRepository) SatelliteService {
-	return SatelliteService{tleRepo: tleRepo, propagateClient: propagateClient, celestrackClient: celestrackClient, repo: repo}
+func NewSatelliteService(tleRepo repository.TleRepository, propagateClient *propagator.PropagatorClient, celestrackClient celestrackClient, repo domain.SatelliteRepository, nameRepo domain.SatelliteNameChangeRepository) SatelliteService {
+	return SatelliteService{tleRepo: tleRepo, propagateClient: propagateClient, celestrackClient: celestrackClient, repo: repo, nameRepo: nameRepo}
 }
 
 func (s *SatelliteService) Propagate(ctx context.Context, noradID string, duration time.Duration, interval time.Duration) (pos []xspace.SatellitePosition, err error) {
@@ -111,14 +114,119 @@ func (s *SatelliteService) GetSatelliteByNoradID(ctx context.Context, noradID st
 	return s.repo.FindByNoradID(ctx, noradID)
 }
 
+// GetNameHistory retrieves the name changes of a satellite, oldest first.
+func (s *SatelliteService) GetNameHistory(ctx context.Context, noradID string) (changes []domain.SatelliteNameChange, err error) {
+	ctx, span := tracing.NewSpan(ctx, "GetSatelliteNameHistory")
+	defer span.EndWithError(err)
+	return s.nameRepo.FindByNoradID(ctx, noradID)
+}
+
+// CreateSyntheticSatellite stores a user-defined satellite with a TLE generated from Keplerian elements.
+// The satellite gets the next free synthetic catalog number so that it flows through propagation and mapping like a cataloged object.
+func (s *SatelliteService) CreateSyntheticSatellite(ctx context.Context, name string, elements xspace.KeplerianElements) (satellite domain.Satellite, tle domain.TLE, err error) {
+	ctx, span := tracing.NewSpan(ctx, "CreateSyntheticSatellite")
+	defer span.EndWithError(err)
+
+	err = allocateSyntheticNoradIDs(ctx, s.repo, 1, func(noradID int) error {
+		satellite, tle, err = domain.NewSyntheticSatellite(name, noradID, elements)
+		if err != nil {
+			return err
+		}
+		return s.repo.CreateSynthetic(ctx, []domain.Satellite{satellite}, []domain.TLE{tle}, "")
+	})
+	if err != nil {
+		return domain.Satellite{}, domain.TLE{}, err
+	}
+
+	publishSyntheticTLEs(ctx, s.tleRepo, []domain.TLE{tle})
+	return satellite, tle, nil
+}
+
+// CreateWalkerConstellation stores the synthetic satellites of a Walker T/P/F constellation at the given epoch.
+func (s *SatelliteService) CreateWalkerConstellation(ctx context.Context, namePrefix string, walker xspace.WalkerConstellation, epoch time.Time) (satellites []domain.Satellite, tles []domain.TLE, err error) {
+	ctx, span := tracing.NewSpan(ctx, "CreateWalkerConstellation")
+	defer span.EndWithError(err)
+
+	if err := walker.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	err = allocateSyntheticNoradIDs(ctx, s.repo, walker.TotalSatellites, func(firstNoradID int) error {
+		satellites, tles, err = domain.NewWalkerConstellationSatellites(namePrefix, firstNoradID, walker, epoch)
+		if err != nil {
+			return err
+		}
+		return s.repo.CreateSynthetic(ctx, satellites, tles, "")
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	publishSyntheticTLEs(ctx, s.tleRepo, tles)
+	return satellites, tles, nil
+}
+
+// ListSyntheticSatellites retrieves the user-defined satellites.
+func (s *SatelliteService) ListSyntheticSatellites(ctx context.Context) (satellites []domain.Satellite, err error) {
+	ctx, span := tracing.NewSpan(ctx, "ListSyntheticSatellites")
+	defer span.EndWithError(err)
+	return s.repo.FindSynthetic(ctx)
+}
+
+// syntheticAllocationAttempts bounds the retries of a synthetic NORAD ID allocation lost to a concurrent one.
+const syntheticAllocationAttempts = 5
+
+// allocateSyntheticNoradIDs picks count consecutive free synthetic catalog numbers and calls create with the
+// first one. The numbers are only reserved by inserting the satellites, so when create reports that another
+// allocation took them first, the numbers are picked again after the new highest one.
+func allocateSyntheticNoradIDs(ctx context.Context, satelliteRepo domain.SatelliteRepository, count int, create func(firstNoradID int) error) error {
+	for attempt := 0; attempt < syntheticAllocationAttempts; attempt++ {
+		highest, err := satelliteRepo.FindHighestSyntheticNoradID(ctx)
+		if err != nil {
+			return err
+		}
+		firstNoradID, err := domain.NextSyntheticNoradID(highest, count)
+		if err != nil {
+			return err
+		}
+
+		err = create(firstNoradID)
+		if !errors.Is(err, domain.ErrNoradIDTaken) {
+			return err
+		}
+		log.Printf("Synthetic NORAD IDs from %d taken by a concurrent allocation, retrying", firstNoradID)
+	}
+	return fmt.Errorf("failed to allocate %d synthetic NORAD IDs after %d attempts: %w", count, syntheticAllocationAttempts, domain.ErrNoradIDTaken)
+}
+
+// publishSyntheticTLEs caches and publishes the element sets of new synthetic satellites, already stored as current.
+func publishSyntheticTLEs(ctx context.Context, tleRepo repository.TleRepository, tles []domain.TLE) {
+	for _, tle := range tles {
+		if err := tleRepo.SetCurrentTle(ctx, tle); err != nil {
+			log.Printf("Failed to publish the TLE of synthetic satellite %s: %v", tle.NoradID, err)
+		}
+	}
+}
+
+// EnsureSatellites creates the missing satellites of ingested element sets and renames the existing ones whose
+// latest element set carries another name, recording the change.
+func (s *SatelliteService) EnsureSatellites(ctx context.Context, tles []domain.TLE) (err error) {
+	ctx, span := tracing.NewSpan(ctx, "EnsureSatellites")
+	defer span.EndWithError(err)
+	return ensureSatellites(ctx, s.repo, s.nameRepo, tles)
+}
+
 // ListAllSatellites retrieves all stored satellites.
 func (s *SatelliteService) ListAllSatellites(ctx context.Context) (satellite []domain.Satellite, err error) {
 	ctx, span := tracing.NewSpan(ctx, "ListAllSatellites")
 	defer span.EndWithError(err)
 	return s.repo.FindAll(ctx)
 }
-func (s *SatelliteService) FetchAndStoreAllSatellites(ctx context.Context, maxCount int) (satellite []domain.Satellite, err error) {
-	ctx, span := tracing.NewSpan(ctx, "FetchAndStoreAllSatellites")
+
+// SyncSatelliteCatalog fetches the SATCAT, keeps its first maxCount entries and compares them with the stored
+// satellites. Only new and changed entries are stored, and the returned events describe each of them.
+func (s *SatelliteService) SyncSatelliteCatalog(ctx context.Context, maxCount int) (events []domain.SatelliteCatalogEvent, err error) {
+	ctx, span := tracing.NewSpan(ctx, "SyncSatelliteCatalog")
 	defer span.EndWithError(err)
 	// Fetch all satellite metadata from CelestrackClient
 	rawSatellites, err := s.celestrackClient.FetchSatelliteMetadata(ctx)
@@ -130,44 +238,63 @@ func (s *SatelliteService) FetchAndStoreAllSatellites(ctx context.Context, maxCo
 		return nil, fmt.Errorf("no satellite metadata available")
 	}
 
-	var storedSatellites []domain.Satellite
-	for _, rawSatellite := range rawSatellites {
+	// Retain only the maximum nbTles elements
+	if len(rawSatellites) > maxCount {
+		rawSatellites = rawSatellites[:maxCount] // Slice to keep only the first maxCount elements
+	}
 
-		// Use the updated constructor to create a Satellite
-		satellite, err := domain.NewSatelliteFromStatCat(
-			rawSatellite.Name,
-			rawSatellite.NoradID,
-			domain.Other, // Default type; adjust based on metadata if available
-			&rawSatellite.LaunchDate,
-			rawSatellite.DecayDate,
-			rawSatellite.IntlDesignator,
-			rawSatellite.Owner,
-			rawSatellite.ObjectType,
-			rawSatellite.Period,
-			rawSatellite.Inclination,
-			rawSatellite.Apogee,
-			rawSatellite.Apogee,
-			rawSatellite.RCS,
-			rawSatellite.Altitude,
-		)
+	entries := make([]domain.Satellite, 0, len(rawSatellites))
+	for _, rawSatellite := range rawSatellites {
+		satellite, err := satelliteFromMetadata(rawSatellite)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create satellite for NORAD ID %s: %w", rawSatellite.NoradID, err)
 		}
-		// Add the satellite to the result list
-		storedSatellites = append(storedSatellites, satellite)
+		entries = append(entries, satellite)
 	}
 
-	// Retain only the maximum nbTles elements
-	if len(storedSatellites) > maxCount {
-		storedSatellites = storedSatellites[:maxCount] // Slice to keep only the first maxCount elements
+	stored, err := s.repo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load stored satellites: %w", err)
 	}
 
-	// Save the satellite to the repository
-	if err := s.repo.SaveBatch(ctx, storedSatellites); err != nil {
+	changed, events := domain.DiffSatelliteCatalog(stored, entries, time.Now().UTC())
+	if err := renameSatellites(ctx, s.repo, s.nameRepo, stored, catalogNames(changed)); err != nil {
+		return nil, err
+	}
+	if err := s.repo.SaveCatalogBatch(ctx, changed); err != nil {
 		return nil, fmt.Errorf("failed to save satellite to database: %w", err)
 	}
 
-	return storedSatellites, nil
+	log.Printf("Compared %d SATCAT entries with %d stored satellites, stored %d changes", len(entries), len(stored), len(changed))
+	return events, nil
+}
+
+// satelliteFromMetadata maps a SATCAT record to a catalog satellite.
+func satelliteFromMetadata(rawSatellite *mappers.SatelliteMetadata) (domain.Satellite, error) {
+	satellite, err := domain.NewSatelliteFromStatCat(
+		rawSatellite.Name,
+		rawSatellite.NoradID,
+		domain.Other, // Default type; adjust based on metadata if available
+		&rawSatellite.LaunchDate,
+		rawSatellite.DecayDate,
+		rawSatellite.IntlDesignator,
+		rawSatellite.Owner,
+		rawSatellite.ObjectType,
+		rawSatellite.Period,
+		rawSatellite.Inclination,
+		rawSatellite.Apogee,
+		rawSatellite.Perigee,
+		rawSatellite.RCS,
+		rawSatellite.Altitude,
+	)
+	if err != nil {
+		return domain.Satellite{}, err
+	}
+	satellite.OpsStatus = rawSatellite.OpsStatus
+	satellite.LaunchSite = rawSatellite.LaunchSite
+	satellite.OrbitCenter = rawSatellite.OrbitCenter
+	satellite.OrbitType = rawSatellite.OrbitType
+	return satellite, nil
 }
 
 // ListSatellitesWithPaginationAndTLE retrieves satellites with pagination and includes a flag indicating if a TLE is present.
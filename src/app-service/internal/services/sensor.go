@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	repository "github.com/Elbujito/2112/src/app-service/internal/repositories"
+	"github.com/Elbujito/2112/src/app-service/pkg/tracing"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+)
+
+// swathTrackStep is the sampling step of the ground track used to build sensor swaths.
+const swathTrackStep = time.Minute
+
+// SensorService manages satellite sensors and the tiles they can image.
+type SensorService struct {
+	repo          domain.SatelliteSensorRepository
+	satelliteRepo domain.SatelliteRepository
+	tileRepo      domain.TileRepository
+	tleRepo       repository.TleRepository
+}
+
+// NewSensorService creates a new instance of SensorService.
+func NewSensorService(repo domain.SatelliteSensorRepository, satelliteRepo domain.SatelliteRepository, tileRepo domain.TileRepository, tleRepo repository.TleRepository) SensorService {
+	return SensorService{repo: repo, satelliteRepo: satelliteRepo, tileRepo: tileRepo, tleRepo: tleRepo}
+}
+
+// AttachSensor attaches or replaces the sensor of a satellite.
+func (s *SensorService) AttachSensor(ctx context.Context, noradID string, sensor xspace.Sensor) (satelliteSensor domain.SatelliteSensor, err error) {
+	ctx, span := tracing.NewSpan(ctx, "AttachSensor")
+	defer span.EndWithError(err)
+
+	satellite, err := s.satelliteRepo.FindByNoradID(ctx, noradID)
+	if err != nil {
+		return domain.SatelliteSensor{}, err
+	}
+	if satellite.NoradID == "" {
+		return domain.SatelliteSensor{}, fmt.Errorf("satellite %s not found", noradID)
+	}
+
+	satelliteSensor, err = domain.NewSatelliteSensor(noradID, sensor)
+	if err != nil {
+		return domain.SatelliteSensor{}, err
+	}
+	if err := s.repo.Save(ctx, satelliteSensor); err != nil {
+		return domain.SatelliteSensor{}, fmt.Errorf("failed to save sensor: %w", err)
+	}
+	return satelliteSensor, nil
+}
+
+// GetSensor retrieves the sensor of a satellite, or nil if it has none.
+func (s *SensorService) GetSensor(ctx context.Context, noradID string) (sensor *domain.SatelliteSensor, err error) {
+	ctx, span := tracing.NewSpan(ctx, "GetSensor")
+	defer span.EndWithError(err)
+	return s.repo.FindByNoradID(ctx, noradID)
+}
+
+// DetachSensor removes the sensor of a satellite.
+func (s *SensorService) DetachSensor(ctx context.Context, noradID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx, "DetachSensor")
+	defer span.EndWithError(err)
+	return s.repo.DeleteByNoradID(ctx, noradID)
+}
+
+// ListImageableTiles retrieves the tiles the sensor of a satellite can image between start and end.
+func (s *SensorService) ListImageableTiles(ctx context.Context, noradID string, start time.Time, end time.Time) (mappings []domain.TileSatelliteMapping, err error) {
+	ctx, span := tracing.NewSpan(ctx, "ListImageableTiles")
+	defer span.EndWithError(err)
+
+	sensor, err := s.repo.FindByNoradID(ctx, noradID)
+	if err != nil {
+		return nil, err
+	}
+	if sensor == nil {
+		return nil, fmt.Errorf("satellite %s has no sensor", noradID)
+	}
+
+	satellite, err := s.satelliteRepo.FindByNoradID(ctx, noradID)
+	if err != nil {
+		return nil, err
+	}
+
+	tle, err := s.tleRepo.GetTle(ctx, noradID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch TLE for NORAD ID %s: %w", noradID, err)
+	}
+
+	track, err := xspace.PropagateRange(tle.Line1, tle.Line2, start, end, swathTrackStep)
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make([]domain.SatellitePosition, len(track))
+	for i, position := range track {
+		positions[i] = domain.SatellitePosition{
+			Latitude:  position.Latitude,
+			Longitude: position.Longitude,
+			Altitude:  position.Altitude,
+			Timestamp: position.Time,
+		}
+	}
+
+	return domain.FindTilesVisibleFromTrack(ctx, s.tileRepo, satellite, sensor, positions)
+}
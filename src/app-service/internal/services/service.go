@@ -9,15 +9,33 @@ import (
 	"github.com/Elbujito/2112/src/app-service/internal/clients/redis"
 	"github.com/Elbujito/2112/src/app-service/internal/config"
 	"github.com/Elbujito/2112/src/app-service/internal/data"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
 	repository "github.com/Elbujito/2112/src/app-service/internal/repositories"
 )
 
 // ServiceComponent holds all service instances for dependency injection.
 type ServiceComponent struct {
-	SatelliteService  SatelliteService
-	TileService       TileService
-	ContextService    ContextService
-	AuditTrailService AuditTrailService
+	SatelliteService        SatelliteService
+	TileService             TileService
+	ContextService          ContextService
+	AuditTrailService       AuditTrailService
+	GeoService              GeoService
+	DecayService            DecayService
+	GroundStationService    GroundStationService
+	ContactSchedulerService ContactSchedulerService
+	LinkService             LinkService
+	SensorService           SensorService
+	OrbitLightingService    OrbitLightingService
+	ConstellationService    ConstellationService
+	TileCoverageService     TileCoverageService
+	GeofenceService         GeofenceService
+	OverflightService       OverflightService
+	SkyService              SkyService
+	ElementSetService       ElementSetService
+	SatelliteTagService     SatelliteTagService
+	TransmitterService      TransmitterService
+	LaunchService           LaunchService
+	DataQualityService      DataQualityService
 }
 
 // NewServiceComponent initializes and returns a new ServiceComponent.
@@ -36,19 +54,78 @@ func NewServiceComponent(env *config.SEnv) *ServiceComponent {
 	mappingRepo := repository.NewTileSatelliteMappingRepository(&database)
 	contextRepo := repository.NewContextRepository(&database)
 	auditTrailRepo := repository.NewAuditTrailRepository(&database)
+	geoRepo := repository.NewGeoRepository(&database)
+	decayRepo := repository.NewDecayPredictionRepository(&database)
+	groundStationRepo := repository.NewGroundStationRepository(&database)
+	contactPlanRepo := repository.NewContactPlanRepository(&database)
+	linkWindowRepo := repository.NewLinkWindowRepository(&database)
+	sensorRepo := repository.NewSatelliteSensorRepository(&database)
+	lightingRepo := repository.NewOrbitLightingRepository(&database)
+	constellationRepo := repository.NewConstellationRepository(&database)
+	tileCoverageRepo := repository.NewTileCoverageRepository(&database)
+	geofenceRepo := repository.NewGeofenceRepository(&database)
+	adminAreaRepo := repository.NewAdminAreaRepository(&database)
+	overflightRepo := repository.NewOverflightReportRepository(&database)
+	satelliteNameRepo := repository.NewSatelliteNameChangeRepository(&database)
+	elementSetOverrideRepo := repository.NewElementSetOverrideRepository(&database)
+	satelliteTagRepo := repository.NewSatelliteTagRepository(&database)
+	transmitterRepo := repository.NewTransmitterRepository(&database)
+	launchRepo := repository.NewLaunchRepository(&database)
+	fragmentationRepo := repository.NewFragmentationEventRepository(&database)
+	dataQualityRepo := repository.NewDataQualityRepository(&database)
+
+	elementSetPolicy, err := domain.ParseElementSetPolicy(env.EnvVars.ElementSets.Precedence)
+	if err != nil {
+		log.Println(err.Error())
+		return nil
+	}
 
 	propagteClient := propagator.NewPropagatorClient(env)
 	celestrackClient := celestrack.NewCelestrackClient(env)
 
-	satelliteService := NewSatelliteService(tleRepo, propagteClient, celestrackClient, satelliteRepo)
-	tileService := NewTileService(tileRepo, tleRepo, satelliteRepo, mappingRepo)
+	satelliteService := NewSatelliteService(tleRepo, propagteClient, celestrackClient, satelliteRepo, satelliteNameRepo)
+	tileService := NewTileService(tileRepo, tleRepo, satelliteRepo, mappingRepo, sensorRepo)
 	contextService := NewContextService(contextRepo)
 	auditTrailService := NewAuditTrailService(auditTrailRepo)
+	geoService := NewGeoService(geoRepo, satelliteRepo, tleRepo)
+	decayService := NewDecayService(decayRepo, satelliteRepo, tleRepo)
+	groundStationService := NewGroundStationService(groundStationRepo, contextRepo, tleRepo, transmitterRepo)
+	contactSchedulerService := NewContactSchedulerService(contactPlanRepo, groundStationRepo, contextRepo, satelliteRepo, tleRepo)
+	linkService := NewLinkService(linkWindowRepo, contextRepo, satelliteRepo, tleRepo)
+	sensorService := NewSensorService(sensorRepo, satelliteRepo, tileRepo, tleRepo)
+	lightingService := NewOrbitLightingService(lightingRepo, satelliteRepo, tleRepo)
+	constellationService := NewConstellationService(constellationRepo, contextRepo, satelliteRepo, tileRepo, tleRepo)
+	tileCoverageService := NewTileCoverageService(tileCoverageRepo, contextRepo, tileRepo, satelliteRepo, tleRepo)
+	geofenceService := NewGeofenceService(geofenceRepo, contextRepo, tleRepo)
+	overflightService := NewOverflightService(overflightRepo, adminAreaRepo, contextRepo, satelliteRepo, tleRepo)
+	skyService := NewSkyService(contextRepo, satelliteRepo, tleRepo)
+	elementSetService := NewElementSetService(tleRepo, elementSetOverrideRepo, satelliteRepo, elementSetPolicy)
+	satelliteTagService := NewSatelliteTagService(satelliteTagRepo, satelliteRepo, contextRepo)
+	transmitterService := NewTransmitterService(transmitterRepo, satelliteRepo)
+	launchService := NewLaunchService(launchRepo, fragmentationRepo)
+	dataQualityService := NewDataQualityService(dataQualityRepo, satelliteRepo, contextRepo)
 
 	return &ServiceComponent{
-		SatelliteService:  satelliteService,
-		TileService:       tileService,
-		ContextService:    contextService,
-		AuditTrailService: auditTrailService,
+		SatelliteService:        satelliteService,
+		TileService:             tileService,
+		ContextService:          contextService,
+		AuditTrailService:       auditTrailService,
+		GeoService:              geoService,
+		DecayService:            decayService,
+		GroundStationService:    groundStationService,
+		ContactSchedulerService: contactSchedulerService,
+		LinkService:             linkService,
+		SensorService:           sensorService,
+		OrbitLightingService:    lightingService,
+		ConstellationService:    constellationService,
+		TileCoverageService:     tileCoverageService,
+		GeofenceService:         geofenceService,
+		OverflightService:       overflightService,
+		SkyService:              skyService,
+		ElementSetService:       elementSetService,
+		SatelliteTagService:     satelliteTagService,
+		TransmitterService:      transmitterService,
+		LaunchService:           launchService,
+		DataQualityService:      dataQualityService,
 	}
 }
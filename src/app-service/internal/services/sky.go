@@ -0,0 +1,53 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	repository "github.com/Elbujito/2112/src/app-service/internal/repositories"
+	"github.com/Elbujito/2112/src/app-service/pkg/tracing"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+)
+
+// SkyService computes the sky seen by an observer from the satellites of a context and the ephemeris of the solar system.
+type SkyService struct {
+	contextRepo   domain.GameContextRepository
+	satelliteRepo domain.SatelliteRepository
+	tleRepo       repository.TleRepository
+}
+
+// NewSkyService creates a new instance of SkyService.
+func NewSkyService(contextRepo domain.GameContextRepository, satelliteRepo domain.SatelliteRepository, tleRepo repository.TleRepository) SkyService {
+	return SkyService{contextRepo: contextRepo, satelliteRepo: satelliteRepo, tleRepo: tleRepo}
+}
+
+// GetSky returns the satellites of a context above minElevation, the Sun, the Moon and the planets seen from the observer at time t.
+func (s *SkyService) GetSky(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, observer xspace.Observer, t time.Time, minElevation float64) (view domain.SkyView, err error) {
+	ctx, span := tracing.NewSpan(ctx, "GetSky")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return domain.SkyView{}, err
+	}
+
+	satellites, err := s.satelliteRepo.FindSatellitesByContext(ctx, gameContext.ID)
+	if err != nil {
+		return domain.SkyView{}, fmt.Errorf("failed to fetch satellites of context %s: %w", contextName, err)
+	}
+
+	var tles []domain.TLE
+	for _, satellite := range satellites {
+		tle, err := s.tleRepo.GetTle(ctx, satellite.NoradID)
+		if err != nil {
+			log.Printf("Skipping NORAD ID %s in sky view: %v", satellite.NoradID, err)
+			continue
+		}
+		tles = append(tles, tle)
+	}
+
+	return domain.NewSkyView(observer, t, satellites, tles, minElevation)
+}
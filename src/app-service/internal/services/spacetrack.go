@@ -0,0 +1,235 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/clients/spacetrack"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	repository "github.com/Elbujito/2112/src/app-service/internal/repositories"
+	"github.com/Elbujito/2112/src/app-service/pkg/tracing"
+)
+
+// spaceTrackSyncSource is the source of the sync states of the Space-Track streams.
+const spaceTrackSyncSource = spacetrack.ElementSetSource
+
+type spaceTrackClient interface {
+	FetchGP(ctx context.Context, noradIDs []string, afterGPID int64, limit int) ([]spacetrack.GPRecord, error)
+	FetchGPHistory(ctx context.Context, noradID string, start time.Time, end time.Time) ([]spacetrack.GPRecord, error)
+	FetchSatcat(ctx context.Context, afterNoradID int64, limit int) ([]spacetrack.SatcatRecord, error)
+}
+
+type elementSetResolver interface {
+	ResolveCurrent(ctx context.Context, noradIDs []string) error
+}
+
+// SpaceTrackService synchronizes element sets and catalog entries from Space-Track. Each stream keeps a cursor
+// in a SyncState so that a run only requests the records published since the previous one.
+type SpaceTrackService struct {
+	client        spaceTrackClient
+	syncRepo      domain.SyncStateRepository
+	tleRepo       repository.TleRepository
+	satelliteRepo domain.SatelliteRepository
+	nameRepo      domain.SatelliteNameChangeRepository
+	elementSets   elementSetResolver
+}
+
+// NewSpaceTrackService creates a new instance of SpaceTrackService.
+func NewSpaceTrackService(client spaceTrackClient, syncRepo domain.SyncStateRepository, tleRepo repository.TleRepository, satelliteRepo domain.SatelliteRepository, nameRepo domain.SatelliteNameChangeRepository, elementSets elementSetResolver) SpaceTrackService {
+	return SpaceTrackService{client: client, syncRepo: syncRepo, tleRepo: tleRepo, satelliteRepo: satelliteRepo, nameRepo: nameRepo, elementSets: elementSets}
+}
+
+// SyncGP fetches at most maxCount element sets published since the last run, restricted to noradIDs when not empty.
+// The cursor is the highest GP_ID stored, so successive runs page through a large backlog.
+func (s *SpaceTrackService) SyncGP(ctx context.Context, noradIDs []string, maxCount int) (tles []domain.TLE, err error) {
+	ctx, span := tracing.NewSpan(ctx, "SyncSpaceTrackGP")
+	defer span.EndWithError(err)
+
+	scope := spacetrack.ClassGP
+	if len(noradIDs) > 0 {
+		sorted := append([]string(nil), noradIDs...)
+		sort.Strings(sorted)
+		scope += ":" + strings.Join(sorted, ",")
+	}
+	state, err := s.syncRepo.Find(ctx, spaceTrackSyncSource, scope)
+	if err != nil {
+		return nil, err
+	}
+	afterGPID, _ := strconv.ParseInt(state.Cursor, 10, 64)
+
+	records, err := s.client.FetchGP(ctx, noradIDs, afterGPID, maxCount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch GP element sets: %w", err)
+	}
+
+	cursor := afterGPID
+	for _, record := range records {
+		if id := record.GPID.Int(); id > cursor {
+			cursor = id
+		}
+		tle, err := record.ToTLE()
+		if err != nil {
+			log.Printf("Skipping GP_ID %s: %v", record.GPID, err)
+			continue
+		}
+		tles = append(tles, tle)
+	}
+
+	if err := s.storeTLEs(ctx, tles); err != nil {
+		return nil, err
+	}
+	if err := s.saveCursor(ctx, scope, strconv.FormatInt(cursor, 10)); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Synchronized %d GP element sets from Space-Track (GP_ID cursor %d)", len(tles), cursor)
+	return tles, nil
+}
+
+// SyncGPHistory fetches the element sets of each satellite with an epoch after its last synchronized one,
+// starting at start on the first run.
+func (s *SpaceTrackService) SyncGPHistory(ctx context.Context, noradIDs []string, start time.Time) (tles []domain.TLE, err error) {
+	ctx, span := tracing.NewSpan(ctx, "SyncSpaceTrackGPHistory")
+	defer span.EndWithError(err)
+
+	end := time.Now().UTC()
+	for _, noradID := range noradIDs {
+		scope := spacetrack.ClassGPHistory + ":" + noradID
+		state, err := s.syncRepo.Find(ctx, spaceTrackSyncSource, scope)
+		if err != nil {
+			return nil, err
+		}
+
+		from := start
+		if last, err := time.Parse(time.RFC3339Nano, state.Cursor); err == nil && !last.Before(from) {
+			from = last.Add(time.Millisecond) // Epoch predicates are inclusive
+		}
+		if !from.Before(end) {
+			continue
+		}
+
+		records, err := s.client.FetchGPHistory(ctx, noradID, from, end)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch GP history for NORAD ID %s: %w", noradID, err)
+		}
+
+		var history []domain.TLE
+		for _, record := range records {
+			tle, err := record.ToTLE()
+			if err != nil {
+				log.Printf("Skipping GP_ID %s: %v", record.GPID, err)
+				continue
+			}
+			history = append(history, tle)
+		}
+		if len(history) == 0 {
+			continue
+		}
+
+		if err := s.storeTLEs(ctx, history); err != nil {
+			return nil, err
+		}
+		latest := domain.LatestTLEByNoradID(history)[noradID]
+		if err := s.saveCursor(ctx, scope, latest.Epoch.UTC().Format(time.RFC3339Nano)); err != nil {
+			return nil, err
+		}
+		tles = append(tles, history...)
+	}
+
+	log.Printf("Synchronized %d historical element sets from Space-Track for %d satellites", len(tles), len(noradIDs))
+	return tles, nil
+}
+
+// SyncSatcat fetches at most maxCount catalog entries with a NORAD ID above the last synchronized one and
+// compares them with the stored satellites. Only new and changed entries are stored, and the returned events
+// describe each of them. A full run restarts from the beginning of the catalog.
+func (s *SpaceTrackService) SyncSatcat(ctx context.Context, maxCount int, full bool) (events []domain.SatelliteCatalogEvent, err error) {
+	ctx, span := tracing.NewSpan(ctx, "SyncSpaceTrackSatcat")
+	defer span.EndWithError(err)
+
+	var afterNoradID int64
+	if !full {
+		state, err := s.syncRepo.Find(ctx, spaceTrackSyncSource, spacetrack.ClassSatcat)
+		if err != nil {
+			return nil, err
+		}
+		afterNoradID, _ = strconv.ParseInt(state.Cursor, 10, 64)
+	}
+
+	records, err := s.client.FetchSatcat(ctx, afterNoradID, maxCount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch SATCAT entries: %w", err)
+	}
+
+	cursor := afterNoradID
+	var entries []domain.Satellite
+	for _, record := range records {
+		if id := record.NoradCatID.Int(); id > cursor {
+			cursor = id
+		}
+		satellite, err := record.ToSatellite()
+		if err != nil {
+			log.Printf("Skipping SATCAT entry %s: %v", record.IntlDes, err)
+			continue
+		}
+		entries = append(entries, satellite)
+	}
+
+	stored, err := s.satelliteRepo.FindByNoradIDs(ctx, catalogNoradIDs(entries))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load stored satellites: %w", err)
+	}
+	changed, events := domain.DiffSatelliteCatalog(stored, entries, time.Now().UTC())
+	if err := renameSatellites(ctx, s.satelliteRepo, s.nameRepo, stored, catalogNames(changed)); err != nil {
+		return nil, err
+	}
+	if err := s.satelliteRepo.SaveCatalogBatch(ctx, changed); err != nil {
+		return nil, fmt.Errorf("failed to save satellites to database: %w", err)
+	}
+	if err := s.saveCursor(ctx, spacetrack.ClassSatcat, strconv.FormatInt(cursor, 10)); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Compared %d SATCAT entries from Space-Track with %d stored satellites, stored %d changes (NORAD ID cursor %d)", len(entries), len(stored), len(changed), cursor)
+	return events, nil
+}
+
+// storeTLEs creates or renames the satellites of the TLEs, upserts the TLEs and selects the current element set
+// of each satellite, which also refreshes its orbital parameters and regime.
+func (s *SpaceTrackService) storeTLEs(ctx context.Context, tles []domain.TLE) error {
+	if len(tles) == 0 {
+		return nil
+	}
+	if err := ensureSatellites(ctx, s.satelliteRepo, s.nameRepo, tles); err != nil {
+		return err
+	}
+	if err := s.tleRepo.UpdateTleBatch(ctx, tles); err != nil {
+		return fmt.Errorf("failed to upsert TLEs: %w", err)
+	}
+
+	latest := domain.LatestTLEByNoradID(tles)
+	noradIDs := make([]string, 0, len(latest))
+	for noradID := range latest {
+		noradIDs = append(noradIDs, noradID)
+	}
+	if err := s.elementSets.ResolveCurrent(ctx, noradIDs); err != nil {
+		return fmt.Errorf("failed to select current element sets: %w", err)
+	}
+	return nil
+}
+
+func (s *SpaceTrackService) saveCursor(ctx context.Context, scope string, cursor string) error {
+	state, err := domain.NewSyncState(spaceTrackSyncSource, scope, cursor, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	if err := s.syncRepo.Save(ctx, state); err != nil {
+		return fmt.Errorf("failed to save %s sync state: %w", scope, err)
+	}
+	return nil
+}
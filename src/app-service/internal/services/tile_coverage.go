@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	repository "github.com/Elbujito/2112/src/app-service/internal/repositories"
+	"github.com/Elbujito/2112/src/app-service/pkg/tracing"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+)
+
+// tileCoverageStep is the sampling step of the ground tracks, which are interpolated between samples.
+const tileCoverageStep = 30 * time.Second
+
+// TileCoverageService materializes per-tile revisit statistics from the ground tracks of the satellites of a context.
+type TileCoverageService struct {
+	repo          domain.TileCoverageRepository
+	contextRepo   domain.GameContextRepository
+	tileRepo      domain.TileRepository
+	satelliteRepo domain.SatelliteRepository
+	tleRepo       repository.TleRepository
+}
+
+// NewTileCoverageService creates a new instance of TileCoverageService.
+func NewTileCoverageService(repo domain.TileCoverageRepository, contextRepo domain.GameContextRepository, tileRepo domain.TileRepository, satelliteRepo domain.SatelliteRepository, tleRepo repository.TleRepository) TileCoverageService {
+	return TileCoverageService{repo: repo, contextRepo: contextRepo, tileRepo: tileRepo, satelliteRepo: satelliteRepo, tleRepo: tleRepo}
+}
+
+// RefreshCoverage recomputes the coverage statistics of every tile of a context between start and end,
+// from the passes of the context satellites propagated over the window.
+func (s *TileCoverageService) RefreshCoverage(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, start time.Time, end time.Time) (count int, err error) {
+	ctx, span := tracing.NewSpan(ctx, "RefreshTileCoverage")
+	defer span.EndWithError(err)
+
+	if !end.After(start) {
+		return 0, fmt.Errorf("coverage window end must be after start")
+	}
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return 0, err
+	}
+
+	tiles, err := s.tileRepo.GetTilesByContext(ctx, gameContext.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch tiles of context %s: %w", contextName, err)
+	}
+	satellites, err := s.satelliteRepo.FindSatellitesByContext(ctx, gameContext.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch satellites of context %s: %w", contextName, err)
+	}
+
+	var tracks [][]xspace.SatellitePosition
+	var noradIDs []string
+	for _, satellite := range satellites {
+		tle, err := s.tleRepo.GetTle(ctx, satellite.NoradID)
+		if err != nil {
+			log.Printf("Skipping NORAD ID %s in tile coverage: %v", satellite.NoradID, err)
+			continue
+		}
+		track, err := xspace.PropagateRange(tle.Line1, tle.Line2, start, end, tileCoverageStep)
+		if err != nil {
+			log.Printf("Skipping NORAD ID %s in tile coverage: failed to propagate: %v", satellite.NoradID, err)
+			continue
+		}
+		tracks = append(tracks, track)
+		noradIDs = append(noradIDs, satellite.NoradID)
+	}
+
+	stats := make([]domain.TileCoverageStats, 0, len(tiles))
+	for _, tile := range tiles {
+		var passes []domain.TilePass
+		for i, track := range tracks {
+			passes = append(passes, domain.FindTilePasses(tile, noradIDs[i], track)...)
+		}
+		stats = append(stats, domain.NewTileCoverageStats(gameContext.ID, domain.NewTilePassAggregate(tile, passes), start, end))
+	}
+
+	if err := s.repo.ReplaceForContext(ctx, gameContext.ID, stats); err != nil {
+		return 0, err
+	}
+	return len(stats), nil
+}
+
+// GetCoverage retrieves the latest coverage statistics of the tiles of a context.
+func (s *TileCoverageService) GetCoverage(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID) (stats []domain.TileCoverageStats, err error) {
+	ctx, span := tracing.NewSpan(ctx, "GetTileCoverage")
+	defer span.EndWithError(err)
+
+	gameContext, err := resolveTenantContext(ctx, s.contextRepo, contextName, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.FindByContext(ctx, gameContext.ID)
+}
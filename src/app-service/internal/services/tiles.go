@@ -16,6 +16,7 @@ type TileService struct {
 	tleRepo       repository.TleRepository
 	satelliteRepo domain.SatelliteRepository
 	mappingRepo   domain.MappingRepository
+	sensorRepo    domain.SatelliteSensorRepository
 }
 
 // NewTileService creates a new instance of TileService.
@@ -24,12 +25,14 @@ func NewTileService(
 	tleRepo repository.TleRepository,
 	satelliteRepo domain.SatelliteRepository,
 	mappingRepo domain.MappingRepository,
+	sensorRepo domain.SatelliteSensorRepository,
 ) TileService {
 	return TileService{
 		repo:          tileRepo,
 		tleRepo:       tleRepo,
 		satelliteRepo: satelliteRepo,
 		mappingRepo:   mappingRepo,
+		sensorRepo:    sensorRepo,
 	}
 }
 
@@ -157,8 +160,12 @@ func (s *TileService) RecomputeMappings(ctx context.Context, contextID, noradID
 		return nil
 	}
 
-	// Step 4: Compute new mappings
-	mappings, err := s.repo.FindTilesVisibleFromLine(ctx, satellite, positions)
+	// Step 4: Compute new mappings, along the sensor swath when the satellite has one
+	sensor, err := s.sensorRepo.FindByNoradID(ctx, noradID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch sensor for NORAD ID [%s]: %w", noradID, err)
+	}
+	mappings, err := domain.FindTilesVisibleFromTrack(ctx, s.repo, satellite, sensor, positions)
 	if err != nil {
 		return fmt.Errorf("failed to compute tile mappings for NORAD ID [%s]: %w", noradID, err)
 	}
@@ -2,10 +2,14 @@ package services
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"time"
 
 	"github.com/Elbujito/2112/src/app-service/internal/api/mappers"
+	"github.com/Elbujito/2112/src/app-service/internal/clients/celestrack"
+	"github.com/Elbujito/2112/src/app-service/internal/clients/elementsets"
 	"github.com/Elbujito/2112/src/app-service/internal/domain"
 	repository "github.com/Elbujito/2112/src/app-service/internal/repositories"
 	"github.com/Elbujito/2112/src/app-service/pkg/tracing"
@@ -16,61 +20,166 @@ type celestrackClient interface {
 	FetchSatelliteMetadata(ctx context.Context) ([]*mappers.SatelliteMetadata, error)
 }
 
+// elementSetSources resolves the element set source configured for a context.
+type elementSetSources interface {
+	ForContext(contextName string) elementsets.ElementSetSource
+}
+
 type TleService struct {
 	celestrackClient celestrackClient
+	sources          elementSetSources
 	tleRepo          repository.TleRepository
 	contextRepo      domain.GameContextRepository
+	syncRepo         domain.SyncStateRepository
 }
 
 // NewTleService creates a new instance of TleService.
 func NewTleService(
 	celestrackClient celestrackClient,
+	sources elementSetSources,
 	tleRepo repository.TleRepository,
 	contextRepo domain.GameContextRepository,
+	syncRepo domain.SyncStateRepository,
 ) TleService {
 	return TleService{
 		celestrackClient: celestrackClient,
+		sources:          sources,
 		tleRepo:          tleRepo,
 		contextRepo:      contextRepo,
+		syncRepo:         syncRepo,
 	}
 }
 
-// FetchTLEFromSatCatByCategory fetches TLEs from a given category and associates them with a context.
-func (s *TleService) FetchTLEFromSatCatByCategory(ctx context.Context, category string, contextName domain.GameContextName) (ts []domain.TLE, err error) {
+// FetchTLEFromSatCatByCategory fetches TLEs from a given category of the element set source of a context
+// and associates them with the context. The fetch is compared with the last one recorded for the group,
+// through HTTP validators when the source supports conditional requests and through the payload hash
+// otherwise, and reported unchanged when they match. Callers record the returned state with
+// RecordElementSetFetch once the TLEs are stored, which also consumes the files of a drop directory.
+func (s *TleService) FetchTLEFromSatCatByCategory(ctx context.Context, category string, contextName domain.GameContextName) (fetch domain.ElementSetFetch, err error) {
 	ctx, span := tracing.NewSpan(ctx, "FetchTLEFromSatCatByCategory")
 	defer span.EndWithError(err)
 	// Validate the contextID
 	if _, err := s.contextRepo.FindByUniqueName(ctx, contextName); err != nil {
-		return nil, fmt.Errorf("invalid contextID: %w", err)
+		return domain.ElementSetFetch{}, fmt.Errorf("invalid contextID: %w", err)
 	}
 
 	nowUtc := time.Now().UTC()
 
-	// Fetch raw TLEs from the external service
-	rawTLEs, err := s.celestrackClient.FetchTLEFromSatCatByCategory(ctx, category)
+	// Fetch raw TLEs from the source configured for the context
+	source := s.sources.ForContext(string(contextName))
+	scope := string(contextName) + ":" + category
+	previous, err := s.syncRepo.Find(ctx, source.Name(), scope)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch TLEs from category [%s]: %w", category, err)
+		return domain.ElementSetFetch{}, err
+	}
+
+	state, err := domain.NewSyncState(source.Name(), scope, "", nowUtc)
+	if err != nil {
+		return domain.ElementSetFetch{}, err
+	}
+
+	var rawTLEs []*mappers.RawTLE
+	var files []string
+	notModified := false
+	if conditional, ok := source.(elementsets.ConditionalSource); ok {
+		group, err := conditional.FetchGroup(ctx, category, celestrack.Validators{ETag: previous.ETag, LastModified: previous.LastModified})
+		if err != nil {
+			return domain.ElementSetFetch{}, fmt.Errorf("failed to fetch TLEs from category [%s] of %s: %w", category, source.Name(), err)
+		}
+		rawTLEs = group.TLEs
+		notModified = group.NotModified
+		state.ETag = group.Validators.ETag
+		state.LastModified = group.Validators.LastModified
+	} else if consuming, ok := source.(elementsets.ConsumingSource); ok {
+		rawTLEs, files, err = consuming.FetchFiles(ctx, category)
+		if err != nil {
+			return domain.ElementSetFetch{}, fmt.Errorf("failed to fetch TLEs from category [%s] of %s: %w", category, source.Name(), err)
+		}
+	} else {
+		rawTLEs, err = source.Fetch(ctx, category)
+		if err != nil {
+			return domain.ElementSetFetch{}, fmt.Errorf("failed to fetch TLEs from category [%s] of %s: %w", category, source.Name(), err)
+		}
+	}
+	state.PayloadHash = hashElementSets(rawTLEs)
+
+	fetch = domain.ElementSetFetch{
+		Changed: !notModified && state.PayloadHash != previous.PayloadHash,
+		State:   state,
+		Context: contextName,
+		Files:   files,
+	}
+	if !fetch.Changed {
+		// Nothing will be stored, so the files repeating the last ingestion are consumed right away
+		if err := s.commitFiles(ctx, fetch); err != nil {
+			return domain.ElementSetFetch{}, err
+		}
+		return fetch, nil
 	}
 
 	tles := make([]domain.TLE, len(rawTLEs))
 	for idx, raw := range rawTLEs {
+		// Display the object name of 3LE sets, fall back on the context for 2LE sets
+		displayName := raw.Name
+		if displayName == "" {
+			displayName = string(contextName)
+		}
 		tle, err := domain.NewTLE(
 			raw.NoradID,
 			raw.Line1,
 			raw.Line2,
 			nowUtc,
-			string(contextName), // Associate with the context
+			displayName,
 			true,
 			false,
 		)
 
 		if err != nil {
-			return nil, fmt.Errorf("error creating TLE for NORAD ID [%s]: %w", raw.NoradID, err)
+			return domain.ElementSetFetch{}, fmt.Errorf("error creating TLE for NORAD ID [%s]: %w", raw.NoradID, err)
 		}
+		tle.Name = raw.Name
+		tle.SetProvenance(source.Name(), nowUtc)
 		tles[idx] = tle
 	}
+	fetch.TLEs = tles
 
-	return tles, nil
+	return fetch, nil
+}
+
+// RecordElementSetFetch stores the last-fetch record of a group, so that the next fetch can be skipped when nothing changed,
+// and consumes the files the element sets were read from.
+func (s *TleService) RecordElementSetFetch(ctx context.Context, fetch domain.ElementSetFetch) (err error) {
+	ctx, span := tracing.NewSpan(ctx, "RecordElementSetFetch")
+	defer span.EndWithError(err)
+
+	if err := s.syncRepo.Save(ctx, fetch.State); err != nil {
+		return fmt.Errorf("failed to record fetch of %s: %w", fetch.State.Scope, err)
+	}
+	return s.commitFiles(ctx, fetch)
+}
+
+// commitFiles consumes the files read by a fetch from a consuming source.
+func (s *TleService) commitFiles(ctx context.Context, fetch domain.ElementSetFetch) error {
+	if len(fetch.Files) == 0 {
+		return nil
+	}
+	consuming, ok := s.sources.ForContext(string(fetch.Context)).(elementsets.ConsumingSource)
+	if !ok {
+		return nil
+	}
+	if err := consuming.Commit(ctx, fetch.Files); err != nil {
+		return fmt.Errorf("failed to consume the files of %s: %w", fetch.State.Scope, err)
+	}
+	return nil
+}
+
+// hashElementSets computes the SHA-256 of the element set lines, in source order.
+func hashElementSets(rawTLEs []*mappers.RawTLE) string {
+	hash := sha256.New()
+	for _, raw := range rawTLEs {
+		hash.Write([]byte(raw.Name + "\n" + raw.Line1 + "\n" + raw.Line2 + "\n"))
+	}
+	return hex.EncodeToString(hash.Sum(nil))
 }
 
 // FetchSatelliteMetadata retrieves metadata about satellites and associates them with a context.
@@ -88,19 +197,11 @@ func (s *TleService) FetchSatelliteMetadata(ctx context.Context, contextName dom
 		return nil, fmt.Errorf("failed to fetch satellite metadata: %w", err)
 	}
 
-	nowUtc := time.Now().UTC()
 	satellites := make([]domain.Satellite, len(metadata))
 	for idx, raw := range metadata {
-		sat := domain.Satellite{
-			NoradID:    raw.NoradID,
-			Name:       raw.Name,
-			Owner:      raw.Owner,
-			LaunchDate: &raw.LaunchDate,
-			DecayDate:  raw.DecayDate,
-			ObjectType: raw.ObjectType,
-			ModelBase: domain.ModelBase{
-				CreatedAt: nowUtc,
-			},
+		sat, err := satelliteFromMetadata(raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create satellite for NORAD ID %s: %w", raw.NoradID, err)
 		}
 		satellites[idx] = sat
 	}
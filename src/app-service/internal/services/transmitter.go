@@ -0,0 +1,172 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/clients/satnogs"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"github.com/Elbujito/2112/src/app-service/pkg/tracing"
+)
+
+// TransmitterService manages the radio transmitters of satellites.
+type TransmitterService struct {
+	repo          domain.TransmitterRepository
+	satelliteRepo domain.SatelliteRepository
+}
+
+// NewTransmitterService creates a new instance of TransmitterService.
+func NewTransmitterService(repo domain.TransmitterRepository, satelliteRepo domain.SatelliteRepository) TransmitterService {
+	return TransmitterService{repo: repo, satelliteRepo: satelliteRepo}
+}
+
+// List retrieves the transmitters of a satellite.
+func (s *TransmitterService) List(ctx context.Context, noradID string) (transmitters []domain.Transmitter, err error) {
+	ctx, span := tracing.NewSpan(ctx, "ListTransmitters")
+	defer span.EndWithError(err)
+	return s.repo.FindByNoradID(ctx, noradID)
+}
+
+// Get retrieves a transmitter by ID.
+func (s *TransmitterService) Get(ctx context.Context, id string) (transmitter domain.Transmitter, err error) {
+	ctx, span := tracing.NewSpan(ctx, "GetTransmitter")
+	defer span.EndWithError(err)
+	return s.repo.FindByID(ctx, id)
+}
+
+// Create adds a transmitter to a satellite.
+func (s *TransmitterService) Create(ctx context.Context, input domain.Transmitter) (transmitter domain.Transmitter, err error) {
+	ctx, span := tracing.NewSpan(ctx, "CreateTransmitter")
+	defer span.EndWithError(err)
+
+	if _, err := s.satelliteRepo.FindByNoradID(ctx, input.NoradID); err != nil {
+		return domain.Transmitter{}, fmt.Errorf("failed to find satellite %s: %w", input.NoradID, err)
+	}
+
+	status := input.Status
+	if status == "" {
+		status = domain.TransmitterActive
+	}
+
+	transmitter, err = domain.NewTransmitter(
+		input.NoradID,
+		"", // Not imported
+		input.Description,
+		input.Type,
+		input.Uplink,
+		input.Downlink,
+		input.Mode,
+		input.UplinkMode,
+		input.Baud,
+		input.Invert,
+		status,
+		input.Service,
+	)
+	if err != nil {
+		return domain.Transmitter{}, err
+	}
+
+	if err := s.repo.Save(ctx, transmitter); err != nil {
+		return domain.Transmitter{}, fmt.Errorf("failed to save transmitter: %w", err)
+	}
+	return transmitter, nil
+}
+
+// Update replaces the frequencies, modes and status of a transmitter. The status is kept when none is given.
+func (s *TransmitterService) Update(ctx context.Context, id string, input domain.Transmitter) (transmitter domain.Transmitter, err error) {
+	ctx, span := tracing.NewSpan(ctx, "UpdateTransmitter")
+	defer span.EndWithError(err)
+
+	transmitter, err = s.repo.FindByID(ctx, id)
+	if err != nil {
+		return domain.Transmitter{}, err
+	}
+
+	nowUtc := time.Now().UTC()
+	transmitter.Description = input.Description
+	transmitter.DisplayName = input.Description
+	transmitter.Type = input.Type
+	transmitter.Uplink = input.Uplink
+	transmitter.Downlink = input.Downlink
+	transmitter.Mode = input.Mode
+	transmitter.UplinkMode = input.UplinkMode
+	transmitter.Baud = input.Baud
+	transmitter.Invert = input.Invert
+	transmitter.Service = input.Service
+	if input.Status != "" {
+		transmitter.Status = input.Status
+		transmitter.IsActive = input.Status == domain.TransmitterActive
+	}
+	transmitter.UpdatedAt = &nowUtc
+	if err := transmitter.Validate(); err != nil {
+		return domain.Transmitter{}, err
+	}
+
+	if err := s.repo.Update(ctx, transmitter); err != nil {
+		return domain.Transmitter{}, err
+	}
+	return transmitter, nil
+}
+
+// Delete removes a transmitter.
+func (s *TransmitterService) Delete(ctx context.Context, id string) (err error) {
+	ctx, span := tracing.NewSpan(ctx, "DeleteTransmitter")
+	defer span.EndWithError(err)
+	return s.repo.DeleteByID(ctx, id)
+}
+
+// ImportSatnogs imports a SatNOGS DB transmitters dump from a local file. Transmitters already imported are
+// updated in place. Entries of satellites missing from the catalog are skipped, and only the last entry
+// of a UUID listed several times is kept.
+func (s *TransmitterService) ImportSatnogs(ctx context.Context, path string) (result domain.TransmitterImport, err error) {
+	ctx, span := tracing.NewSpan(ctx, "ImportSatnogsTransmitters")
+	defer span.EndWithError(err)
+
+	records, err := satnogs.LoadTransmitters(path)
+	if err != nil {
+		return domain.TransmitterImport{}, err
+	}
+
+	satellites, err := s.satelliteRepo.FindAll(ctx)
+	if err != nil {
+		return domain.TransmitterImport{}, fmt.Errorf("failed to fetch satellites: %w", err)
+	}
+	known := make(map[string]bool, len(satellites))
+	for _, satellite := range satellites {
+		known[satellite.NoradID] = true
+	}
+
+	// The batch upsert cannot touch the same external ID twice
+	var transmitters []domain.Transmitter
+	positions := make(map[string]int, len(records))
+	for _, record := range records {
+		if !known[record.NoradID()] || record.UUID == "" {
+			result.Skipped++
+			continue
+		}
+		transmitter, err := record.ToTransmitter()
+		if err != nil {
+			log.Printf("Skipping transmitter %s: %v", record.UUID, err)
+			result.Skipped++
+			continue
+		}
+		if position, ok := positions[record.UUID]; ok {
+			log.Printf("Replacing duplicate transmitter %s", record.UUID)
+			transmitters[position] = transmitter
+			result.Skipped++
+			continue
+		}
+		positions[record.UUID] = len(transmitters)
+		transmitters = append(transmitters, transmitter)
+	}
+
+	if err := s.repo.SaveBatch(ctx, transmitters); err != nil {
+		return domain.TransmitterImport{}, fmt.Errorf("failed to save transmitters: %w", err)
+	}
+	result.Imported = len(transmitters)
+
+	log.Printf("Imported %d transmitters from %s, skipped %d", result.Imported, path, result.Skipped)
+	return result, nil
+}
@@ -2,34 +2,47 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"log"
 	"strconv"
+	"time"
 
+	"github.com/Elbujito/2112/src/app-service/internal/clients/redis"
 	"github.com/Elbujito/2112/src/app-service/internal/domain"
 )
 
+// satelliteCatalogEventsChannel carries the satellites created, updated or decayed in the catalog.
+const satelliteCatalogEventsChannel = "satellite_catalog_events"
+
 type SatelliteServiceClient interface {
-	FetchAndStoreAllSatellites(ctx context.Context, maxCount int) ([]domain.Satellite, error)
+	SyncSatelliteCatalog(ctx context.Context, maxCount int) ([]domain.SatelliteCatalogEvent, error)
 }
 
 type CelesTrackSatelliteUploadHandler struct {
 	satelliteRepo    domain.SatelliteRepository
 	satelliteService SatelliteServiceClient
+	launchService    LaunchServiceClient
+	redisClient      *redis.RedisClient
 }
 
 func NewCelesTrackSatelliteUploadHandler(
 	satelliteRepo domain.SatelliteRepository,
-	satelliteService SatelliteServiceClient) CelesTrackSatelliteUploadHandler {
+	satelliteService SatelliteServiceClient,
+	launchService LaunchServiceClient,
+	redisClient *redis.RedisClient) CelesTrackSatelliteUploadHandler {
 	return CelesTrackSatelliteUploadHandler{
 		satelliteRepo:    satelliteRepo,
 		satelliteService: satelliteService,
+		launchService:    launchService,
+		redisClient:      redisClient,
 	}
 }
 
 func (h *CelesTrackSatelliteUploadHandler) GetTask() Task {
 	return Task{
 		Name:         "celestrack_satellite_upload",
-		Description:  "Fetch Satellite Metadata from CelesTrak and upsert it in the database",
+		Description:  "Fetch Satellite Metadata from CelesTrak, store the new and changed entries and publish created/updated/decayed events, then flag fragmented launches",
 		RequiredArgs: []string{"maxCount"},
 	}
 }
@@ -47,10 +60,47 @@ func (h *CelesTrackSatelliteUploadHandler) Run(ctx context.Context, args map[str
 		return fmt.Errorf("invalid value for max: %v", err)
 	}
 
-	_, err = h.satelliteService.FetchAndStoreAllSatellites(ctx, maxCount)
+	events, err := h.satelliteService.SyncSatelliteCatalog(ctx, maxCount)
 	if err != nil {
 		return err
 	}
 
+	counts, err := publishSatelliteCatalogEvents(ctx, h.redisClient, events)
+	if err != nil {
+		return err
+	}
+
+	// New pieces of an already cataloged launch may reveal a breakup
+	if counts[domain.SatelliteCreated] > 0 {
+		return detectFragmentations(ctx, h.launchService, h.redisClient, domain.DefaultFragmentationPolicy)
+	}
 	return nil
 }
+
+// publishSatelliteCatalogEvents publishes the catalog events and returns their count by kind.
+func publishSatelliteCatalogEvents(ctx context.Context, redisClient *redis.RedisClient, events []domain.SatelliteCatalogEvent) (map[domain.SatelliteCatalogEventKind]int, error) {
+	counts := make(map[domain.SatelliteCatalogEventKind]int)
+	for _, event := range events {
+		message, err := json.Marshal(satelliteCatalogEventMessage(event))
+		if err != nil {
+			return counts, fmt.Errorf("failed to serialize satellite catalog event: %w", err)
+		}
+		if err := redisClient.Publish(ctx, satelliteCatalogEventsChannel, message); err != nil {
+			return counts, fmt.Errorf("failed to publish satellite catalog event: %w", err)
+		}
+		counts[event.Kind]++
+	}
+
+	log.Printf("Published satellite catalog events: %d created, %d updated, %d decayed", counts[domain.SatelliteCreated], counts[domain.SatelliteUpdated], counts[domain.SatelliteDecayed])
+	return counts, nil
+}
+
+func satelliteCatalogEventMessage(event domain.SatelliteCatalogEvent) map[string]interface{} {
+	return map[string]interface{}{
+		"kind":        string(event.Kind),
+		"satelliteId": event.NoradID,
+		"name":        event.Name,
+		"fields":      event.Fields,
+		"timestamp":   event.OccurredAt.Format(time.RFC3339),
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/Elbujito/2112/src/app-service/internal/domain"
@@ -13,30 +12,49 @@ import (
 )
 
 type TleServiceClient interface {
-	FetchTLEFromSatCatByCategory(ctx context.Context, category string, contextName domain.GameContextName) ([]domain.TLE, error)
+	FetchTLEFromSatCatByCategory(ctx context.Context, category string, contextName domain.GameContextName) (domain.ElementSetFetch, error)
+	RecordElementSetFetch(ctx context.Context, fetch domain.ElementSetFetch) error
+}
+
+type ElementSetServiceClient interface {
+	ResolveCurrent(ctx context.Context, noradIDs []string) error
+}
+
+type SatelliteEnsureServiceClient interface {
+	EnsureSatellites(ctx context.Context, tles []domain.TLE) error
+}
+
+type SatelliteTagServiceClient interface {
+	TagFromSourceGroup(ctx context.Context, group string, noradIDs []string, complete bool) error
 }
 
 type CelestrackTleUploadHandler struct {
-	satelliteRepo domain.SatelliteRepository
-	tleRepo       repository.TleRepository
-	tleService    TleServiceClient
+	satelliteService  SatelliteEnsureServiceClient
+	tleRepo           repository.TleRepository
+	tleService        TleServiceClient
+	elementSetService ElementSetServiceClient
+	tagService        SatelliteTagServiceClient
 }
 
 func NewCelestrackTleUploadHandler(
-	satelliteRepo domain.SatelliteRepository,
+	satelliteService SatelliteEnsureServiceClient,
 	tleRepo repository.TleRepository,
-	tleService TleServiceClient) CelestrackTleUploadHandler {
+	tleService TleServiceClient,
+	elementSetService ElementSetServiceClient,
+	tagService SatelliteTagServiceClient) CelestrackTleUploadHandler {
 	return CelestrackTleUploadHandler{
-		satelliteRepo: satelliteRepo,
-		tleRepo:       tleRepo,
-		tleService:    tleService,
+		satelliteService:  satelliteService,
+		tleRepo:           tleRepo,
+		tleService:        tleService,
+		elementSetService: elementSetService,
+		tagService:        tagService,
 	}
 }
 
 func (h *CelestrackTleUploadHandler) GetTask() Task {
 	return Task{
 		Name:         "celestrack_tle_upload",
-		Description:  "Fetch TLE from CelesTrak and upsert it in the database",
+		Description:  "Fetch TLE from the element set source of the context (CelesTrak unless configured otherwise) and upsert it in the database",
 		RequiredArgs: []string{"category", "maxCount", "contextName"},
 	}
 }
@@ -64,56 +82,63 @@ func (h *CelestrackTleUploadHandler) Run(ctx context.Context, args map[string]st
 		return fmt.Errorf("invalid value for max: %v", err)
 	}
 
-	tles, err := h.tleService.FetchTLEFromSatCatByCategory(ctx, category, domain.GameContextName(contextName))
+	fetch, err := h.tleService.FetchTLEFromSatCatByCategory(ctx, category, domain.GameContextName(contextName))
 	if err != nil {
 		return fmt.Errorf("failed to fetch TLE catalog for category %s: %v", category, err)
 	}
+	if !fetch.Changed {
+		// Nothing to upsert, so nothing to re-propagate
+		log.Printf("TLE catalog for category %s unchanged since %s, skipping", category, fetch.State.SyncedAt.Format(time.RFC3339))
+		return nil
+	}
+	tles := fetch.TLEs
 
 	// Retain only the maximum nbTles elements
-	if len(tles) > maxCount {
+	truncated := len(tles) > maxCount
+	if truncated {
 		tles = tles[:maxCount] // Slice to keep only the first maxCount elements
 	}
 
-	// Ensure satellites exist for the batch
-	// for _, tle := range tles {
-	// 	if err := h.ensureSatelliteExists(ctx, tle.NoradID, category); err != nil {
-	// 		return fmt.Errorf("failed to ensure satellite existence for NORAD ID %s: %v", tle.NoradID, err)
-	// 	}
-	// }
-
 	log.Printf("Returning %d TLEs for category %s", len(tles), category)
-	err = h.tleRepo.UpdateTleBatch(ctx, tles)
-	if err != nil {
-		return fmt.Errorf("failed to upsert TLE for NORAD ID %s", err)
+	if len(tles) > 0 {
+		if err := storeElementSets(ctx, h.satelliteService, h.tleRepo, h.elementSetService, tles); err != nil {
+			return err
+		}
+		if err := h.tagService.TagFromSourceGroup(ctx, category, tleNoradIDs(tles), fetch.Complete() && !truncated); err != nil {
+			return fmt.Errorf("failed to tag satellites of category %s: %v", category, err)
+		}
 	}
-	return nil
-}
-
-func (h *CelestrackTleUploadHandler) ensureSatelliteExists(ctx context.Context, noradID string, category string) error {
-	satellite, err := h.satelliteRepo.FindByNoradID(ctx, noradID)
-	if err == nil && satellite.NoradID == noradID {
+	if truncated {
+		// The validators and the dropped files cover the whole group, recording them would skip the rest of it next time
+		log.Printf("Stored %d of %d TLEs for category %s, leaving the fetch unrecorded", len(tles), len(fetch.TLEs), category)
 		return nil
 	}
+	return h.tleService.RecordElementSetFetch(ctx, fetch)
+}
 
-	nowUtc := time.Now().UTC()
-
-	newSatellite, err := domain.NewSatellite(
-		fmt.Sprintf("Unknown Satellite %s", noradID),
-		noradID,
-		domain.SatelliteType(strings.ToUpper(category)),
-		true,  //active by default
-		false, // not in favourite
-		nowUtc,
-	)
-
-	if err != nil {
-		return err
+// storeElementSets links each TLE to its satellite, creating the satellite when missing, upserts the TLEs and
+// selects the current element set of each satellite, which also refreshes its orbital parameters and regime.
+func storeElementSets(ctx context.Context, satelliteService SatelliteEnsureServiceClient, tleRepo repository.TleRepository, elementSetService ElementSetServiceClient, tles []domain.TLE) error {
+	if err := satelliteService.EnsureSatellites(ctx, tles); err != nil {
+		return fmt.Errorf("failed to ensure satellite existence: %v", err)
 	}
 
-	err = h.satelliteRepo.Save(ctx, newSatellite)
-	if err != nil {
-		return fmt.Errorf("failed to create satellite: %v", err)
+	if err := tleRepo.UpdateTleBatch(ctx, tles); err != nil {
+		return fmt.Errorf("failed to upsert TLE for NORAD ID %s", err)
 	}
 
+	if err := elementSetService.ResolveCurrent(ctx, tleNoradIDs(tles)); err != nil {
+		return fmt.Errorf("failed to select current element sets: %v", err)
+	}
 	return nil
 }
+
+// tleNoradIDs returns the distinct NORAD IDs of the TLEs.
+func tleNoradIDs(tles []domain.TLE) []string {
+	latest := domain.LatestTLEByNoradID(tles)
+	noradIDs := make([]string, 0, len(latest))
+	for noradID := range latest {
+		noradIDs = append(noradIDs, noradID)
+	}
+	return noradIDs
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+const (
+	defaultCoverageMinElevation = 10.0
+	defaultCoverageBandWidth    = 10.0
+	defaultCoverageStepSeconds  = 60
+)
+
+type ConstellationServiceClient interface {
+	ComputeCoverage(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, id string, minElevation float64, bandWidth float64, start time.Time, end time.Time, step time.Duration) (domain.ConstellationCoverageReport, error)
+}
+
+type ConstellationCoverageHandler struct {
+	constellationService ConstellationServiceClient
+}
+
+func NewConstellationCoverageHandler(constellationService ConstellationServiceClient) ConstellationCoverageHandler {
+	return ConstellationCoverageHandler{
+		constellationService: constellationService,
+	}
+}
+
+func (h *ConstellationCoverageHandler) GetTask() Task {
+	return Task{
+		Name:         "constellation_coverage",
+		Description:  "Compute revisit, max gap and coverage per latitude band of a constellation over the context tiles (optional args: minElevation, bandWidth in degrees, stepSeconds)",
+		RequiredArgs: []string{"contextName", "tenantID", "constellationID", "hours"},
+	}
+}
+
+func (h *ConstellationCoverageHandler) Run(ctx context.Context, args map[string]string) error {
+	contextName, ok := args["contextName"]
+	if !ok || contextName == "" {
+		return fmt.Errorf("missing required argument: contextName")
+	}
+	constellationID, ok := args["constellationID"]
+	if !ok || constellationID == "" {
+		return fmt.Errorf("missing required argument: constellationID")
+	}
+
+	hours, err := ParseIntArg(args, "hours")
+	if err != nil {
+		return err
+	}
+
+	minElevation := defaultCoverageMinElevation
+	if value := args["minElevation"]; value != "" {
+		minElevation, err = strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid value for minElevation: %v", err)
+		}
+	}
+
+	bandWidth := defaultCoverageBandWidth
+	if value := args["bandWidth"]; value != "" {
+		bandWidth, err = strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid value for bandWidth: %v", err)
+		}
+	}
+
+	stepSeconds := defaultCoverageStepSeconds
+	if args["stepSeconds"] != "" {
+		stepSeconds, err = ParseIntArg(args, "stepSeconds")
+		if err != nil {
+			return err
+		}
+	}
+
+	start := time.Now().UTC()
+	end := start.Add(time.Duration(hours) * time.Hour)
+	report, err := h.constellationService.ComputeCoverage(
+		ctx,
+		domain.GameContextName(contextName),
+		domain.TenantID(args["tenantID"]),
+		constellationID,
+		minElevation,
+		bandWidth,
+		start,
+		end,
+		time.Duration(stepSeconds)*time.Second,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to compute constellation coverage: %v", err)
+	}
+
+	log.Printf("Constellation %s covers %.1f%% of %d tiles (mean revisit %.1f min, max gap %.1f min)",
+		constellationID, report.CoverageFraction*100, report.Tiles, report.MeanRevisitMinutes, report.MaxGapMinutes)
+	return nil
+}
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+type ContactSchedulerServiceClient interface {
+	SchedulePlan(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, requests []domain.ContactRequest, stationIDs []string, start time.Time, end time.Time, setupMargin time.Duration, teardownMargin time.Duration) (domain.ContactPlan, error)
+}
+
+type ContactScheduleHandler struct {
+	schedulerService ContactSchedulerServiceClient
+}
+
+func NewContactScheduleHandler(schedulerService ContactSchedulerServiceClient) ContactScheduleHandler {
+	return ContactScheduleHandler{
+		schedulerService: schedulerService,
+	}
+}
+
+func (h *ContactScheduleHandler) GetTask() Task {
+	return Task{
+		Name:         "contact_schedule",
+		Description:  "Build a conflict-free contact plan for the ground stations of a context (optional args: priorities as noradID:priority list, stations, setupSeconds, teardownSeconds, icsFile, jsonFile)",
+		RequiredArgs: []string{"contextName", "tenantID", "hours"},
+	}
+}
+
+func (h *ContactScheduleHandler) Run(ctx context.Context, args map[string]string) error {
+	contextName, ok := args["contextName"]
+	if !ok || contextName == "" {
+		return fmt.Errorf("missing required argument: contextName")
+	}
+
+	hours, err := ParseIntArg(args, "hours")
+	if err != nil {
+		return err
+	}
+
+	requests, err := ParseContactRequests(args["priorities"])
+	if err != nil {
+		return err
+	}
+
+	var stationIDs []string
+	if stations := args["stations"]; stations != "" {
+		stationIDs = strings.Split(stations, ",")
+	}
+
+	setupSeconds, err := parseOptionalSeconds(args, "setupSeconds")
+	if err != nil {
+		return err
+	}
+	teardownSeconds, err := parseOptionalSeconds(args, "teardownSeconds")
+	if err != nil {
+		return err
+	}
+
+	start := time.Now().UTC()
+	end := start.Add(time.Duration(hours) * time.Hour)
+	plan, err := h.schedulerService.SchedulePlan(ctx, domain.GameContextName(contextName), domain.TenantID(args["tenantID"]), requests, stationIDs, start, end, setupSeconds, teardownSeconds)
+	if err != nil {
+		return fmt.Errorf("failed to schedule contacts: %v", err)
+	}
+
+	if path := args["icsFile"]; path != "" {
+		if err := os.WriteFile(path, []byte(plan.ICalendar()), 0o644); err != nil {
+			return fmt.Errorf("failed to write iCalendar file: %v", err)
+		}
+	}
+	if path := args["jsonFile"]; path != "" {
+		content, err := json.MarshalIndent(plan, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode contact plan: %v", err)
+		}
+		if err := os.WriteFile(path, content, 0o644); err != nil {
+			return fmt.Errorf("failed to write JSON file: %v", err)
+		}
+	}
+
+	log.Printf("Scheduled %d contacts for context %s (%d conflicting passes dropped)", len(plan.Contacts), contextName, plan.Rejected)
+	return nil
+}
+
+// ParseContactRequests parses a comma separated list of noradID:priority pairs. A missing priority defaults to 1.
+func ParseContactRequests(value string) ([]domain.ContactRequest, error) {
+	var requests []domain.ContactRequest
+	if value == "" {
+		return requests, nil
+	}
+	for _, entry := range strings.Split(value, ",") {
+		parts := strings.SplitN(strings.TrimSpace(entry), ":", 2)
+		request := domain.ContactRequest{NoradID: parts[0], Priority: 1}
+		if request.NoradID == "" {
+			return nil, fmt.Errorf("invalid contact request: %q", entry)
+		}
+		if len(parts) == 2 {
+			priority, err := strconv.Atoi(parts[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid priority for NORAD ID %s: %v", request.NoradID, err)
+			}
+			request.Priority = priority
+		}
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
+
+func parseOptionalSeconds(args map[string]string, key string) (time.Duration, error) {
+	value, ok := args[key]
+	if !ok || value == "" {
+		return 0, nil
+	}
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return 0, fmt.Errorf("invalid value for %s: %s", key, value)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+)
+
+type DecayServiceClient interface {
+	LoadSpaceWeather(path string) (xspace.SpaceWeather, error)
+	PredictDecays(ctx context.Context, maxPerigee float64, historyWindow time.Duration, weather xspace.SpaceWeather) ([]domain.DecayPrediction, error)
+	MarkDecayedSatellites(ctx context.Context) (int, error)
+}
+
+type DecayPredictionHandler struct {
+	decayService DecayServiceClient
+}
+
+func NewDecayPredictionHandler(decayService DecayServiceClient) DecayPredictionHandler {
+	return DecayPredictionHandler{
+		decayService: decayService,
+	}
+}
+
+func (h *DecayPredictionHandler) GetTask() Task {
+	return Task{
+		Name:         "decay_prediction",
+		Description:  "Predict reentry windows of low perigee satellites and mark decayed ones (optional arg: spaceWeatherFile)",
+		RequiredArgs: []string{"maxPerigee", "historyDays"},
+	}
+}
+
+func (h *DecayPredictionHandler) Run(ctx context.Context, args map[string]string) error {
+	maxPerigeeStr, ok := args["maxPerigee"]
+	if !ok || maxPerigeeStr == "" {
+		return fmt.Errorf("missing required argument: maxPerigee")
+	}
+	maxPerigee, err := strconv.ParseFloat(maxPerigeeStr, 64)
+	if err != nil {
+		return fmt.Errorf("invalid value for maxPerigee: %v", err)
+	}
+
+	historyDays, err := ParseIntArg(args, "historyDays")
+	if err != nil {
+		return err
+	}
+
+	weather, err := h.decayService.LoadSpaceWeather(args["spaceWeatherFile"])
+	if err != nil {
+		return err
+	}
+
+	predictions, err := h.decayService.PredictDecays(ctx, maxPerigee, time.Duration(historyDays)*24*time.Hour, weather)
+	if err != nil {
+		return fmt.Errorf("failed to predict decays: %v", err)
+	}
+
+	decayed, err := h.decayService.MarkDecayedSatellites(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Predicted %d reentries (F10.7 %.1f, Ap %.1f), marked %d satellites as decayed", len(predictions), weather.F107, weather.Ap, decayed)
+	return nil
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	repository "github.com/Elbujito/2112/src/app-service/internal/repositories"
+)
+
+const defaultElementSetWatchIntervalSeconds = 60
+
+type ElementSetWatchHandler struct {
+	satelliteService  SatelliteEnsureServiceClient
+	tleRepo           repository.TleRepository
+	tleService        TleServiceClient
+	elementSetService ElementSetServiceClient
+	tagService        SatelliteTagServiceClient
+}
+
+func NewElementSetWatchHandler(
+	satelliteService SatelliteEnsureServiceClient,
+	tleRepo repository.TleRepository,
+	tleService TleServiceClient,
+	elementSetService ElementSetServiceClient,
+	tagService SatelliteTagServiceClient) ElementSetWatchHandler {
+	return ElementSetWatchHandler{
+		satelliteService:  satelliteService,
+		tleRepo:           tleRepo,
+		tleService:        tleService,
+		elementSetService: elementSetService,
+		tagService:        tagService,
+	}
+}
+
+func (h *ElementSetWatchHandler) GetTask() Task {
+	return Task{
+		Name:         "element_set_watch",
+		Description:  "Poll the element set source of the context, typically a drop directory, and upsert the new TLEs (optional args: group, intervalSeconds defaults to 60)",
+		RequiredArgs: []string{"contextName"},
+	}
+}
+
+// Run polls the source until the context is cancelled. A failed poll is logged and retried at the next interval.
+func (h *ElementSetWatchHandler) Run(ctx context.Context, args map[string]string) error {
+	contextName, ok := args["contextName"]
+	if !ok || contextName == "" {
+		return fmt.Errorf("missing required argument: contextName")
+	}
+
+	intervalSeconds := defaultElementSetWatchIntervalSeconds
+	if args["intervalSeconds"] != "" {
+		var err error
+		intervalSeconds, err = ParseIntArg(args, "intervalSeconds")
+		if err != nil {
+			return err
+		}
+		if intervalSeconds <= 0 {
+			return fmt.Errorf("intervalSeconds must be greater than zero")
+		}
+	}
+
+	ticker := time.NewTicker(time.Duration(intervalSeconds) * time.Second)
+	defer ticker.Stop()
+
+	log.Printf("Watching element sets of context %s every %d seconds", contextName, intervalSeconds)
+	for {
+		if err := h.Exec(ctx, domain.GameContextName(contextName), args["group"]); err != nil {
+			log.Printf("Failed to ingest element sets of context %s: %v", contextName, err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			log.Println("Element set watch shutting down due to context cancellation")
+			return nil
+		}
+	}
+}
+
+// Exec fetches the element sets of a group from the source of a context and stores them.
+func (h *ElementSetWatchHandler) Exec(ctx context.Context, contextName domain.GameContextName, group string) error {
+	fetch, err := h.tleService.FetchTLEFromSatCatByCategory(ctx, group, contextName)
+	if err != nil {
+		return err
+	}
+	if !fetch.Changed {
+		return nil
+	}
+
+	if len(fetch.TLEs) > 0 {
+		if err := storeElementSets(ctx, h.satelliteService, h.tleRepo, h.elementSetService, fetch.TLEs); err != nil {
+			return err
+		}
+		if err := h.tagService.TagFromSourceGroup(ctx, group, tleNoradIDs(fetch.TLEs), fetch.Complete()); err != nil {
+			return err
+		}
+		log.Printf("Ingested %d TLEs for context %s", len(fetch.TLEs), contextName)
+	}
+	return h.tleService.RecordElementSetFetch(ctx, fetch)
+}
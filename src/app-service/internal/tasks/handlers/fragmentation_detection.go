@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/clients/redis"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+// fragmentationEventsChannel carries the launches flagged with a burst of new pieces.
+const fragmentationEventsChannel = "fragmentation_events"
+
+type LaunchServiceClient interface {
+	DetectFragmentations(ctx context.Context, policy domain.FragmentationPolicy) ([]domain.FragmentationEvent, error)
+}
+
+type FragmentationDetectionHandler struct {
+	launchService LaunchServiceClient
+	redisClient   *redis.RedisClient
+}
+
+func NewFragmentationDetectionHandler(launchService LaunchServiceClient, redisClient *redis.RedisClient) FragmentationDetectionHandler {
+	return FragmentationDetectionHandler{
+		launchService: launchService,
+		redisClient:   redisClient,
+	}
+}
+
+func (h *FragmentationDetectionHandler) GetTask() Task {
+	return Task{
+		Name:         "fragmentation_detection",
+		Description:  "Flag the launches with many new pieces cataloged in a short window and publish fragmentation events (optional args: minPieces defaults to 10, windowDays defaults to 7)",
+		RequiredArgs: []string{},
+	}
+}
+
+func (h *FragmentationDetectionHandler) Run(ctx context.Context, args map[string]string) error {
+	policy := domain.DefaultFragmentationPolicy
+	if args["minPieces"] != "" {
+		minPieces, err := ParseIntArg(args, "minPieces")
+		if err != nil {
+			return fmt.Errorf("invalid value for minPieces: %v", err)
+		}
+		policy.MinPieces = minPieces
+	}
+	if args["windowDays"] != "" {
+		windowDays, err := ParseIntArg(args, "windowDays")
+		if err != nil {
+			return fmt.Errorf("invalid value for windowDays: %v", err)
+		}
+		policy.Window = time.Duration(windowDays) * 24 * time.Hour
+	}
+
+	return detectFragmentations(ctx, h.launchService, h.redisClient, policy)
+}
+
+// detectFragmentations flags the fragmented launches and publishes an event for each.
+func detectFragmentations(ctx context.Context, launchService LaunchServiceClient, redisClient *redis.RedisClient, policy domain.FragmentationPolicy) error {
+	events, err := launchService.DetectFragmentations(ctx, policy)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		message, err := json.Marshal(fragmentationEventMessage(event))
+		if err != nil {
+			return fmt.Errorf("failed to serialize fragmentation event: %w", err)
+		}
+		if err := redisClient.Publish(ctx, fragmentationEventsChannel, message); err != nil {
+			return fmt.Errorf("failed to publish fragmentation event: %w", err)
+		}
+		log.Printf("Launch %s flagged with %d new pieces since %s", event.Launch, event.NewPieces, event.WindowStart.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func fragmentationEventMessage(event domain.FragmentationEvent) map[string]interface{} {
+	return map[string]interface{}{
+		"launch":       event.Launch,
+		"newPieces":    event.NewPieces,
+		"satelliteIds": event.NoradIDs,
+		"windowStart":  event.WindowStart.Format(time.RFC3339),
+		"windowEnd":    event.WindowEnd.Format(time.RFC3339),
+		"timestamp":    event.DetectedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+type GeoServiceClient interface {
+	UpdateGeoCatalog(ctx context.Context, historyWindow time.Duration) ([]domain.GeoObject, error)
+}
+
+type GeoCatalogUpdateHandler struct {
+	geoService GeoServiceClient
+}
+
+func NewGeoCatalogUpdateHandler(geoService GeoServiceClient) GeoCatalogUpdateHandler {
+	return GeoCatalogUpdateHandler{
+		geoService: geoService,
+	}
+}
+
+func (h *GeoCatalogUpdateHandler) GetTask() Task {
+	return Task{
+		Name:         "geo_catalog_update",
+		Description:  "Compute longitude, drift rate and station-keeping status of GEO satellites from their TLE history",
+		RequiredArgs: []string{"historyDays"},
+	}
+}
+
+func (h *GeoCatalogUpdateHandler) Run(ctx context.Context, args map[string]string) error {
+	historyDays, err := ParseIntArg(args, "historyDays")
+	if err != nil {
+		return err
+	}
+	if historyDays <= 0 {
+		return fmt.Errorf("invalid value for historyDays: %d", historyDays)
+	}
+
+	objects, err := h.geoService.UpdateGeoCatalog(ctx, time.Duration(historyDays)*24*time.Hour)
+	if err != nil {
+		return fmt.Errorf("failed to update GEO catalog: %v", err)
+	}
+
+	log.Printf("GEO catalog updated with %d objects", len(objects))
+	return nil
+}
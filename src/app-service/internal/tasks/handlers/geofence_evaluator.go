@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/clients/redis"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+const (
+	// geofenceEventsChannel carries the newly predicted geofence crossings to the gateway.
+	geofenceEventsChannel = "geofence_events"
+	// geofenceCrossingsKeyPrefix prefixes the cached upcoming crossings of a geofence.
+	geofenceCrossingsKeyPrefix  = "geofence_crossings:"
+	defaultGeofenceHorizonHours = 24
+)
+
+type GeofenceServiceClient interface {
+	EvaluateSatellite(ctx context.Context, noradID string, start time.Time, end time.Time) ([]domain.Geofence, []domain.GeofenceEvent, error)
+	FindUpcomingCrossings(ctx context.Context, geofenceID string, horizon time.Duration) ([]domain.GeofenceEvent, error)
+}
+
+type GeofenceEvaluatorHandler struct {
+	geofenceService GeofenceServiceClient
+	redisClient     *redis.RedisClient
+	workerCount     int
+	horizon         time.Duration
+}
+
+func NewGeofenceEvaluatorHandler(geofenceService GeofenceServiceClient, redisClient *redis.RedisClient, workerCount int) GeofenceEvaluatorHandler {
+	return GeofenceEvaluatorHandler{
+		geofenceService: geofenceService,
+		redisClient:     redisClient,
+		workerCount:     workerCount,
+		horizon:         defaultGeofenceHorizonHours * time.Hour,
+	}
+}
+
+func (h *GeofenceEvaluatorHandler) GetTask() Task {
+	return Task{
+		Name:         "geofence_evaluator",
+		Description:  "Predict geofence enter/exit events on each satellite positions update and publish them (optional arg: horizonHours of the cached upcoming crossings, defaults to 24)",
+		RequiredArgs: []string{},
+	}
+}
+
+// Run subscribes to the satellite position updates and evaluates the geofences watching each updated satellite.
+func (h *GeofenceEvaluatorHandler) Run(ctx context.Context, args map[string]string) error {
+	if args["horizonHours"] != "" {
+		hours, err := ParseIntArg(args, "horizonHours")
+		if err != nil {
+			return err
+		}
+		h.horizon = time.Duration(hours) * time.Hour
+	}
+
+	log.Println("Subscribing to event_satellite_positions_updated channel")
+	return h.Subscribe(ctx, "event_satellite_positions_updated")
+}
+
+// Exec evaluates the geofences watching a satellite, publishes the new events and refreshes the cached upcoming crossings.
+func (h *GeofenceEvaluatorHandler) Exec(ctx context.Context, noradID string, startTime time.Time, endTime time.Time) error {
+	geofences, events, err := h.geofenceService.EvaluateSatellite(ctx, noradID, startTime, endTime)
+	if err != nil {
+		return fmt.Errorf("failed to evaluate geofences for satellite %s: %w", noradID, err)
+	}
+	if len(geofences) == 0 {
+		return nil
+	}
+
+	names := make(map[string]string, len(geofences))
+	for _, geofence := range geofences {
+		names[geofence.ID] = geofence.Name
+	}
+
+	for _, event := range events {
+		message, err := json.Marshal(geofenceEventMessage(event, names[event.GeofenceID]))
+		if err != nil {
+			return fmt.Errorf("failed to serialize geofence event: %w", err)
+		}
+		if err := h.redisClient.Publish(ctx, geofenceEventsChannel, message); err != nil {
+			return fmt.Errorf("failed to publish geofence event: %w", err)
+		}
+	}
+
+	for _, geofence := range geofences {
+		upcoming, err := h.geofenceService.FindUpcomingCrossings(ctx, geofence.ID, h.horizon)
+		if err != nil {
+			return fmt.Errorf("failed to fetch upcoming crossings of geofence %s: %w", geofence.ID, err)
+		}
+
+		crossings := make([]map[string]interface{}, 0, len(upcoming))
+		for _, event := range upcoming {
+			crossings = append(crossings, geofenceEventMessage(event, geofence.Name))
+		}
+		cachedData, err := json.Marshal(crossings)
+		if err != nil {
+			return fmt.Errorf("failed to serialize upcoming crossings: %w", err)
+		}
+		key := geofenceCrossingsKeyPrefix + geofence.ID
+		if err := h.redisClient.Set(ctx, key, cachedData); err != nil {
+			return fmt.Errorf("failed to cache upcoming crossings of geofence %s: %w", geofence.ID, err)
+		}
+		if err := h.redisClient.Expire(ctx, key, h.horizon); err != nil {
+			log.Printf("Failed to set expiration for Redis key %s: %v\n", key, err)
+		}
+	}
+
+	log.Printf("Published %d geofence events for satellite %s\n", len(events), noradID)
+	return nil
+}
+
+// Subscribe listens for satellite position updates and evaluates geofences using a worker pool.
+func (h *GeofenceEvaluatorHandler) Subscribe(ctx context.Context, channel string) error {
+	messageChan := make(chan string, 100)
+	for i := 0; i < h.workerCount; i++ {
+		go h.worker(ctx, messageChan)
+	}
+
+	err := h.redisClient.Subscribe(ctx, channel, func(message string) error {
+		select {
+		case messageChan <- message:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to channel %s: %w", channel, err)
+	}
+	return nil
+}
+
+// worker processes incoming messages in the messageChan
+func (h *GeofenceEvaluatorHandler) worker(ctx context.Context, messageChan <-chan string) {
+	for {
+		select {
+		case message := <-messageChan:
+			var update struct {
+				SatelliteID string `json:"satellite_id"`
+				StartTime   string `json:"start_time"`
+				EndTime     string `json:"end_time"`
+			}
+			if err := json.Unmarshal([]byte(message), &update); err != nil {
+				log.Printf("Failed to parse update message: %v\n", err)
+				continue
+			}
+
+			startTime, err := time.Parse(time.RFC3339, update.StartTime)
+			if err != nil {
+				log.Printf("Failed to parse start time: %v\n", err)
+				continue
+			}
+			endTime, err := time.Parse(time.RFC3339, update.EndTime)
+			if err != nil {
+				log.Printf("Failed to parse end time: %v\n", err)
+				continue
+			}
+
+			if err := h.Exec(ctx, update.SatelliteID, startTime, endTime); err != nil {
+				log.Printf("Failed to evaluate geofences for satellite ID %s: %v\n", update.SatelliteID, err)
+			}
+
+		case <-ctx.Done():
+			log.Println("Worker shutting down due to context cancellation")
+			return
+		}
+	}
+}
+
+// geofenceEventMessage serializes an event in the shape of the GraphQL GeofenceEvent type.
+func geofenceEventMessage(event domain.GeofenceEvent, geofenceName string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":           event.ID,
+		"geofenceId":   event.GeofenceID,
+		"geofenceName": geofenceName,
+		"satelliteId":  event.NoradID,
+		"kind":         string(event.Kind),
+		"timestamp":    event.OccurredAt.Format(time.RFC3339),
+		"latitude":     event.Latitude,
+		"longitude":    event.Longitude,
+	}
+}
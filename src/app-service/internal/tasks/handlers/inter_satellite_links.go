@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xspace"
+)
+
+type LinkServiceClient interface {
+	ComputeLinkWindows(ctx context.Context, contextName domain.GameContextName, grazingAltitudeKm float64, maxRangeKm float64, start time.Time, end time.Time) ([]domain.LinkWindow, error)
+}
+
+type LinkWindowsHandler struct {
+	linkService LinkServiceClient
+}
+
+func NewLinkWindowsHandler(linkService LinkServiceClient) LinkWindowsHandler {
+	return LinkWindowsHandler{
+		linkService: linkService,
+	}
+}
+
+func (h *LinkWindowsHandler) GetTask() Task {
+	return Task{
+		Name:         "link_windows",
+		Description:  "Compute inter-satellite link windows for all satellite pairs of a context (optional args: grazingAltitude, maxRange in km)",
+		RequiredArgs: []string{"contextName", "hours"},
+	}
+}
+
+func (h *LinkWindowsHandler) Run(ctx context.Context, args map[string]string) error {
+	contextName, ok := args["contextName"]
+	if !ok || contextName == "" {
+		return fmt.Errorf("missing required argument: contextName")
+	}
+
+	hours, err := ParseIntArg(args, "hours")
+	if err != nil {
+		return err
+	}
+
+	grazingAltitude := xspace.DEFAULT_GRAZING_ALTITUDE_KM
+	if value := args["grazingAltitude"]; value != "" {
+		grazingAltitude, err = strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid value for grazingAltitude: %v", err)
+		}
+	}
+
+	maxRange := 0.0
+	if value := args["maxRange"]; value != "" {
+		maxRange, err = strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid value for maxRange: %v", err)
+		}
+	}
+
+	start := time.Now().UTC()
+	end := start.Add(time.Duration(hours) * time.Hour)
+	windows, err := h.linkService.ComputeLinkWindows(ctx, domain.GameContextName(contextName), grazingAltitude, maxRange, start, end)
+	if err != nil {
+		return fmt.Errorf("failed to compute link windows: %v", err)
+	}
+
+	log.Printf("Computed %d link windows for context %s", len(windows), contextName)
+	return nil
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+type OrbitLightingServiceClient interface {
+	UpdateLightingSeries(ctx context.Context, regime domain.OrbitRegime, days int) (int, error)
+}
+
+type OrbitLightingUpdateHandler struct {
+	lightingService OrbitLightingServiceClient
+}
+
+func NewOrbitLightingUpdateHandler(lightingService OrbitLightingServiceClient) OrbitLightingUpdateHandler {
+	return OrbitLightingUpdateHandler{
+		lightingService: lightingService,
+	}
+}
+
+func (h *OrbitLightingUpdateHandler) GetTask() Task {
+	return Task{
+		Name:         "orbit_lighting_update",
+		Description:  "Sample LTAN/LTDN, beta angle and eclipse fraction of satellites over the next days (optional arg: orbitRegime, defaults to SSO)",
+		RequiredArgs: []string{"days"},
+	}
+}
+
+func (h *OrbitLightingUpdateHandler) Run(ctx context.Context, args map[string]string) error {
+	days, err := ParseIntArg(args, "days")
+	if err != nil {
+		return err
+	}
+
+	regime := domain.OrbitRegimeSSO
+	if value := args["orbitRegime"]; value != "" {
+		regime = domain.OrbitRegime(value)
+		if err := regime.IsValid(); err != nil {
+			return err
+		}
+	}
+
+	count, err := h.lightingService.UpdateLightingSeries(ctx, regime, days)
+	if err != nil {
+		return fmt.Errorf("failed to update orbit lighting: %v", err)
+	}
+
+	log.Printf("Stored %d orbit lighting samples for %s satellites", count, regime)
+	return nil
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	apihandlers "github.com/Elbujito/2112/src/app-service/internal/api/handlers"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+const defaultOverflightStepSeconds = 30
+
+type OverflightServiceClient interface {
+	ComputeReport(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, areaCodes []string, noradIDs []string, start time.Time, end time.Time, step time.Duration) (domain.OverflightReport, error)
+}
+
+type OverflightReportHandler struct {
+	overflightService OverflightServiceClient
+}
+
+func NewOverflightReportHandler(overflightService OverflightServiceClient) OverflightReportHandler {
+	return OverflightReportHandler{
+		overflightService: overflightService,
+	}
+}
+
+func (h *OverflightReportHandler) GetTask() Task {
+	return Task{
+		Name:         "overflight_report",
+		Description:  "Report the entry, exit and duration of the context satellites over admin areas between start and end in RFC3339, past or future (areaCodes comma separated; optional args: noradIDs comma separated, stepSeconds)",
+		RequiredArgs: []string{"contextName", "tenantID", "areaCodes", "start", "end"},
+	}
+}
+
+func (h *OverflightReportHandler) Run(ctx context.Context, args map[string]string) error {
+	contextName, ok := args["contextName"]
+	if !ok || contextName == "" {
+		return fmt.Errorf("missing required argument: contextName")
+	}
+	areaCodes := apihandlers.SplitQueryList(args["areaCodes"])
+	if len(areaCodes) == 0 {
+		return fmt.Errorf("missing required argument: areaCodes")
+	}
+
+	start, err := time.Parse(time.RFC3339, args["start"])
+	if err != nil {
+		return fmt.Errorf("invalid value for start: %v", err)
+	}
+	end, err := time.Parse(time.RFC3339, args["end"])
+	if err != nil {
+		return fmt.Errorf("invalid value for end: %v", err)
+	}
+
+	stepSeconds := defaultOverflightStepSeconds
+	if args["stepSeconds"] != "" {
+		stepSeconds, err = ParseIntArg(args, "stepSeconds")
+		if err != nil {
+			return err
+		}
+	}
+
+	report, err := h.overflightService.ComputeReport(
+		ctx,
+		domain.GameContextName(contextName),
+		domain.TenantID(args["tenantID"]),
+		areaCodes,
+		apihandlers.SplitQueryList(args["noradIDs"]),
+		start.UTC(),
+		end.UTC(),
+		time.Duration(stepSeconds)*time.Second,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to compute overflight report: %v", err)
+	}
+
+	log.Printf("Stored overflight report %s with %d records for context %s", report.ID, len(report.Records), contextName)
+	return nil
+}
@@ -17,6 +17,7 @@ type SatellitesTilesMappingsHandler struct {
 	tleRepo       repository.TleRepository
 	satelliteRepo domain.SatelliteRepository
 	mappingRepo   domain.MappingRepository
+	sensorRepo    domain.SatelliteSensorRepository
 	redisClient   *redis.RedisClient
 	workerCount   int
 }
@@ -27,6 +28,7 @@ func NewSatellitesTilesMappingsHandler(
 	tleRepo repository.TleRepository,
 	satelliteRepo domain.SatelliteRepository,
 	mappingRepo domain.MappingRepository,
+	sensorRepo domain.SatelliteSensorRepository,
 	redisClient *redis.RedisClient,
 	workerCount int, // Number of workers
 ) SatellitesTilesMappingsHandler {
@@ -35,6 +37,7 @@ func NewSatellitesTilesMappingsHandler(
 		tleRepo:       tleRepo,
 		satelliteRepo: satelliteRepo,
 		mappingRepo:   mappingRepo,
+		sensorRepo:    sensorRepo,
 		redisClient:   redisClient,
 		workerCount:   workerCount,
 	}
@@ -96,7 +99,12 @@ func (h *SatellitesTilesMappingsHandler) computeTileMappings(
 		return fmt.Errorf("failed to delete visible tiles along the path: %w", err)
 	}
 
-	mappings, err := h.tileRepo.FindTilesVisibleFromLine(ctx, sat, positions)
+	sensor, err := h.sensorRepo.FindByNoradID(ctx, sat.NoradID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch sensor: %w", err)
+	}
+
+	mappings, err := domain.FindTilesVisibleFromTrack(ctx, h.tileRepo, sat, sensor, positions)
 	if err != nil {
 		return fmt.Errorf("failed to find visible tiles along the path: %w", err)
 	}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+type TransmitterServiceClient interface {
+	ImportSatnogs(ctx context.Context, path string) (domain.TransmitterImport, error)
+}
+
+type SatnogsTransmitterImportHandler struct {
+	transmitterService TransmitterServiceClient
+}
+
+func NewSatnogsTransmitterImportHandler(transmitterService TransmitterServiceClient) SatnogsTransmitterImportHandler {
+	return SatnogsTransmitterImportHandler{
+		transmitterService: transmitterService,
+	}
+}
+
+func (h *SatnogsTransmitterImportHandler) GetTask() Task {
+	return Task{
+		Name:         "satnogs_transmitter_import",
+		Description:  "Import the transmitters of a SatNOGS DB JSON dump from a local file and upsert them for the known satellites",
+		RequiredArgs: []string{"path"},
+	}
+}
+
+func (h *SatnogsTransmitterImportHandler) Run(ctx context.Context, args map[string]string) error {
+	path, ok := args["path"]
+	if !ok || path == "" {
+		return fmt.Errorf("missing required argument: path")
+	}
+
+	_, err := h.transmitterService.ImportSatnogs(ctx, path)
+	return err
+}
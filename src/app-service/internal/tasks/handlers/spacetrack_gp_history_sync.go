@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apihandlers "github.com/Elbujito/2112/src/app-service/internal/api/handlers"
+)
+
+type SpaceTrackGPHistorySyncHandler struct {
+	spaceTrackService SpaceTrackServiceClient
+}
+
+func NewSpaceTrackGPHistorySyncHandler(spaceTrackService SpaceTrackServiceClient) SpaceTrackGPHistorySyncHandler {
+	return SpaceTrackGPHistorySyncHandler{
+		spaceTrackService: spaceTrackService,
+	}
+}
+
+func (h *SpaceTrackGPHistorySyncHandler) GetTask() Task {
+	return Task{
+		Name:         "spacetrack_gp_history_sync",
+		Description:  "Fetch the historical element sets of satellites from Space-Track, from start in RFC3339 on the first run and from the last synchronized epoch afterwards (noradIDs comma separated)",
+		RequiredArgs: []string{"noradIDs", "start"},
+	}
+}
+
+func (h *SpaceTrackGPHistorySyncHandler) Run(ctx context.Context, args map[string]string) error {
+	noradIDs := apihandlers.SplitQueryList(args["noradIDs"])
+	if len(noradIDs) == 0 {
+		return fmt.Errorf("missing required argument: noradIDs")
+	}
+
+	start, err := time.Parse(time.RFC3339, args["start"])
+	if err != nil {
+		return fmt.Errorf("invalid value for start: %v", err)
+	}
+
+	_, err = h.spaceTrackService.SyncGPHistory(ctx, noradIDs, start)
+	return err
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apihandlers "github.com/Elbujito/2112/src/app-service/internal/api/handlers"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+type SpaceTrackServiceClient interface {
+	SyncGP(ctx context.Context, noradIDs []string, maxCount int) ([]domain.TLE, error)
+	SyncGPHistory(ctx context.Context, noradIDs []string, start time.Time) ([]domain.TLE, error)
+	SyncSatcat(ctx context.Context, maxCount int, full bool) ([]domain.SatelliteCatalogEvent, error)
+}
+
+type SpaceTrackGPSyncHandler struct {
+	spaceTrackService SpaceTrackServiceClient
+}
+
+func NewSpaceTrackGPSyncHandler(spaceTrackService SpaceTrackServiceClient) SpaceTrackGPSyncHandler {
+	return SpaceTrackGPSyncHandler{
+		spaceTrackService: spaceTrackService,
+	}
+}
+
+func (h *SpaceTrackGPSyncHandler) GetTask() Task {
+	return Task{
+		Name:         "spacetrack_gp_sync",
+		Description:  "Fetch the element sets published on Space-Track since the last run and upsert them in the database (optional arg: noradIDs comma separated)",
+		RequiredArgs: []string{"maxCount"},
+	}
+}
+
+func (h *SpaceTrackGPSyncHandler) Run(ctx context.Context, args map[string]string) error {
+	maxCount, err := ParseIntArg(args, "maxCount")
+	if err != nil {
+		return fmt.Errorf("invalid value for maxCount: %v", err)
+	}
+
+	_, err = h.spaceTrackService.SyncGP(ctx, apihandlers.SplitQueryList(args["noradIDs"]), maxCount)
+	return err
+}
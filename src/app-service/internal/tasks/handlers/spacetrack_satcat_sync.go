@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/Elbujito/2112/src/app-service/internal/clients/redis"
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+type SpaceTrackSatcatSyncHandler struct {
+	spaceTrackService SpaceTrackServiceClient
+	launchService     LaunchServiceClient
+	redisClient       *redis.RedisClient
+}
+
+func NewSpaceTrackSatcatSyncHandler(
+	spaceTrackService SpaceTrackServiceClient,
+	launchService LaunchServiceClient,
+	redisClient *redis.RedisClient) SpaceTrackSatcatSyncHandler {
+	return SpaceTrackSatcatSyncHandler{
+		spaceTrackService: spaceTrackService,
+		launchService:     launchService,
+		redisClient:       redisClient,
+	}
+}
+
+func (h *SpaceTrackSatcatSyncHandler) GetTask() Task {
+	return Task{
+		Name:         "spacetrack_satcat_sync",
+		Description:  "Fetch the Space-Track catalog entries added since the last run store the new and changed ones and publish created/updated/decayed events (optional arg: full=true to restart from the first entry)",
+		RequiredArgs: []string{"maxCount"},
+	}
+}
+
+func (h *SpaceTrackSatcatSyncHandler) Run(ctx context.Context, args map[string]string) error {
+	maxCount, err := ParseIntArg(args, "maxCount")
+	if err != nil {
+		return fmt.Errorf("invalid value for maxCount: %v", err)
+	}
+
+	full := false
+	if args["full"] != "" {
+		full, err = strconv.ParseBool(args["full"])
+		if err != nil {
+			return fmt.Errorf("invalid value for full: %v", err)
+		}
+	}
+
+	events, err := h.spaceTrackService.SyncSatcat(ctx, maxCount, full)
+	if err != nil {
+		return err
+	}
+
+	counts, err := publishSatelliteCatalogEvents(ctx, h.redisClient, events)
+	if err != nil {
+		return err
+	}
+
+	// New pieces of an already cataloged launch may reveal a breakup
+	if counts[domain.SatelliteCreated] > 0 {
+		return detectFragmentations(ctx, h.launchService, h.redisClient, domain.DefaultFragmentationPolicy)
+	}
+	return nil
+}
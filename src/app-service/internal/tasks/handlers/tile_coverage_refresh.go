@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Elbujito/2112/src/app-service/internal/domain"
+)
+
+type TileCoverageServiceClient interface {
+	RefreshCoverage(ctx context.Context, contextName domain.GameContextName, tenantID domain.TenantID, start time.Time, end time.Time) (int, error)
+}
+
+type TileCoverageRefreshHandler struct {
+	coverageService TileCoverageServiceClient
+}
+
+func NewTileCoverageRefreshHandler(coverageService TileCoverageServiceClient) TileCoverageRefreshHandler {
+	return TileCoverageRefreshHandler{
+		coverageService: coverageService,
+	}
+}
+
+func (h *TileCoverageRefreshHandler) GetTask() Task {
+	return Task{
+		Name:         "tile_coverage_refresh",
+		Description:  "Refresh passes, revisit gaps, dwell time and distinct satellites per tile of a context from the ground tracks of its satellites over the next hours",
+		RequiredArgs: []string{"contextName", "tenantID", "hours"},
+	}
+}
+
+func (h *TileCoverageRefreshHandler) Run(ctx context.Context, args map[string]string) error {
+	contextName, ok := args["contextName"]
+	if !ok || contextName == "" {
+		return fmt.Errorf("missing required argument: contextName")
+	}
+
+	hours, err := ParseIntArg(args, "hours")
+	if err != nil {
+		return err
+	}
+
+	start := time.Now().UTC()
+	end := start.Add(time.Duration(hours) * time.Hour)
+	count, err := h.coverageService.RefreshCoverage(ctx, domain.GameContextName(contextName), domain.TenantID(args["tenantID"]), start, end)
+	if err != nil {
+		return fmt.Errorf("failed to refresh tile coverage: %v", err)
+	}
+
+	log.Printf("Refreshed coverage statistics of %d tiles for context %s", count, contextName)
+	return nil
+}
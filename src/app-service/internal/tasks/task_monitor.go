@@ -23,12 +23,14 @@ type TaskMonitor struct {
 }
 
 // TaskMonitor constructor
-func NewTaskMonitor(satelliteRepo domain.SatelliteRepository, tleRepo repository.TleRepository, tileRepo domain.TileRepository, visibilityRepo domain.MappingRepository, tleService services.TleService, satelliteService services.SatelliteService, redisClient *redis.RedisClient) (TaskMonitor, error) {
+func NewTaskMonitor(satelliteRepo domain.SatelliteRepository, tleRepo repository.TleRepository, tileRepo domain.TileRepository, visibilityRepo domain.MappingRepository, sensorRepo domain.SatelliteSensorRepository, tleService services.TleService, satelliteService services.SatelliteService, geoService services.GeoService, decayService services.DecayService, contactSchedulerService services.ContactSchedulerService, linkService services.LinkService, lightingService services.OrbitLightingService, constellationService services.ConstellationService, tileCoverageService services.TileCoverageService, geofenceService services.GeofenceService, overflightService services.OverflightService, spaceTrackService services.SpaceTrackService, elementSetService services.ElementSetService, tagService services.SatelliteTagService, transmitterService services.TransmitterService, launchService services.LaunchService, redisClient *redis.RedisClient) (TaskMonitor, error) {
 
 	celestrackTleUpload := handlers.NewCelestrackTleUploadHandler(
-		satelliteRepo,
+		&satelliteService,
 		tleRepo,
 		&tleService,
+		&elementSetService,
+		&tagService,
 	)
 
 	generateTilesHandler := handlers.NewGenerateTilesHandler(
@@ -40,6 +42,7 @@ func NewTaskMonitor(satelliteRepo domain.SatelliteRepository, tleRepo repository
 		tleRepo,
 		satelliteRepo,
 		visibilityRepo,
+		sensorRepo,
 		redisClient,
 		4,
 	)
@@ -47,6 +50,8 @@ func NewTaskMonitor(satelliteRepo domain.SatelliteRepository, tleRepo repository
 	celestrackSatelliteUpload := handlers.NewCelesTrackSatelliteUploadHandler(
 		satelliteRepo,
 		&satelliteService,
+		&launchService,
+		redisClient,
 	)
 
 	satelliteVisibilities := handlers.NewComputeVisibilitiessHandler(
@@ -56,12 +61,96 @@ func NewTaskMonitor(satelliteRepo domain.SatelliteRepository, tleRepo repository
 		redisClient,
 	)
 
+	geoCatalogUpdate := handlers.NewGeoCatalogUpdateHandler(
+		&geoService,
+	)
+
+	decayPrediction := handlers.NewDecayPredictionHandler(
+		&decayService,
+	)
+
+	contactSchedule := handlers.NewContactScheduleHandler(
+		&contactSchedulerService,
+	)
+
+	linkWindows := handlers.NewLinkWindowsHandler(
+		&linkService,
+	)
+
+	orbitLightingUpdate := handlers.NewOrbitLightingUpdateHandler(
+		&lightingService,
+	)
+
+	constellationCoverage := handlers.NewConstellationCoverageHandler(
+		&constellationService,
+	)
+
+	tileCoverageRefresh := handlers.NewTileCoverageRefreshHandler(
+		&tileCoverageService,
+	)
+
+	geofenceEvaluator := handlers.NewGeofenceEvaluatorHandler(
+		&geofenceService,
+		redisClient,
+		4,
+	)
+
+	overflightReport := handlers.NewOverflightReportHandler(
+		&overflightService,
+	)
+
+	elementSetWatch := handlers.NewElementSetWatchHandler(
+		&satelliteService,
+		tleRepo,
+		&tleService,
+		&elementSetService,
+		&tagService,
+	)
+
+	spaceTrackGPSync := handlers.NewSpaceTrackGPSyncHandler(
+		&spaceTrackService,
+	)
+
+	spaceTrackGPHistorySync := handlers.NewSpaceTrackGPHistorySyncHandler(
+		&spaceTrackService,
+	)
+
+	spaceTrackSatcatSync := handlers.NewSpaceTrackSatcatSyncHandler(
+		&spaceTrackService,
+		&launchService,
+		redisClient,
+	)
+
+	satnogsTransmitterImport := handlers.NewSatnogsTransmitterImportHandler(
+		&transmitterService,
+	)
+
+	fragmentationDetection := handlers.NewFragmentationDetectionHandler(
+		&launchService,
+		redisClient,
+	)
+
 	tasks := map[handlers.TaskName]TaskHandler{
 		celestrackTleUpload.GetTask().Name:       &celestrackTleUpload,
 		generateTilesHandler.GetTask().Name:      &generateTilesHandler,
 		mappingHandler.GetTask().Name:            &mappingHandler,
 		celestrackSatelliteUpload.GetTask().Name: &celestrackSatelliteUpload,
 		satelliteVisibilities.GetTask().Name:     &satelliteVisibilities,
+		geoCatalogUpdate.GetTask().Name:          &geoCatalogUpdate,
+		decayPrediction.GetTask().Name:           &decayPrediction,
+		contactSchedule.GetTask().Name:           &contactSchedule,
+		linkWindows.GetTask().Name:               &linkWindows,
+		orbitLightingUpdate.GetTask().Name:       &orbitLightingUpdate,
+		constellationCoverage.GetTask().Name:     &constellationCoverage,
+		tileCoverageRefresh.GetTask().Name:       &tileCoverageRefresh,
+		geofenceEvaluator.GetTask().Name:         &geofenceEvaluator,
+		elementSetWatch.GetTask().Name:           &elementSetWatch,
+		overflightReport.GetTask().Name:          &overflightReport,
+		spaceTrackGPSync.GetTask().Name:          &spaceTrackGPSync,
+		spaceTrackGPHistorySync.GetTask().Name:   &spaceTrackGPHistorySync,
+		spaceTrackSatcatSync.GetTask().Name:      &spaceTrackSatcatSync,
+		satnogsTransmitterImport.GetTask().Name:  &satnogsTransmitterImport,
+		fragmentationDetection.GetTask().Name:    &fragmentationDetection,
 	}
 	return TaskMonitor{
 		Tasks: tasks,
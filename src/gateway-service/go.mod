@@ -4,7 +4,7 @@ go 1.23.4
 
 require (
 	github.com/99designs/gqlgen v0.17.57
-	github.com/Elbujito/2112/src/graphql-api/go v0.0.15
+	github.com/Elbujito/2112/src/graphql-api/go v0.0.16
 	github.com/go-redis/redis/v8 v8.11.5
 	github.com/rs/cors v1.11.1
 )
@@ -19,5 +19,5 @@ require (
 	github.com/hashicorp/golang-lru/v2 v2.0.7 // indirect
 	github.com/sosodev/duration v1.3.1 // indirect
 	github.com/vektah/gqlparser/v2 v2.5.19 // indirect
-	golang.org/x/net v0.34.0 // indirect
+	golang.org/x/sys v0.29.0 // indirect
 )
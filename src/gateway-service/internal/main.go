@@ -32,6 +32,7 @@ func main() {
 
 	// Initialize custom resolver
 	resolver := NewCustomResolver(redisClient)
+	go subscribeToRedisForGeofenceEvents(resolver)
 
 	// GraphQL server
 	srv := handler.NewDefaultServer(graph.NewExecutableSchema(graph.Config{Resolvers: resolver}))
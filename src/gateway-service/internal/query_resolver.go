@@ -121,6 +121,28 @@ func (q *queryResolver) CachedSatelliteVisibilities(ctx context.Context, uid str
 	return distinctVisibilities, nil
 }
 
+// UpcomingGeofenceCrossings retrieves the cached upcoming enter/exit events of a geofence.
+func (q *queryResolver) UpcomingGeofenceCrossings(ctx context.Context, geofenceID string) ([]*model.GeofenceEvent, error) {
+	// Fetch the crossings cached by the geofence evaluator
+	data, err := q.CustomResolver.rdb.Get(ctx, "geofence_crossings:"+geofenceID).Result()
+	if err == redis.Nil {
+		return []*model.GeofenceEvent{}, nil
+	}
+	if err != nil {
+		log.Printf("Error fetching geofence crossings from Redis for ID %s: %v", geofenceID, err)
+		return nil, fmt.Errorf("failed to fetch geofence crossings for ID %s: %w", geofenceID, err)
+	}
+
+	// Unmarshal JSON data into GeofenceEvent structs
+	var events []*model.GeofenceEvent
+	if err := json.Unmarshal([]byte(data), &events); err != nil {
+		log.Printf("Error unmarshalling GeofenceEvent for ID %s: %v", geofenceID, err)
+		return nil, fmt.Errorf("failed to parse geofence crossings for ID %s: %w", geofenceID, err)
+	}
+
+	return events, nil
+}
+
 // Helper function to remove duplicates
 func distinctSatelliteVisibilities(visibilities []*model.SatelliteVisibility) []*model.SatelliteVisibility {
 	seen := make(map[string]bool) // Use a map to track unique entries
@@ -74,6 +74,30 @@ func subscribeToRedisForVisibilityUpdates(resolver *CustomResolver) {
 	}
 }
 
+// Subscribe to Redis for geofence enter/exit events
+func subscribeToRedisForGeofenceEvents(resolver *CustomResolver) {
+	pubsub := resolver.rdb.Subscribe(ctx, "geofence_events")
+	defer func() {
+		if err := pubsub.Close(); err != nil {
+			log.Printf("Error closing Redis subscription: %v", err)
+		}
+	}()
+
+	log.Println("Subscribed to geofence_events channel")
+
+	for msg := range pubsub.Channel() {
+		var event model.GeofenceEvent
+		if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+			log.Printf("Error unmarshalling GeofenceEvent: %v", err)
+			continue
+		}
+
+		log.Printf("Received GeofenceEvent %s for satellite %s on geofence %s", event.Kind, event.SatelliteID, event.GeofenceID)
+		// Notify relevant subscribers
+		resolver.NotifyGeofenceSubscribers(&event)
+	}
+}
+
 // Helper function to extract UIDs from a list of SatelliteVisibility objects
 func extractUIDs(visibilities []*model.SatelliteVisibility) []string {
 	uids := make(map[string]struct{})
@@ -13,6 +13,7 @@ type CustomResolver struct {
 	rdb                   *redis.Client
 	PositionSubscribers   map[string]map[string]chan *model.SatellitePosition
 	VisibilitySubscribers map[string]chan []*model.SatelliteVisibility
+	GeofenceSubscribers   map[string]map[string]chan *model.GeofenceEvent
 	mu                    sync.Mutex
 }
 
@@ -22,6 +23,7 @@ func NewCustomResolver(redisClient *redis.Client) *CustomResolver {
 		rdb:                   redisClient,
 		PositionSubscribers:   make(map[string]map[string]chan *model.SatellitePosition),
 		VisibilitySubscribers: make(map[string]chan []*model.SatelliteVisibility),
+		GeofenceSubscribers:   make(map[string]map[string]chan *model.GeofenceEvent),
 	}
 }
 
@@ -59,3 +61,18 @@ func (r *CustomResolver) NotifyVisibilitySubscribers(uid string, visibilities []
 		ch <- visibilities
 	}
 }
+
+// NotifyGeofenceSubscribers sends an event to every user subscribed to its geofence.
+func (r *CustomResolver) NotifyGeofenceSubscribers(event *model.GeofenceEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, userChannels := range r.GeofenceSubscribers {
+		if ch, ok := userChannels[event.GeofenceID]; ok {
+			select {
+			case ch <- event:
+			default:
+				// Drop the event rather than blocking the Redis listener on a slow subscriber
+			}
+		}
+	}
+}
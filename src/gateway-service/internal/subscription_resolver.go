@@ -63,6 +63,34 @@ func (s *subscriptionResolver) SatelliteVisibilityUpdated(ctx context.Context, u
 	return ch, nil
 }
 
+// GeofenceEventReceived resolves the subscription for real-time geofence enter/exit events
+func (s *subscriptionResolver) GeofenceEventReceived(ctx context.Context, uid string, geofenceID string) (<-chan *model.GeofenceEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Ensure the user's geofence subscription map exists
+	if _, exists := s.GeofenceSubscribers[uid]; !exists {
+		s.GeofenceSubscribers[uid] = make(map[string]chan *model.GeofenceEvent)
+	}
+
+	// Check if a subscription for this geofence already exists to prevent overwriting
+	if _, exists := s.GeofenceSubscribers[uid][geofenceID]; exists {
+		return nil, fmt.Errorf("subscription for geofence ID %s already exists for user %s", geofenceID, uid)
+	}
+
+	// Create a new channel for geofence events
+	ch := make(chan *model.GeofenceEvent, 16)
+	s.GeofenceSubscribers[uid][geofenceID] = ch
+
+	// Clean up subscription when the context is canceled
+	go func() {
+		<-ctx.Done()
+		s.cleanupGeofenceSubscriber(uid, geofenceID, ch)
+	}()
+
+	return ch, nil
+}
+
 // cleanupPositionSubscriber removes a position subscription and closes the channel
 func (s *subscriptionResolver) cleanupPositionSubscriber(uid, id string, ch chan *model.SatellitePosition) {
 	s.mu.Lock()
@@ -93,6 +121,23 @@ func (s *subscriptionResolver) cleanupVisibilitySubscriber(uid string, ch chan [
 	}
 }
 
+// cleanupGeofenceSubscriber removes a geofence subscription and closes the channel
+func (s *subscriptionResolver) cleanupGeofenceSubscriber(uid, geofenceID string, ch chan *model.GeofenceEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if userSubs, ok := s.GeofenceSubscribers[uid]; ok {
+		if _, exists := userSubs[geofenceID]; exists {
+			delete(userSubs, geofenceID)
+			close(ch) // Close the channel to signal the end of the subscription
+		}
+		// If the user has no more subscriptions, clean up their map
+		if len(userSubs) == 0 {
+			delete(s.GeofenceSubscribers, uid)
+		}
+	}
+}
+
 // Helper function to safely initialize a nested map if it doesn't exist
 func (s *subscriptionResolver) ensurePositionSubscribers(uid string) {
 	s.mu.Lock()
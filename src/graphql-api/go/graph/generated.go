@@ -48,6 +48,17 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	GeofenceEvent struct {
+		GeofenceID   func(childComplexity int) int
+		GeofenceName func(childComplexity int) int
+		ID           func(childComplexity int) int
+		Kind         func(childComplexity int) int
+		Latitude     func(childComplexity int) int
+		Longitude    func(childComplexity int) int
+		SatelliteID  func(childComplexity int) int
+		Timestamp    func(childComplexity int) int
+	}
+
 	Mutation struct {
 		RequestSatelliteVisibilities func(childComplexity int, uid string, userLocation model.UserLocationInput, startTime string, endTime string) int
 	}
@@ -57,6 +68,7 @@ type ComplexityRoot struct {
 		SatellitePosition           func(childComplexity int, id string) int
 		SatellitePositionsInRange   func(childComplexity int, id string, startTime string, endTime string) int
 		SatelliteTle                func(childComplexity int, id string) int
+		UpcomingGeofenceCrossings   func(childComplexity int, geofenceID string) int
 	}
 
 	SatellitePosition struct {
@@ -87,6 +99,7 @@ type ComplexityRoot struct {
 	}
 
 	Subscription struct {
+		GeofenceEventReceived      func(childComplexity int, uid string, geofenceID string) int
 		SatellitePositionUpdated   func(childComplexity int, uid string, id string) int
 		SatelliteVisibilityUpdated func(childComplexity int, uid string, userLocation model.UserLocationInput, startTime string, endTime string) int
 	}
@@ -108,10 +121,12 @@ type QueryResolver interface {
 	SatelliteTle(ctx context.Context, id string) (*model.SatelliteTle, error)
 	SatellitePositionsInRange(ctx context.Context, id string, startTime string, endTime string) ([]*model.SatellitePosition, error)
 	CachedSatelliteVisibilities(ctx context.Context, uid string, userLocation model.UserLocationInput, startTime string, endTime string) ([]*model.SatelliteVisibility, error)
+	UpcomingGeofenceCrossings(ctx context.Context, geofenceID string) ([]*model.GeofenceEvent, error)
 }
 type SubscriptionResolver interface {
 	SatellitePositionUpdated(ctx context.Context, uid string, id string) (<-chan *model.SatellitePosition, error)
 	SatelliteVisibilityUpdated(ctx context.Context, uid string, userLocation model.UserLocationInput, startTime string, endTime string) (<-chan []*model.SatelliteVisibility, error)
+	GeofenceEventReceived(ctx context.Context, uid string, geofenceID string) (<-chan *model.GeofenceEvent, error)
 }
 
 type executableSchema struct {
@@ -133,6 +148,62 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "GeofenceEvent.geofenceId":
+		if e.complexity.GeofenceEvent.GeofenceID == nil {
+			break
+		}
+
+		return e.complexity.GeofenceEvent.GeofenceID(childComplexity), true
+
+	case "GeofenceEvent.geofenceName":
+		if e.complexity.GeofenceEvent.GeofenceName == nil {
+			break
+		}
+
+		return e.complexity.GeofenceEvent.GeofenceName(childComplexity), true
+
+	case "GeofenceEvent.id":
+		if e.complexity.GeofenceEvent.ID == nil {
+			break
+		}
+
+		return e.complexity.GeofenceEvent.ID(childComplexity), true
+
+	case "GeofenceEvent.kind":
+		if e.complexity.GeofenceEvent.Kind == nil {
+			break
+		}
+
+		return e.complexity.GeofenceEvent.Kind(childComplexity), true
+
+	case "GeofenceEvent.latitude":
+		if e.complexity.GeofenceEvent.Latitude == nil {
+			break
+		}
+
+		return e.complexity.GeofenceEvent.Latitude(childComplexity), true
+
+	case "GeofenceEvent.longitude":
+		if e.complexity.GeofenceEvent.Longitude == nil {
+			break
+		}
+
+		return e.complexity.GeofenceEvent.Longitude(childComplexity), true
+
+	case "GeofenceEvent.satelliteId":
+		if e.complexity.GeofenceEvent.SatelliteID == nil {
+			break
+		}
+
+		return e.complexity.GeofenceEvent.SatelliteID(childComplexity), true
+
+	case "GeofenceEvent.timestamp":
+		if e.complexity.GeofenceEvent.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.GeofenceEvent.Timestamp(childComplexity), true
+
 	case "Mutation.requestSatelliteVisibilities":
 		if e.complexity.Mutation.RequestSatelliteVisibilities == nil {
 			break
@@ -193,6 +264,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.SatelliteTle(childComplexity, args["id"].(string)), true
 
+	case "Query.upcomingGeofenceCrossings":
+		if e.complexity.Query.UpcomingGeofenceCrossings == nil {
+			break
+		}
+
+		args, err := ec.field_Query_upcomingGeofenceCrossings_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.UpcomingGeofenceCrossings(childComplexity, args["geofenceId"].(string)), true
+
 	case "SatellitePosition.altitude":
 		if e.complexity.SatellitePosition.Altitude == nil {
 			break
@@ -319,6 +402,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SatelliteVisibility.UserLocation(childComplexity), true
 
+	case "Subscription.geofenceEventReceived":
+		if e.complexity.Subscription.GeofenceEventReceived == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_geofenceEventReceived_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.GeofenceEventReceived(childComplexity, args["uid"].(string), args["geofenceId"].(string)), true
+
 	case "Subscription.satellitePositionUpdated":
 		if e.complexity.Subscription.SatellitePositionUpdated == nil {
 			break
@@ -524,6 +619,9 @@ type Query {
     startTime: String!
     endTime: String!
   ): [SatelliteVisibility!]!
+
+  # Upcoming enter/exit events of a geofence, oldest first
+  upcomingGeofenceCrossings(geofenceId: ID!): [GeofenceEvent!]!
 }
 
 type Mutation {
@@ -547,6 +645,9 @@ type Subscription {
     startTime: String!
     endTime: String!
   ): [SatelliteVisibility!]!
+
+  # Real-time enter/exit events of a geofence for a specific user
+  geofenceEventReceived(uid: String!, geofenceId: ID!): GeofenceEvent
 }
 
 # User-defined location parameters for visibility queries
@@ -595,6 +696,24 @@ type UserLocation {
   radius: Float! # Radius of visibility in kilometers
   horizon: Float! # Horizon angle in degrees
 }
+
+# Direction of a geofence boundary crossing
+enum GeofenceEventKind {
+  ENTER
+  EXIT
+}
+
+# Predicted crossing of a geofence boundary by a satellite
+type GeofenceEvent {
+  id: ID!
+  geofenceId: ID!
+  geofenceName: String!
+  satelliteId: ID!
+  kind: GeofenceEventKind!
+  timestamp: String! # ISO 8601 format
+  latitude: Float!
+  longitude: Float!
+}
 `, BuiltIn: false},
 }
 var parsedSchema = gqlparser.MustLoadSchema(sources...)
@@ -885,6 +1004,70 @@ func (ec *executionContext) field_Query_satelliteTle_argsID(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_upcomingGeofenceCrossings_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_upcomingGeofenceCrossings_argsGeofenceID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["geofenceId"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_upcomingGeofenceCrossings_argsGeofenceID(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("geofenceId"))
+	if tmp, ok := rawArgs["geofenceId"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Subscription_geofenceEventReceived_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Subscription_geofenceEventReceived_argsUID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["uid"] = arg0
+	arg1, err := ec.field_Subscription_geofenceEventReceived_argsGeofenceID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["geofenceId"] = arg1
+	return args, nil
+}
+func (ec *executionContext) field_Subscription_geofenceEventReceived_argsUID(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("uid"))
+	if tmp, ok := rawArgs["uid"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Subscription_geofenceEventReceived_argsGeofenceID(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("geofenceId"))
+	if tmp, ok := rawArgs["geofenceId"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Subscription_satellitePositionUpdated_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1057,6 +1240,358 @@ func (ec *executionContext) field___Type_fields_argsIncludeDeprecated(
 
 // region    **************************** field.gotpl *****************************
 
+func (ec *executionContext) _GeofenceEvent_id(ctx context.Context, field graphql.CollectedField, obj *model.GeofenceEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeofenceEvent_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GeofenceEvent_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GeofenceEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GeofenceEvent_geofenceId(ctx context.Context, field graphql.CollectedField, obj *model.GeofenceEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeofenceEvent_geofenceId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.GeofenceID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GeofenceEvent_geofenceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GeofenceEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GeofenceEvent_geofenceName(ctx context.Context, field graphql.CollectedField, obj *model.GeofenceEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeofenceEvent_geofenceName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.GeofenceName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GeofenceEvent_geofenceName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GeofenceEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GeofenceEvent_satelliteId(ctx context.Context, field graphql.CollectedField, obj *model.GeofenceEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeofenceEvent_satelliteId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SatelliteID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GeofenceEvent_satelliteId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GeofenceEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GeofenceEvent_kind(ctx context.Context, field graphql.CollectedField, obj *model.GeofenceEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeofenceEvent_kind(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.GeofenceEventKind)
+	fc.Result = res
+	return ec.marshalNGeofenceEventKind2githubᚗcomᚋElbujitoᚋ2112ᚋsrcᚋgraphqlᚑapiᚋgoᚋgraphᚋmodelᚐGeofenceEventKind(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GeofenceEvent_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GeofenceEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type GeofenceEventKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GeofenceEvent_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.GeofenceEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeofenceEvent_timestamp(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Timestamp, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GeofenceEvent_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GeofenceEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GeofenceEvent_latitude(ctx context.Context, field graphql.CollectedField, obj *model.GeofenceEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeofenceEvent_latitude(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Latitude, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GeofenceEvent_latitude(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GeofenceEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GeofenceEvent_longitude(ctx context.Context, field graphql.CollectedField, obj *model.GeofenceEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GeofenceEvent_longitude(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Longitude, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GeofenceEvent_longitude(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GeofenceEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Mutation_requestSatelliteVisibilities(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Mutation_requestSatelliteVisibilities(ctx, field)
 	if err != nil {
@@ -1296,9 +1831,78 @@ func (ec *executionContext) fieldContext_Query_satellitePositionsInRange(ctx con
 			case "timestamp":
 				return ec.fieldContext_SatellitePosition_timestamp(ctx, field)
 			case "uid":
-				return ec.fieldContext_SatellitePosition_uid(ctx, field)
+				return ec.fieldContext_SatellitePosition_uid(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SatellitePosition", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_satellitePositionsInRange_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_cachedSatelliteVisibilities(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_cachedSatelliteVisibilities(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().CachedSatelliteVisibilities(rctx, fc.Args["uid"].(string), fc.Args["userLocation"].(model.UserLocationInput), fc.Args["startTime"].(string), fc.Args["endTime"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.SatelliteVisibility)
+	fc.Result = res
+	return ec.marshalNSatelliteVisibility2ᚕᚖgithubᚗcomᚋElbujitoᚋ2112ᚋsrcᚋgraphqlᚑapiᚋgoᚋgraphᚋmodelᚐSatelliteVisibilityᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_cachedSatelliteVisibilities(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "satelliteId":
+				return ec.fieldContext_SatelliteVisibility_satelliteId(ctx, field)
+			case "satelliteName":
+				return ec.fieldContext_SatelliteVisibility_satelliteName(ctx, field)
+			case "aos":
+				return ec.fieldContext_SatelliteVisibility_aos(ctx, field)
+			case "los":
+				return ec.fieldContext_SatelliteVisibility_los(ctx, field)
+			case "userLocation":
+				return ec.fieldContext_SatelliteVisibility_userLocation(ctx, field)
+			case "uid":
+				return ec.fieldContext_SatelliteVisibility_uid(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type SatellitePosition", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type SatelliteVisibility", field.Name)
 		},
 	}
 	defer func() {
@@ -1308,15 +1912,15 @@ func (ec *executionContext) fieldContext_Query_satellitePositionsInRange(ctx con
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_satellitePositionsInRange_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_cachedSatelliteVisibilities_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_cachedSatelliteVisibilities(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_cachedSatelliteVisibilities(ctx, field)
+func (ec *executionContext) _Query_upcomingGeofenceCrossings(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_upcomingGeofenceCrossings(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1329,7 +1933,7 @@ func (ec *executionContext) _Query_cachedSatelliteVisibilities(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().CachedSatelliteVisibilities(rctx, fc.Args["uid"].(string), fc.Args["userLocation"].(model.UserLocationInput), fc.Args["startTime"].(string), fc.Args["endTime"].(string))
+		return ec.resolvers.Query().UpcomingGeofenceCrossings(rctx, fc.Args["geofenceId"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1341,12 +1945,12 @@ func (ec *executionContext) _Query_cachedSatelliteVisibilities(ctx context.Conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.SatelliteVisibility)
+	res := resTmp.([]*model.GeofenceEvent)
 	fc.Result = res
-	return ec.marshalNSatelliteVisibility2ᚕᚖgithubᚗcomᚋElbujitoᚋ2112ᚋsrcᚋgraphqlᚑapiᚋgoᚋgraphᚋmodelᚐSatelliteVisibilityᚄ(ctx, field.Selections, res)
+	return ec.marshalNGeofenceEvent2ᚕᚖgithubᚗcomᚋElbujitoᚋ2112ᚋsrcᚋgraphqlᚑapiᚋgoᚋgraphᚋmodelᚐGeofenceEventᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_cachedSatelliteVisibilities(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_upcomingGeofenceCrossings(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -1354,20 +1958,24 @@ func (ec *executionContext) fieldContext_Query_cachedSatelliteVisibilities(ctx c
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "id":
+				return ec.fieldContext_GeofenceEvent_id(ctx, field)
+			case "geofenceId":
+				return ec.fieldContext_GeofenceEvent_geofenceId(ctx, field)
+			case "geofenceName":
+				return ec.fieldContext_GeofenceEvent_geofenceName(ctx, field)
 			case "satelliteId":
-				return ec.fieldContext_SatelliteVisibility_satelliteId(ctx, field)
-			case "satelliteName":
-				return ec.fieldContext_SatelliteVisibility_satelliteName(ctx, field)
-			case "aos":
-				return ec.fieldContext_SatelliteVisibility_aos(ctx, field)
-			case "los":
-				return ec.fieldContext_SatelliteVisibility_los(ctx, field)
-			case "userLocation":
-				return ec.fieldContext_SatelliteVisibility_userLocation(ctx, field)
-			case "uid":
-				return ec.fieldContext_SatelliteVisibility_uid(ctx, field)
+				return ec.fieldContext_GeofenceEvent_satelliteId(ctx, field)
+			case "kind":
+				return ec.fieldContext_GeofenceEvent_kind(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_GeofenceEvent_timestamp(ctx, field)
+			case "latitude":
+				return ec.fieldContext_GeofenceEvent_latitude(ctx, field)
+			case "longitude":
+				return ec.fieldContext_GeofenceEvent_longitude(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type SatelliteVisibility", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type GeofenceEvent", field.Name)
 		},
 	}
 	defer func() {
@@ -1377,7 +1985,7 @@ func (ec *executionContext) fieldContext_Query_cachedSatelliteVisibilities(ctx c
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_cachedSatelliteVisibilities_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_upcomingGeofenceCrossings_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
@@ -2482,6 +3090,90 @@ func (ec *executionContext) fieldContext_Subscription_satelliteVisibilityUpdated
 	return fc, nil
 }
 
+func (ec *executionContext) _Subscription_geofenceEventReceived(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_geofenceEventReceived(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().GeofenceEventReceived(rctx, fc.Args["uid"].(string), fc.Args["geofenceId"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.GeofenceEvent):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalOGeofenceEvent2ᚖgithubᚗcomᚋElbujitoᚋ2112ᚋsrcᚋgraphqlᚑapiᚋgoᚋgraphᚋmodelᚐGeofenceEvent(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_geofenceEventReceived(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_GeofenceEvent_id(ctx, field)
+			case "geofenceId":
+				return ec.fieldContext_GeofenceEvent_geofenceId(ctx, field)
+			case "geofenceName":
+				return ec.fieldContext_GeofenceEvent_geofenceName(ctx, field)
+			case "satelliteId":
+				return ec.fieldContext_GeofenceEvent_satelliteId(ctx, field)
+			case "kind":
+				return ec.fieldContext_GeofenceEvent_kind(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_GeofenceEvent_timestamp(ctx, field)
+			case "latitude":
+				return ec.fieldContext_GeofenceEvent_latitude(ctx, field)
+			case "longitude":
+				return ec.fieldContext_GeofenceEvent_longitude(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GeofenceEvent", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_geofenceEventReceived_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _UserLocation_uid(ctx context.Context, field graphql.CollectedField, obj *model.UserLocation) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_UserLocation_uid(ctx, field)
 	if err != nil {
@@ -4538,6 +5230,80 @@ func (ec *executionContext) unmarshalInputUserLocationInput(ctx context.Context,
 
 // region    **************************** object.gotpl ****************************
 
+var geofenceEventImplementors = []string{"GeofenceEvent"}
+
+func (ec *executionContext) _GeofenceEvent(ctx context.Context, sel ast.SelectionSet, obj *model.GeofenceEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, geofenceEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GeofenceEvent")
+		case "id":
+			out.Values[i] = ec._GeofenceEvent_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "geofenceId":
+			out.Values[i] = ec._GeofenceEvent_geofenceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "geofenceName":
+			out.Values[i] = ec._GeofenceEvent_geofenceName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "satelliteId":
+			out.Values[i] = ec._GeofenceEvent_satelliteId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "kind":
+			out.Values[i] = ec._GeofenceEvent_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._GeofenceEvent_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "latitude":
+			out.Values[i] = ec._GeofenceEvent_latitude(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "longitude":
+			out.Values[i] = ec._GeofenceEvent_longitude(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var mutationImplementors = []string{"Mutation"}
 
 func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
@@ -4687,6 +5453,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "upcomingGeofenceCrossings":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_upcomingGeofenceCrossings(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "__type":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
@@ -4928,6 +5716,8 @@ func (ec *executionContext) _Subscription(ctx context.Context, sel ast.Selection
 		return ec._Subscription_satellitePositionUpdated(ctx, fields[0])
 	case "satelliteVisibilityUpdated":
 		return ec._Subscription_satelliteVisibilityUpdated(ctx, fields[0])
+	case "geofenceEventReceived":
+		return ec._Subscription_geofenceEventReceived(ctx, fields[0])
 	default:
 		panic("unknown field " + strconv.Quote(fields[0].Name))
 	}
@@ -5348,6 +6138,70 @@ func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.S
 	return graphql.WrapContextMarshaler(ctx, res)
 }
 
+func (ec *executionContext) marshalNGeofenceEvent2ᚕᚖgithubᚗcomᚋElbujitoᚋ2112ᚋsrcᚋgraphqlᚑapiᚋgoᚋgraphᚋmodelᚐGeofenceEventᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.GeofenceEvent) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNGeofenceEvent2ᚖgithubᚗcomᚋElbujitoᚋ2112ᚋsrcᚋgraphqlᚑapiᚋgoᚋgraphᚋmodelᚐGeofenceEvent(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNGeofenceEvent2ᚖgithubᚗcomᚋElbujitoᚋ2112ᚋsrcᚋgraphqlᚑapiᚋgoᚋgraphᚋmodelᚐGeofenceEvent(ctx context.Context, sel ast.SelectionSet, v *model.GeofenceEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._GeofenceEvent(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNGeofenceEventKind2githubᚗcomᚋElbujitoᚋ2112ᚋsrcᚋgraphqlᚑapiᚋgoᚋgraphᚋmodelᚐGeofenceEventKind(ctx context.Context, v interface{}) (model.GeofenceEventKind, error) {
+	var res model.GeofenceEventKind
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNGeofenceEventKind2githubᚗcomᚋElbujitoᚋ2112ᚋsrcᚋgraphqlᚑapiᚋgoᚋgraphᚋmodelᚐGeofenceEventKind(ctx context.Context, sel ast.SelectionSet, v model.GeofenceEventKind) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) unmarshalNID2string(ctx context.Context, v interface{}) (string, error) {
 	res, err := graphql.UnmarshalID(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -5780,6 +6634,13 @@ func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast
 	return res
 }
 
+func (ec *executionContext) marshalOGeofenceEvent2ᚖgithubᚗcomᚋElbujitoᚋ2112ᚋsrcᚋgraphqlᚑapiᚋgoᚋgraphᚋmodelᚐGeofenceEvent(ctx context.Context, sel ast.SelectionSet, v *model.GeofenceEvent) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._GeofenceEvent(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOSatellitePosition2ᚖgithubᚗcomᚋElbujitoᚋ2112ᚋsrcᚋgraphqlᚑapiᚋgoᚋgraphᚋmodelᚐSatellitePosition(ctx context.Context, sel ast.SelectionSet, v *model.SatellitePosition) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -2,6 +2,23 @@
 
 package model
 
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type GeofenceEvent struct {
+	ID           string            `json:"id"`
+	GeofenceID   string            `json:"geofenceId"`
+	GeofenceName string            `json:"geofenceName"`
+	SatelliteID  string            `json:"satelliteId"`
+	Kind         GeofenceEventKind `json:"kind"`
+	Timestamp    string            `json:"timestamp"`
+	Latitude     float64           `json:"latitude"`
+	Longitude    float64           `json:"longitude"`
+}
+
 type Mutation struct {
 }
 
@@ -53,3 +70,44 @@ type UserLocationInput struct {
 	Radius    float64 `json:"radius"`
 	Horizon   float64 `json:"horizon"`
 }
+
+type GeofenceEventKind string
+
+const (
+	GeofenceEventKindEnter GeofenceEventKind = "ENTER"
+	GeofenceEventKindExit  GeofenceEventKind = "EXIT"
+)
+
+var AllGeofenceEventKind = []GeofenceEventKind{
+	GeofenceEventKindEnter,
+	GeofenceEventKindExit,
+}
+
+func (e GeofenceEventKind) IsValid() bool {
+	switch e {
+	case GeofenceEventKindEnter, GeofenceEventKindExit:
+		return true
+	}
+	return false
+}
+
+func (e GeofenceEventKind) String() string {
+	return string(e)
+}
+
+func (e *GeofenceEventKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GeofenceEventKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GeofenceEventKind", str)
+	}
+	return nil
+}
+
+func (e GeofenceEventKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
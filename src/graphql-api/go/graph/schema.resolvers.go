@@ -36,6 +36,11 @@ func (r *queryResolver) CachedSatelliteVisibilities(ctx context.Context, uid str
 	panic(fmt.Errorf("not implemented: CachedSatelliteVisibilities - cachedSatelliteVisibilities"))
 }
 
+// UpcomingGeofenceCrossings is the resolver for the upcomingGeofenceCrossings field.
+func (r *queryResolver) UpcomingGeofenceCrossings(ctx context.Context, geofenceID string) ([]*model.GeofenceEvent, error) {
+	panic(fmt.Errorf("not implemented: UpcomingGeofenceCrossings - upcomingGeofenceCrossings"))
+}
+
 // SatellitePositionUpdated is the resolver for the satellitePositionUpdated field.
 func (r *subscriptionResolver) SatellitePositionUpdated(ctx context.Context, uid string, id string) (<-chan *model.SatellitePosition, error) {
 	panic(fmt.Errorf("not implemented: SatellitePositionUpdated - satellitePositionUpdated"))
@@ -46,6 +51,11 @@ func (r *subscriptionResolver) SatelliteVisibilityUpdated(ctx context.Context, u
 	panic(fmt.Errorf("not implemented: SatelliteVisibilityUpdated - satelliteVisibilityUpdated"))
 }
 
+// GeofenceEventReceived is the resolver for the geofenceEventReceived field.
+func (r *subscriptionResolver) GeofenceEventReceived(ctx context.Context, uid string, geofenceID string) (<-chan *model.GeofenceEvent, error) {
+	panic(fmt.Errorf("not implemented: GeofenceEventReceived - geofenceEventReceived"))
+}
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
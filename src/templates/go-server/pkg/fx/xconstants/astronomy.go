@@ -0,0 +1,16 @@
+package xconstants
+
+// ASTRONOMICAL_UNIT_KM constants definition
+const ASTRONOMICAL_UNIT_KM float64 = 149597870.7
+
+// J2000_JULIAN_DATE constants definition
+const J2000_JULIAN_DATE float64 = 2451545.0 // Julian date of 2000-01-01 12:00 TT
+
+// UNIX_EPOCH_JULIAN_DATE constants definition
+const UNIX_EPOCH_JULIAN_DATE float64 = 2440587.5 // Julian date of 1970-01-01 00:00 UTC
+
+// DAYS_PER_JULIAN_CENTURY constants definition
+const DAYS_PER_JULIAN_CENTURY float64 = 36525.0
+
+// GENERAL_PRECESSION_DEG_PER_CENTURY constants definition
+const GENERAL_PRECESSION_DEG_PER_CENTURY float64 = 1.396971 // Precession of the equinoxes in ecliptic longitude
@@ -5,9 +5,18 @@ import (
 )
 
 const (
-	DEFAULT_PUBLIC_CESLESTRACK_URL        string = "https://celestrak.com/NORAD/elements/gp.php"
-	DEFAULT_PRIVATE_PROPAGATOR_URL        string = "http://propagator-service:5000/satellite/propagate"
-	DEFAULT_PUBLIC_CESLESTRACK_SATCAT_URL string = "https://celestrak.org/pub/satcat.csv"
+	DEFAULT_PUBLIC_CESLESTRACK_URL         string = "https://celestrak.com/NORAD/elements/gp.php"
+	DEFAULT_PRIVATE_PROPAGATOR_URL         string = "http://propagator-service:5000/satellite/propagate"
+	DEFAULT_PUBLIC_CESLESTRACK_SATCAT_URL  string = "https://celestrak.org/pub/satcat.csv"
+	DEFAULT_BOUNDARIES_DIR                 string = "/var/2112/data/boundaries"
+	DEFAULT_ELEMENT_SET_SOURCES            string = "default=celestrak"
+	DEFAULT_ELEMENT_SET_PRECEDENCE         string = "newest"
+	DEFAULT_PUBLIC_SPACETRACK_URL          string = "https://www.space-track.org"
+	DEFAULT_SPACETRACK_REQUESTS_PER_MINUTE int    = 30  // Published Space-Track API limit
+	DEFAULT_SPACETRACK_REQUESTS_PER_HOUR   int    = 300 // Published Space-Track API limit
+	DEFAULT_CELESTRACK_CACHE_DIR           string = "/var/2112/cache/celestrak"
+	DEFAULT_CELESTRACK_REQUESTS_PER_MINUTE int    = 20
+	DEFAULT_CELESTRACK_MAX_RETRIES         int    = 3
 
 	// defaults
 	DEFAULT_PROTECTED_API_PORT       string = "8080"
@@ -28,15 +37,18 @@ const (
 	DEFAULT_GZIP_LEVEL               int    = 5
 
 	// features
-	FEATURE_SERVICE    string = "service"
-	FEATURE_ORY_KRATOS string = "ory_kratos"
-	FEATURE_ORY_KETO   string = "ory_keto"
-	FEATURE_DATABASE   string = "database"
-	FEATURE_CORS       string = "cors"
-	FEATURE_GZIP       string = "gzip"
-	FEATURE_CELESTRACK string = "celestrack"
-	FEATURE_PROPAGATOR string = "propagator"
-	FEATURE_REDIS      string = "redis"
+	FEATURE_SERVICE      string = "service"
+	FEATURE_ORY_KRATOS   string = "ory_kratos"
+	FEATURE_ORY_KETO     string = "ory_keto"
+	FEATURE_DATABASE     string = "database"
+	FEATURE_CORS         string = "cors"
+	FEATURE_GZIP         string = "gzip"
+	FEATURE_CELESTRACK   string = "celestrack"
+	FEATURE_PROPAGATOR   string = "propagator"
+	FEATURE_REDIS        string = "redis"
+	FEATURE_BOUNDARIES   string = "boundaries"
+	FEATURE_ELEMENT_SETS string = "element_sets"
+	FEATURE_SPACETRACK   string = "spacetrack"
 
 	// generic words
 	WORD_DATABASE        string = "database"
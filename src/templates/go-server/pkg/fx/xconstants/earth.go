@@ -23,3 +23,21 @@ const EPSILON = 1e-6
 
 // GM constants definition
 const GM = 3.986e14
+
+// EARTH_EQUATORIAL_RADIUS_KM constants definition
+const EARTH_EQUATORIAL_RADIUS_KM float64 = EARTH_RADIUS / 1000.0
+
+// EARTH_MU_KM constants definition
+const EARTH_MU_KM float64 = GM / 1e9 // Gravitational parameter in km^3/s^2
+
+// EARTH_J2 constants definition
+const EARTH_J2 float64 = 1.08262668e-3 // Second zonal harmonic of the geopotential
+
+// EARTH_ROTATION_RATE constants definition
+const EARTH_ROTATION_RATE float64 = 7.2921159e-5 // Sidereal rotation rate in rad/s
+
+// SECONDS_PER_DAY constants definition
+const SECONDS_PER_DAY float64 = 86400.0
+
+// MINUTES_PER_DAY constants definition
+const MINUTES_PER_DAY float64 = 1440.0
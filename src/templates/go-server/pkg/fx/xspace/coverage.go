@@ -0,0 +1,172 @@
+package xspace
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	xconstants "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xconstants"
+)
+
+// CoverageTarget is a ground point whose access by a set of satellites is evaluated.
+type CoverageTarget struct {
+	ID        string
+	Latitude  float64 // Degrees
+	Longitude float64 // Degrees
+}
+
+// TargetCoverage summarizes the access of a ground point over an analysis window.
+type TargetCoverage struct {
+	TargetID         string
+	Latitude         float64       // Degrees
+	Longitude        float64       // Degrees
+	CoverageFraction float64       // Fraction of the window with at least one satellite in view
+	Accesses         int           // Number of distinct access intervals
+	MeanRevisit      time.Duration // Mean duration of the gaps, including the ones at the window boundaries
+	MaxGap           time.Duration // Longest interval without any satellite in view
+}
+
+// LatitudeBandCoverage aggregates the coverage of the targets within a latitude band.
+type LatitudeBandCoverage struct {
+	MinLatitude      float64       // Degrees, inclusive
+	MaxLatitude      float64       // Degrees, exclusive except for the northernmost band
+	Targets          int           // Number of targets in the band
+	CoverageFraction float64       // Mean coverage fraction of the targets
+	MeanRevisit      time.Duration // Mean of the target mean revisits
+	MaxGap           time.Duration // Longest gap of any target
+}
+
+// ElevationFromSubpoint returns the elevation in degrees of a satellite seen from a ground point,
+// computed on a spherical Earth from the satellite subpoint and altitude.
+func ElevationFromSubpoint(groundLat, groundLon, satLat, satLon, satAltitudeKm float64) float64 {
+	lat1, lat2 := DegreesToRadians(groundLat), DegreesToRadians(satLat)
+	dLat := lat2 - lat1
+	dLon := DegreesToRadians(satLon - groundLon)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	centralAngle := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+
+	ratio := xconstants.EARTH_RADIUS_KM / (xconstants.EARTH_RADIUS_KM + satAltitudeKm)
+	return RadiansToDegrees(math.Atan2(math.Cos(centralAngle)-ratio, math.Sin(centralAngle)))
+}
+
+// ComputeCoverage evaluates the access of every target by a set of satellite tracks above minElevation.
+// Tracks must be sampled on the same grid, from start to end every step, as returned by PropagateRange.
+func ComputeCoverage(tracks [][]SatellitePosition, targets []CoverageTarget, minElevation float64, start time.Time, end time.Time, step time.Duration) ([]TargetCoverage, error) {
+	if step <= 0 || !end.After(start) {
+		return nil, fmt.Errorf("invalid coverage window")
+	}
+	samples := int(end.Sub(start)/step) + 1
+	for i, track := range tracks {
+		if len(track) != samples {
+			return nil, fmt.Errorf("track %d has %d samples, expected %d", i, len(track), samples)
+		}
+	}
+
+	coverages := make([]TargetCoverage, len(targets))
+	covered := make([]bool, samples)
+	for i, target := range targets {
+		for k := 0; k < samples; k++ {
+			covered[k] = false
+			for _, track := range tracks {
+				position := track[k]
+				if ElevationFromSubpoint(target.Latitude, target.Longitude, position.Latitude, position.Longitude, position.Altitude) >= minElevation {
+					covered[k] = true
+					break
+				}
+			}
+		}
+		coverages[i] = summarizeCoverage(target, covered, step)
+	}
+	return coverages, nil
+}
+
+// summarizeCoverage derives the access statistics of a target from its sampled visibility.
+func summarizeCoverage(target CoverageTarget, covered []bool, step time.Duration) TargetCoverage {
+	coverage := TargetCoverage{
+		TargetID:  target.ID,
+		Latitude:  target.Latitude,
+		Longitude: target.Longitude,
+	}
+
+	coveredSamples, gaps, gapSamples := 0, 0, 0
+	var totalGap time.Duration
+	closeGap := func() {
+		if gapSamples == 0 {
+			return
+		}
+		gap := time.Duration(gapSamples) * step
+		totalGap += gap
+		gaps++
+		if gap > coverage.MaxGap {
+			coverage.MaxGap = gap
+		}
+		gapSamples = 0
+	}
+
+	for k, inView := range covered {
+		if !inView {
+			gapSamples++
+			continue
+		}
+		closeGap()
+		coveredSamples++
+		if k == 0 || !covered[k-1] {
+			coverage.Accesses++
+		}
+	}
+	closeGap()
+
+	coverage.CoverageFraction = float64(coveredSamples) / float64(len(covered))
+	if gaps > 0 {
+		coverage.MeanRevisit = totalGap / time.Duration(gaps)
+	}
+	return coverage
+}
+
+// AggregateCoverageByLatitude groups target coverages into latitude bands of the given width, south to north.
+// Bands without any target are omitted.
+func AggregateCoverageByLatitude(coverages []TargetCoverage, bandWidth float64) ([]LatitudeBandCoverage, error) {
+	if bandWidth <= 0 || bandWidth > 180 {
+		return nil, fmt.Errorf("invalid latitude band width: %f", bandWidth)
+	}
+
+	type accumulator struct {
+		band         LatitudeBandCoverage
+		totalRevisit time.Duration
+	}
+	bands := make(map[int]*accumulator)
+	for _, coverage := range coverages {
+		index := int(math.Floor((coverage.Latitude + 90) / bandWidth))
+		if coverage.Latitude+90 >= 180 {
+			index = int(math.Ceil(180/bandWidth)) - 1 // North pole belongs to the last band
+		}
+
+		acc, ok := bands[index]
+		if !ok {
+			minLatitude := -90 + float64(index)*bandWidth
+			acc = &accumulator{band: LatitudeBandCoverage{
+				MinLatitude: minLatitude,
+				MaxLatitude: math.Min(minLatitude+bandWidth, 90),
+			}}
+			bands[index] = acc
+		}
+		acc.band.Targets++
+		acc.band.CoverageFraction += coverage.CoverageFraction
+		acc.totalRevisit += coverage.MeanRevisit
+		if coverage.MaxGap > acc.band.MaxGap {
+			acc.band.MaxGap = coverage.MaxGap
+		}
+	}
+
+	result := make([]LatitudeBandCoverage, 0, len(bands))
+	for _, acc := range bands {
+		acc.band.CoverageFraction /= float64(acc.band.Targets)
+		acc.band.MeanRevisit = acc.totalRevisit / time.Duration(acc.band.Targets)
+		result = append(result, acc.band)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].MinLatitude < result[j].MinLatitude
+	})
+	return result, nil
+}
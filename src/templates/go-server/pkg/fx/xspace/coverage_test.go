@@ -0,0 +1,105 @@
+package xspace
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestElevationFromSubpoint(t *testing.T) {
+	if got := ElevationFromSubpoint(10, 20, 10, 20, 500); math.Abs(got-90) > 1e-9 {
+		t.Errorf("Expected zenith elevation, got %f", got)
+	}
+
+	// At the horizon, the central angle is acos(R / (R + h))
+	horizonAngle := RadiansToDegrees(math.Acos(6371.0 / (6371.0 + 500)))
+	if got := ElevationFromSubpoint(0, 0, 0, horizonAngle, 500); math.Abs(got) > 1e-6 {
+		t.Errorf("Expected horizon elevation, got %f", got)
+	}
+	if got := ElevationFromSubpoint(0, 0, 0, 60, 500); got >= 0 {
+		t.Errorf("Expected a satellite below the horizon, got %f", got)
+	}
+}
+
+func TestComputeCoverageStatistics(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	step := time.Minute
+	end := start.Add(9 * step)
+
+	// The satellite is overhead the target at samples 2-3 and 7, far away otherwise
+	visible := map[int]bool{2: true, 3: true, 7: true}
+	var track []SatellitePosition
+	for k := 0; k < 10; k++ {
+		position := SatellitePosition{Latitude: 0, Longitude: 120, Altitude: 500, Time: start.Add(time.Duration(k) * step)}
+		if visible[k] {
+			position.Longitude = 0
+		}
+		track = append(track, position)
+	}
+
+	targets := []CoverageTarget{{ID: "equator", Latitude: 0, Longitude: 0}, {ID: "pole", Latitude: 89, Longitude: 0}}
+	coverages, err := ComputeCoverage([][]SatellitePosition{track}, targets, 10, start, end, step)
+	if err != nil {
+		t.Fatalf("ComputeCoverage returned an error: %v", err)
+	}
+
+	equator := coverages[0]
+	if math.Abs(equator.CoverageFraction-0.3) > 1e-9 {
+		t.Errorf("Expected 30%% coverage, got %f", equator.CoverageFraction)
+	}
+	if equator.Accesses != 2 {
+		t.Errorf("Expected 2 accesses, got %d", equator.Accesses)
+	}
+	// Gaps: samples 0-1 (2 min), 4-6 (3 min), 8-9 (2 min)
+	if equator.MaxGap != 3*time.Minute {
+		t.Errorf("Expected a 3 minutes max gap, got %v", equator.MaxGap)
+	}
+	if equator.MeanRevisit != 140*time.Second {
+		t.Errorf("Expected a 2m20s mean revisit, got %v", equator.MeanRevisit)
+	}
+
+	pole := coverages[1]
+	if pole.CoverageFraction != 0 || pole.Accesses != 0 || pole.MaxGap != 10*time.Minute {
+		t.Errorf("Expected an uncovered pole, got %+v", pole)
+	}
+}
+
+func TestComputeCoverageRejectsMisalignedTracks(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	track := []SatellitePosition{{}, {}}
+	if _, err := ComputeCoverage([][]SatellitePosition{track}, nil, 10, start, start.Add(5*time.Minute), time.Minute); err == nil {
+		t.Error("Expected an error for a track with the wrong number of samples")
+	}
+}
+
+func TestAggregateCoverageByLatitude(t *testing.T) {
+	coverages := []TargetCoverage{
+		{Latitude: -45, CoverageFraction: 0.2, MeanRevisit: 10 * time.Minute, MaxGap: 30 * time.Minute},
+		{Latitude: -41, CoverageFraction: 0.4, MeanRevisit: 20 * time.Minute, MaxGap: 50 * time.Minute},
+		{Latitude: 5, CoverageFraction: 1, MeanRevisit: 0, MaxGap: 0},
+		{Latitude: 90, CoverageFraction: 0, MeanRevisit: time.Hour, MaxGap: time.Hour},
+	}
+
+	bands, err := AggregateCoverageByLatitude(coverages, 10)
+	if err != nil {
+		t.Fatalf("AggregateCoverageByLatitude returned an error: %v", err)
+	}
+	if len(bands) != 3 {
+		t.Fatalf("Expected 3 bands, got %d", len(bands))
+	}
+
+	south := bands[0]
+	if south.MinLatitude != -50 || south.MaxLatitude != -40 || south.Targets != 2 {
+		t.Errorf("Unexpected southern band: %+v", south)
+	}
+	if math.Abs(south.CoverageFraction-0.3) > 1e-9 || south.MeanRevisit != 15*time.Minute || south.MaxGap != 50*time.Minute {
+		t.Errorf("Unexpected southern band statistics: %+v", south)
+	}
+	if north := bands[2]; north.MinLatitude != 80 || north.MaxLatitude != 90 {
+		t.Errorf("Expected the pole in the northernmost band, got %+v", north)
+	}
+
+	if _, err := AggregateCoverageByLatitude(coverages, 0); err == nil {
+		t.Error("Expected an error for a zero band width")
+	}
+}
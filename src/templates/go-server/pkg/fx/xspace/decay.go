@@ -0,0 +1,228 @@
+package xspace
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	xconstants "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xconstants"
+)
+
+const (
+	// REENTRY_ALTITUDE_KM altitude below which an object is considered reentered.
+	REENTRY_ALTITUDE_KM float64 = 120.0
+	// BSTAR_TO_BALLISTIC converts BSTAR (1/earth radii) into Cd*A/m (m^2/kg).
+	BSTAR_TO_BALLISTIC float64 = 12.741621
+	// DECAY_WINDOW_FRACTION relative uncertainty applied to the remaining lifetime.
+	DECAY_WINDOW_FRACTION float64 = 0.2
+	// DECAY_MAX_HORIZON is the longest lifetime the estimator integrates.
+	DECAY_MAX_HORIZON = 25 * 365 * 24 * time.Hour
+)
+
+// ErrNoDecay is returned when the object does not reenter within DECAY_MAX_HORIZON.
+var ErrNoDecay = errors.New("no reentry within the prediction horizon")
+
+// SpaceWeather holds the solar and geomagnetic activity driving the atmosphere density.
+type SpaceWeather struct {
+	F107 float64 // 10.7 cm solar radio flux in solar flux units
+	Ap   float64 // Daily planetary geomagnetic index
+}
+
+// DefaultSpaceWeather represents moderate solar activity.
+var DefaultSpaceWeather = SpaceWeather{F107: 150, Ap: 15}
+
+// DecayPrediction represents the predicted reentry of an object.
+type DecayPrediction struct {
+	Epoch       time.Time // Epoch of the element set used for the prediction
+	Decay       time.Time // Most likely reentry time
+	WindowStart time.Time // Earliest expected reentry time
+	WindowEnd   time.Time // Latest expected reentry time
+	Ballistic   float64   // Effective Cd*A/m in m^2/kg
+}
+
+// AtmosphereDensity returns the thermospheric density in kg/m^3 at an altitude in kilometers,
+// using the exponential model of the IPS Radio and Space Services (valid from 180 to 500 km).
+func AtmosphereDensity(altitudeKm float64, weather SpaceWeather) float64 {
+	temperature := 900 + 2.5*(weather.F107-70) + 1.5*weather.Ap
+	molecularMass := 27 - 0.012*(altitudeKm-200)
+	scaleHeight := temperature / molecularMass
+	return 6e-10 * math.Exp(-(altitudeKm-175)/scaleHeight)
+}
+
+// semiMajorAxisRate returns da/dt in km/s for a circular orbit under drag.
+func semiMajorAxisRate(semiMajorAxisKm, ballistic float64, weather SpaceWeather) float64 {
+	altitude := semiMajorAxisKm - xconstants.EARTH_EQUATORIAL_RADIUS_KM
+	density := AtmosphereDensity(altitude, weather)
+	aMeters := semiMajorAxisKm * 1000
+	return -math.Sqrt(xconstants.GM*aMeters) * ballistic * density / 1000
+}
+
+// EffectiveBallistic derives Cd*A/m in m^2/kg from the element history.
+// The observed mean motion derivative is preferred, BSTAR is used as a fallback.
+func EffectiveBallistic(history []MeanElements, weather SpaceWeather) (float64, error) {
+	if len(history) == 0 {
+		return 0, errors.New("empty element history")
+	}
+
+	sort.Slice(history, func(i, j int) bool {
+		return history[i].Epoch.Before(history[j].Epoch)
+	})
+	latest := history[len(history)-1]
+
+	// Mean motion derivative in rev/day^2, fitted over the history when possible
+	nDot := 2 * latest.MeanMotionDot
+	if fitted, ok := fitMeanMotionRate(history); ok {
+		nDot = fitted
+	}
+
+	if nDot > 0 {
+		a := latest.SemiMajorAxisKm()
+		// da/dt = -2/3 * a * ndot / n
+		aDot := -2.0 / 3.0 * a * nDot / latest.MeanMotion / xconstants.SECONDS_PER_DAY
+		unit := semiMajorAxisRate(a, 1, weather)
+		if unit < 0 {
+			return aDot / unit, nil
+		}
+	}
+
+	if latest.BStar > 0 {
+		return latest.BStar * BSTAR_TO_BALLISTIC, nil
+	}
+	return 0, errors.New("no drag signature in the element history")
+}
+
+// fitMeanMotionRate returns the least-squares slope of the mean motion over time in rev/day^2.
+func fitMeanMotionRate(history []MeanElements) (float64, bool) {
+	if len(history) < 2 {
+		return 0, false
+	}
+	origin := history[0].Epoch
+	var sumT, sumN, sumTT, sumTN float64
+	for _, elements := range history {
+		t := elements.Epoch.Sub(origin).Hours() / 24
+		sumT += t
+		sumN += elements.MeanMotion
+		sumTT += t * t
+		sumTN += t * elements.MeanMotion
+	}
+	count := float64(len(history))
+	denominator := count*sumTT - sumT*sumT
+	if denominator == 0 {
+		return 0, false
+	}
+	return (count*sumTN - sumT*sumN) / denominator, true
+}
+
+// PredictDecay integrates the orbit decay from the latest element set until reentry.
+// The orbit is approximated as circular at its perigee altitude.
+func PredictDecay(history []MeanElements, weather SpaceWeather) (DecayPrediction, error) {
+	ballistic, err := EffectiveBallistic(history, weather)
+	if err != nil {
+		return DecayPrediction{}, err
+	}
+	latest := history[len(history)-1]
+
+	a := latest.PerigeeAltitudeKm() + xconstants.EARTH_EQUATORIAL_RADIUS_KM
+	elapsed := 0.0 // seconds
+	horizon := DECAY_MAX_HORIZON.Seconds()
+	for a-xconstants.EARTH_EQUATORIAL_RADIUS_KM > REENTRY_ALTITUDE_KM {
+		rate := semiMajorAxisRate(a, ballistic, weather)
+		// Step so that the altitude drops by at most 1 km, capped to one day
+		step := math.Min(xconstants.SECONDS_PER_DAY, 1/math.Abs(rate))
+		a += rate * step
+		elapsed += step
+		if elapsed > horizon {
+			return DecayPrediction{}, ErrNoDecay
+		}
+	}
+
+	lifetime := time.Duration(elapsed * float64(time.Second))
+	window := time.Duration(float64(lifetime) * DECAY_WINDOW_FRACTION)
+	decay := latest.Epoch.Add(lifetime)
+	return DecayPrediction{
+		Epoch:       latest.Epoch,
+		Decay:       decay,
+		WindowStart: decay.Add(-window),
+		WindowEnd:   decay.Add(window),
+		Ballistic:   ballistic,
+	}, nil
+}
+
+// SpaceWeatherRecord represents one day of a space weather file.
+type SpaceWeatherRecord struct {
+	Date time.Time
+	SpaceWeather
+}
+
+// ParseSpaceWeatherCSV reads a CelesTrak space weather CSV file (SW-All.csv format).
+// Only the DATE, F10.7_OBS and AP_AVG columns are used; rows without values are skipped.
+func ParseSpaceWeatherCSV(r io.Reader) ([]SpaceWeatherRecord, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read space weather header: %w", err)
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.TrimSpace(strings.ToUpper(name))] = i
+	}
+	dateIdx, okDate := columns["DATE"]
+	fluxIdx, okFlux := columns["F10.7_OBS"]
+	apIdx, okAp := columns["AP_AVG"]
+	if !okDate || !okFlux || !okAp {
+		return nil, errors.New("space weather file must contain DATE, F10.7_OBS and AP_AVG columns")
+	}
+
+	var records []SpaceWeatherRecord
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read space weather row: %w", err)
+		}
+		if len(row) <= dateIdx || len(row) <= fluxIdx || len(row) <= apIdx {
+			continue
+		}
+		date, err := time.Parse("2006-01-02", strings.TrimSpace(row[dateIdx]))
+		if err != nil {
+			continue
+		}
+		flux, errFlux := strconv.ParseFloat(strings.TrimSpace(row[fluxIdx]), 64)
+		ap, errAp := strconv.ParseFloat(strings.TrimSpace(row[apIdx]), 64)
+		if errFlux != nil || errAp != nil {
+			continue
+		}
+		records = append(records, SpaceWeatherRecord{Date: date, SpaceWeather: SpaceWeather{F107: flux, Ap: ap}})
+	}
+	return records, nil
+}
+
+// AverageSpaceWeather averages the records of the given number of days ending at t.
+// DefaultSpaceWeather is returned when no record falls in the window.
+func AverageSpaceWeather(records []SpaceWeatherRecord, t time.Time, days int) SpaceWeather {
+	start := t.AddDate(0, 0, -days)
+	var sum SpaceWeather
+	count := 0
+	for _, record := range records {
+		if record.Date.Before(start) || record.Date.After(t) {
+			continue
+		}
+		sum.F107 += record.F107
+		sum.Ap += record.Ap
+		count++
+	}
+	if count == 0 {
+		return DefaultSpaceWeather
+	}
+	return SpaceWeather{F107: sum.F107 / float64(count), Ap: sum.Ap / float64(count)}
+}
@@ -0,0 +1,107 @@
+package xspace
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAtmosphereDensity(t *testing.T) {
+	low := AtmosphereDensity(200, DefaultSpaceWeather)
+	high := AtmosphereDensity(400, DefaultSpaceWeather)
+	if low <= high {
+		t.Errorf("Expected density to decrease with altitude: %e at 200 km, %e at 400 km", low, high)
+	}
+
+	active := AtmosphereDensity(400, SpaceWeather{F107: 250, Ap: 50})
+	if active <= high {
+		t.Errorf("Expected density to increase with solar activity: %e vs %e", active, high)
+	}
+}
+
+func TestPredictDecayISS(t *testing.T) {
+	elements, err := ParseMeanElements(mockTLELine1, mockTLELine2)
+	if err != nil {
+		t.Fatalf("ParseMeanElements returned an error: %v", err)
+	}
+
+	prediction, err := PredictDecay([]MeanElements{elements}, DefaultSpaceWeather)
+	if err != nil {
+		t.Fatalf("PredictDecay returned an error: %v", err)
+	}
+
+	lifetime := prediction.Decay.Sub(elements.Epoch)
+	if lifetime < 100*24*time.Hour || lifetime > 10*365*24*time.Hour {
+		t.Errorf("Unexpected ISS lifetime without reboost: %v", lifetime)
+	}
+	if !prediction.WindowStart.Before(prediction.Decay) || !prediction.WindowEnd.After(prediction.Decay) {
+		t.Errorf("Decay %v is not inside its window [%v, %v]", prediction.Decay, prediction.WindowStart, prediction.WindowEnd)
+	}
+}
+
+func TestPredictDecayLowObject(t *testing.T) {
+	epoch := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	elements := MeanElements{
+		Epoch:      epoch,
+		MeanMotion: 16.2,
+		BStar:      1e-3,
+	}
+
+	prediction, err := PredictDecay([]MeanElements{elements}, DefaultSpaceWeather)
+	if err != nil {
+		t.Fatalf("PredictDecay returned an error: %v", err)
+	}
+	if lifetime := prediction.Decay.Sub(epoch); lifetime > 30*24*time.Hour {
+		t.Errorf("Expected reentry within a month, got %v", lifetime)
+	}
+}
+
+func TestPredictDecayNoDrag(t *testing.T) {
+	elements, err := ParseMeanElements(mockGeoTLELine1, mockGeoTLELine2)
+	if err != nil {
+		t.Fatalf("ParseMeanElements returned an error: %v", err)
+	}
+	if _, err := PredictDecay([]MeanElements{elements}, DefaultSpaceWeather); err == nil {
+		t.Error("Expected an error for an object without drag")
+	}
+}
+
+func TestFitMeanMotionRate(t *testing.T) {
+	epoch := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	history := []MeanElements{
+		{Epoch: epoch, MeanMotion: 15.50},
+		{Epoch: epoch.AddDate(0, 0, 10), MeanMotion: 15.51},
+		{Epoch: epoch.AddDate(0, 0, 20), MeanMotion: 15.52},
+	}
+	rate, ok := fitMeanMotionRate(history)
+	if !ok {
+		t.Fatal("Expected a fitted rate")
+	}
+	if diff := rate - 0.001; diff > 1e-9 || diff < -1e-9 {
+		t.Errorf("Expected 0.001 rev/day^2, got %v", rate)
+	}
+}
+
+func TestParseSpaceWeatherCSV(t *testing.T) {
+	data := "DATE,BSRN,AP_AVG,F10.7_OBS\n" +
+		"2024-01-01,2596,10,160.5\n" +
+		"2024-01-02,2596,20,170.5\n" +
+		"2024-01-03,2596,,\n"
+
+	records, err := ParseSpaceWeatherCSV(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseSpaceWeatherCSV returned an error: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 records, got %d", len(records))
+	}
+
+	average := AverageSpaceWeather(records, time.Date(2024, time.January, 3, 0, 0, 0, 0, time.UTC), 81)
+	if average.F107 != 165.5 || average.Ap != 15 {
+		t.Errorf("Unexpected average space weather: %+v", average)
+	}
+
+	if fallback := AverageSpaceWeather(records, time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC), 81); fallback != DefaultSpaceWeather {
+		t.Errorf("Expected the default space weather, got %+v", fallback)
+	}
+}
@@ -0,0 +1,157 @@
+package xspace
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	xconstants "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xconstants"
+	"github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xtime"
+)
+
+// MeanElements holds the SGP4 mean elements carried by a two-line element set.
+type MeanElements struct {
+	Epoch         time.Time // Epoch of the element set
+	Inclination   float64   // Degrees
+	RAAN          float64   // Right ascension of the ascending node in degrees
+	Eccentricity  float64   // Dimensionless
+	ArgPerigee    float64   // Argument of perigee in degrees
+	MeanAnomaly   float64   // Degrees
+	MeanMotion    float64   // Revolutions per day
+	MeanMotionDot float64   // First derivative of the mean motion divided by two, in rev/day^2
+	BStar         float64   // Drag term in inverse earth radii
+}
+
+// elementField maps a fixed-width TLE column to its destination.
+type elementField struct {
+	name  string
+	value string
+	out   *float64
+}
+
+// ParseMeanElements extracts the mean elements from the two lines of a TLE.
+func ParseMeanElements(line1, line2 string) (MeanElements, error) {
+	if len(line1) < 61 || len(line2) < 63 {
+		return MeanElements{}, fmt.Errorf("invalid TLE: lines too short")
+	}
+
+	epoch, err := xtime.ParseEpoch(line1)
+	if err != nil {
+		return MeanElements{}, fmt.Errorf("failed to parse epoch: %w", err)
+	}
+
+	var elements MeanElements
+	elements.Epoch = epoch
+
+	fields := []elementField{
+		{"inclination", line2[8:16], &elements.Inclination},
+		{"raan", line2[17:25], &elements.RAAN},
+		{"argument of perigee", line2[34:42], &elements.ArgPerigee},
+		{"mean anomaly", line2[43:51], &elements.MeanAnomaly},
+		{"mean motion", line2[52:63], &elements.MeanMotion},
+		{"mean motion derivative", line1[33:43], &elements.MeanMotionDot},
+	}
+
+	for _, field := range fields {
+		value, err := strconv.ParseFloat(strings.TrimSpace(field.value), 64)
+		if err != nil {
+			return MeanElements{}, fmt.Errorf("invalid %s: %w", field.name, err)
+		}
+		*field.out = value
+	}
+
+	eccentricity, err := strconv.ParseFloat("0."+strings.TrimSpace(line2[26:33]), 64)
+	if err != nil {
+		return MeanElements{}, fmt.Errorf("invalid eccentricity: %w", err)
+	}
+	elements.Eccentricity = eccentricity
+
+	bstar, err := parseImpliedDecimal(line1[53:61])
+	if err != nil {
+		return MeanElements{}, fmt.Errorf("invalid bstar: %w", err)
+	}
+	elements.BStar = bstar
+
+	if elements.MeanMotion <= 0 {
+		return MeanElements{}, fmt.Errorf("invalid mean motion: %f", elements.MeanMotion)
+	}
+
+	return elements, nil
+}
+
+// parseImpliedDecimal parses the TLE "implied decimal point" notation (e.g. " 58234-4" = 0.58234e-4).
+func parseImpliedDecimal(field string) (float64, error) {
+	field = strings.TrimSpace(field)
+	if field == "" {
+		return 0, nil
+	}
+
+	sign := 1.0
+	if field[0] == '-' || field[0] == '+' {
+		if field[0] == '-' {
+			sign = -1.0
+		}
+		field = field[1:]
+	}
+
+	expIdx := strings.LastIndexAny(field, "+-")
+	if expIdx <= 0 {
+		mantissa, err := strconv.ParseFloat("0."+field, 64)
+		return sign * mantissa, err
+	}
+
+	mantissa, err := strconv.ParseFloat("0."+field[:expIdx], 64)
+	if err != nil {
+		return 0, err
+	}
+	exponent, err := strconv.Atoi(field[expIdx:])
+	if err != nil {
+		return 0, err
+	}
+	return sign * mantissa * math.Pow(10, float64(exponent)), nil
+}
+
+// PeriodMinutes returns the orbital period in minutes.
+func (e MeanElements) PeriodMinutes() float64 {
+	return xconstants.MINUTES_PER_DAY / e.MeanMotion
+}
+
+// SemiMajorAxisKm returns the semi-major axis in kilometers derived from the mean motion.
+func (e MeanElements) SemiMajorAxisKm() float64 {
+	n := e.MeanMotion * 2 * math.Pi / xconstants.SECONDS_PER_DAY // rad/s
+	return math.Cbrt(xconstants.EARTH_MU_KM / (n * n))
+}
+
+// ApogeeAltitudeKm returns the apogee altitude above the equatorial radius in kilometers.
+func (e MeanElements) ApogeeAltitudeKm() float64 {
+	return e.SemiMajorAxisKm()*(1+e.Eccentricity) - xconstants.EARTH_EQUATORIAL_RADIUS_KM
+}
+
+// PerigeeAltitudeKm returns the perigee altitude above the equatorial radius in kilometers.
+func (e MeanElements) PerigeeAltitudeKm() float64 {
+	return e.SemiMajorAxisKm()*(1-e.Eccentricity) - xconstants.EARTH_EQUATORIAL_RADIUS_KM
+}
+
+// NodalPrecessionRate returns the secular drift of the ascending node caused by J2, in degrees per day.
+func NodalPrecessionRate(semiMajorAxisKm, eccentricity, inclination float64) float64 {
+	n := math.Sqrt(xconstants.EARTH_MU_KM / math.Pow(semiMajorAxisKm, 3)) // rad/s
+	p := semiMajorAxisKm * (1 - eccentricity*eccentricity)
+	re := xconstants.EARTH_EQUATORIAL_RADIUS_KM
+	rate := -1.5 * n * xconstants.EARTH_J2 * (re / p) * (re / p) * math.Cos(DegreesToRadians(inclination))
+	return RadiansToDegrees(rate) * xconstants.SECONDS_PER_DAY
+}
+
+// SunSynchronousInclination returns the inclination in degrees that makes the node drift
+// match the mean motion of the Sun (360 degrees per tropical year). The second value is false
+// when no such inclination exists for the given orbit size.
+func SunSynchronousInclination(semiMajorAxisKm, eccentricity float64) (float64, bool) {
+	const sunRate = 360.0 / 365.2422 // deg/day
+	baseRate := NodalPrecessionRate(semiMajorAxisKm, eccentricity, 0)
+	cosI := -sunRate / math.Abs(baseRate)
+	if cosI < -1 || cosI > 1 {
+		return 0, false
+	}
+	return RadiansToDegrees(math.Acos(cosI)), true
+}
@@ -0,0 +1,80 @@
+package xspace
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseMeanElements(t *testing.T) {
+	elements, err := ParseMeanElements(mockTLELine1, mockTLELine2)
+	if err != nil {
+		t.Fatalf("ParseMeanElements returned an error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		got      float64
+		expected float64
+	}{
+		{"Inclination", elements.Inclination, 51.6442},
+		{"RAAN", elements.RAAN, 176.8457},
+		{"Eccentricity", elements.Eccentricity, 0.0003392},
+		{"ArgPerigee", elements.ArgPerigee, 45.8666},
+		{"MeanAnomaly", elements.MeanAnomaly, 36.0921},
+		{"MeanMotion", elements.MeanMotion, 15.48815362},
+		{"MeanMotionDot", elements.MeanMotionDot, 0.00002907},
+		{"BStar", elements.BStar, 0.58234e-4},
+	}
+
+	for _, tt := range tests {
+		if math.Abs(tt.got-tt.expected) > 1e-9 {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, tt.got)
+		}
+	}
+
+	if elements.Epoch.Year() != 2021 || elements.Epoch.YearDay() != 275 {
+		t.Errorf("Unexpected epoch: %v", elements.Epoch)
+	}
+}
+
+func TestParseMeanElementsInvalid(t *testing.T) {
+	if _, err := ParseMeanElements("1 25544U", mockTLELine2); err == nil {
+		t.Error("Expected an error for a truncated line 1")
+	}
+}
+
+func TestMeanElementsDerivedQuantities(t *testing.T) {
+	elements, err := ParseMeanElements(mockTLELine1, mockTLELine2)
+	if err != nil {
+		t.Fatalf("ParseMeanElements returned an error: %v", err)
+	}
+
+	if period := elements.PeriodMinutes(); math.Abs(period-92.97) > 0.05 {
+		t.Errorf("Expected period around 92.97 min, got %f", period)
+	}
+
+	apogee := elements.ApogeeAltitudeKm()
+	perigee := elements.PerigeeAltitudeKm()
+	if apogee < perigee {
+		t.Errorf("Apogee %f is below perigee %f", apogee, perigee)
+	}
+	if perigee < 400 || apogee > 440 {
+		t.Errorf("Unexpected ISS altitudes: perigee %f, apogee %f", perigee, apogee)
+	}
+}
+
+func TestSunSynchronousInclination(t *testing.T) {
+	// A circular orbit at 800 km altitude is sun-synchronous at about 98.6 degrees.
+	inclination, ok := SunSynchronousInclination(6378.137+800, 0)
+	if !ok {
+		t.Fatal("Expected a sun-synchronous inclination to exist")
+	}
+	if math.Abs(inclination-98.6) > 0.1 {
+		t.Errorf("Expected inclination around 98.6, got %f", inclination)
+	}
+
+	// Far beyond ~6000 km altitude, J2 is too weak to match the Sun's rate.
+	if _, ok := SunSynchronousInclination(6378.137+20000, 0); ok {
+		t.Error("Expected no sun-synchronous inclination for a MEO orbit")
+	}
+}
@@ -78,6 +78,21 @@ func CalculateIntegratedElevationFromPoint(satellitePos xpolygon.Point, satellit
 	return elevation
 }
 
+// CalculateElevationFromObserver computes the elevation of a satellite above the local horizon of an observer,
+// accounting for the observer altitude and, when the observer has an atmosphere, for refraction.
+// Unlike CalculateIntegratedElevationFromPoint, negative elevations are returned for satellites below the horizon.
+func CalculateElevationFromObserver(satellitePos xpolygon.Point, satelliteAltKm float64, observer Observer) float64 {
+	groundX, groundY, groundZ := LatLonToCartesian(observer.Latitude, observer.Longitude, observer.AltitudeKm)
+	satX, satY, satZ := LatLonToCartesian(satellitePos.Latitude, satellitePos.Longitude, satelliteAltKm)
+
+	// Line of sight from the observer to the satellite and local zenith of the observer
+	vecX, vecY, vecZ := Normalize(satX-groundX, satY-groundY, satZ-groundZ)
+	zenithX, zenithY, zenithZ := Normalize(groundX, groundY, groundZ)
+
+	sinElevation := math.Max(-1, math.Min(1, DotProduct(vecX, vecY, vecZ, zenithX, zenithY, zenithZ)))
+	return ApparentElevation(RadiansToDegrees(math.Asin(sinElevation)), observer.Refraction)
+}
+
 // Helper function to convert degrees to radians
 func DegreesToRadians(degrees float64) float64 {
 	return degrees * xconstants.PI_DIVIDE_BY_180
@@ -0,0 +1,240 @@
+package xspace
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	xconstants "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xconstants"
+	"github.com/joshuaferrara/go-satellite"
+)
+
+// Planet identifies a major planet.
+type Planet string
+
+const (
+	Mercury Planet = "MERCURY"
+	Venus   Planet = "VENUS"
+	Mars    Planet = "MARS"
+	Jupiter Planet = "JUPITER"
+	Saturn  Planet = "SATURN"
+	Uranus  Planet = "URANUS"
+	Neptune Planet = "NEPTUNE"
+)
+
+// Planets lists the major planets other than the Earth, by distance from the Sun.
+var Planets = []Planet{Mercury, Venus, Mars, Jupiter, Saturn, Uranus, Neptune}
+
+// keplerianElements are mean orbital elements referred to the J2000 ecliptic and equinox,
+// with their rates per Julian century. Angles are in degrees and the semi-major axis in AU.
+type keplerianElements struct {
+	a, e, i, l, perihelion, node                   float64
+	aRate, eRate, iRate, lRate, periRate, nodeRate float64
+}
+
+// planetElements are the approximate elements of JPL (Standish) valid from 1800 to 2050,
+// accurate to a few arcminutes for the inner planets.
+var planetElements = map[Planet]keplerianElements{
+	Mercury: {0.38709927, 0.20563593, 7.00497902, 252.25032350, 77.45779628, 48.33076593,
+		0.00000037, 0.00001906, -0.00594749, 149472.67411175, 0.16047689, -0.12534081},
+	Venus: {0.72333566, 0.00677672, 3.39467605, 181.97909950, 131.60246718, 76.67984255,
+		0.00000390, -0.00004107, -0.00078890, 58517.81538729, 0.00268329, -0.27769418},
+	Mars: {1.52371034, 0.09339410, 1.84969142, -4.55343205, -23.94362959, 49.55953891,
+		0.00001847, 0.00007882, -0.00813131, 19140.30268499, 0.44441088, -0.29257343},
+	Jupiter: {5.20288700, 0.04838624, 1.30439695, 34.39644051, 14.72847983, 100.47390909,
+		-0.00011607, -0.00013253, -0.00183714, 3034.74612775, 0.21252668, 0.20469106},
+	Saturn: {9.53667594, 0.05386179, 2.48599187, 49.95424423, 92.59887831, 113.66242448,
+		-0.00125060, -0.00050991, 0.00193609, 1222.49362201, -0.41897216, -0.28867794},
+	Uranus: {19.18916464, 0.04725744, 0.77263783, 313.23810451, 170.95427630, 74.01692503,
+		-0.00196176, -0.00004397, -0.00242939, 428.48202785, 0.40805281, 0.04240589},
+	Neptune: {30.06992276, 0.00859048, 1.77004347, -55.12002969, 44.96476227, 131.78422574,
+		0.00026291, 0.00005105, 0.00035372, 218.45945325, -0.32241464, -0.00508664},
+}
+
+// earthMoonBarycenterElements are the elements of the Earth-Moon barycenter, used as the position of the Earth.
+var earthMoonBarycenterElements = keplerianElements{1.00000261, 0.01671123, -0.00001531, 100.46457166, 102.93768193, 0.0,
+	0.00000562, -0.00004392, -0.01294668, 35999.37244981, 0.32327364, 0.0}
+
+// julianCenturiesSinceJ2000 returns the number of Julian centuries elapsed since the J2000 epoch.
+func julianCenturiesSinceJ2000(t time.Time) float64 {
+	return daysSinceJ2000(t) / xconstants.DAYS_PER_JULIAN_CENTURY
+}
+
+// MoonPosition returns the position of the Moon using the low-precision series of the
+// Astronomical Almanac (about 0.3 degree in longitude and 0.2 degree in latitude).
+func MoonPosition(t time.Time) CelestialPosition {
+	T := julianCenturiesSinceJ2000(t)
+	sinDeg := func(degrees float64) float64 { return math.Sin(DegreesToRadians(degrees)) }
+	cosDeg := func(degrees float64) float64 { return math.Cos(DegreesToRadians(degrees)) }
+
+	longitude := 218.32 + 481267.881*T +
+		6.29*sinDeg(135.0+477198.87*T) -
+		1.27*sinDeg(259.3-413335.36*T) +
+		0.66*sinDeg(235.7+890534.22*T) +
+		0.21*sinDeg(269.9+954397.74*T) -
+		0.19*sinDeg(357.5+35999.05*T) -
+		0.11*sinDeg(186.5+966404.03*T)
+	latitude := 5.13*sinDeg(93.3+483202.02*T) +
+		0.28*sinDeg(228.2+960400.89*T) -
+		0.28*sinDeg(318.3+6003.15*T) -
+		0.17*sinDeg(217.6-407332.21*T)
+	parallax := 0.9508 +
+		0.0518*cosDeg(135.0+477198.87*T) +
+		0.0095*cosDeg(259.3-413335.36*T) +
+		0.0078*cosDeg(235.7+890534.22*T) +
+		0.0028*cosDeg(269.9+954397.74*T)
+
+	distance := xconstants.EARTH_EQUATORIAL_RADIUS_KM / sinDeg(parallax)
+	return eclipticToCelestialPosition(DegreesToRadians(longitude), DegreesToRadians(latitude), distance, t)
+}
+
+// PlanetPosition returns the geocentric position of a planet from its mean Keplerian elements.
+// The heliocentric positions of the planet and of the Earth-Moon barycenter are differenced,
+// so light time and aberration are ignored, which is well within the accuracy of the elements.
+func PlanetPosition(planet Planet, t time.Time) (CelestialPosition, error) {
+	elements, ok := planetElements[planet]
+	if !ok {
+		return CelestialPosition{}, fmt.Errorf("unsupported planet: %s", planet)
+	}
+
+	T := julianCenturiesSinceJ2000(t)
+	px, py, pz := elements.heliocentricPosition(T)
+	ex, ey, ez := earthMoonBarycenterElements.heliocentricPosition(T)
+	x, y, z := px-ex, py-ey, pz-ez
+
+	// Refer the J2000 ecliptic longitude to the equinox of date, as for the Sun and the Moon
+	longitude := math.Atan2(y, x) + DegreesToRadians(xconstants.GENERAL_PRECESSION_DEG_PER_CENTURY*T)
+	latitude := math.Atan2(z, math.Hypot(x, y))
+	distance := math.Sqrt(x*x+y*y+z*z) * xconstants.ASTRONOMICAL_UNIT_KM
+
+	return eclipticToCelestialPosition(longitude, latitude, distance, t), nil
+}
+
+// heliocentricPosition returns the heliocentric ecliptic coordinates in AU, T Julian centuries after J2000.
+func (k keplerianElements) heliocentricPosition(T float64) (float64, float64, float64) {
+	a := k.a + k.aRate*T
+	e := k.e + k.eRate*T
+	i := DegreesToRadians(k.i + k.iRate*T)
+	l := k.l + k.lRate*T
+	perihelion := k.perihelion + k.periRate*T
+	node := DegreesToRadians(k.node + k.nodeRate*T)
+
+	argPerihelion := DegreesToRadians(perihelion) - node
+	meanAnomaly := DegreesToRadians(math.Mod(l-perihelion, 360))
+	eccentricAnomaly := solveKepler(meanAnomaly, e)
+
+	// Position in the orbital plane, x towards the perihelion
+	xOrbit := a * (math.Cos(eccentricAnomaly) - e)
+	yOrbit := a * math.Sqrt(1-e*e) * math.Sin(eccentricAnomaly)
+
+	cosW, sinW := math.Cos(argPerihelion), math.Sin(argPerihelion)
+	cosN, sinN := math.Cos(node), math.Sin(node)
+	cosI, sinI := math.Cos(i), math.Sin(i)
+
+	x := (cosW*cosN-sinW*sinN*cosI)*xOrbit + (-sinW*cosN-cosW*sinN*cosI)*yOrbit
+	y := (cosW*sinN+sinW*cosN*cosI)*xOrbit + (-sinW*sinN+cosW*cosN*cosI)*yOrbit
+	z := sinW*sinI*xOrbit + cosW*sinI*yOrbit
+	return x, y, z
+}
+
+// solveKepler solves Kepler's equation M = E - e sin(E) for the eccentric anomaly, in radians.
+func solveKepler(meanAnomaly, eccentricity float64) float64 {
+	eccentricAnomaly := meanAnomaly + eccentricity*math.Sin(meanAnomaly)
+	for iteration := 0; iteration < 20; iteration++ {
+		delta := (eccentricAnomaly - eccentricity*math.Sin(eccentricAnomaly) - meanAnomaly) / (1 - eccentricity*math.Cos(eccentricAnomaly))
+		eccentricAnomaly -= delta
+		if math.Abs(delta) < 1e-12 {
+			break
+		}
+	}
+	return eccentricAnomaly
+}
+
+// ComputeCelestialLookAngle computes the topocentric azimuth, elevation and range of a body from an observer at time t.
+// Parallax is accounted for, which matters for the Moon. The elevation is corrected for refraction when the observer
+// has an atmosphere.
+func ComputeCelestialLookAngle(position CelestialPosition, observer Observer, t time.Time) LookAngle {
+	t = t.UTC()
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+
+	jday := satellite.JDay(year, int(month), day, hour, minute, second)
+	observerCoords := satellite.LatLong{
+		Latitude:  DegreesToRadians(observer.Latitude),
+		Longitude: DegreesToRadians(observer.Longitude),
+	}
+	angles := satellite.ECIToLookAngles(position.ECI, observerCoords, observer.AltitudeKm, jday)
+
+	return LookAngle{
+		Azimuth:   math.Mod(RadiansToDegrees(angles.Az)+360, 360),
+		Elevation: ApparentElevation(RadiansToDegrees(angles.El), observer.Refraction),
+		RangeKm:   angles.Rg,
+		Time:      t,
+	}
+}
+
+// AngularSeparation returns the angle in degrees between two directions seen from the same observer.
+func AngularSeparation(a, b LookAngle) float64 {
+	elA, elB := DegreesToRadians(a.Elevation), DegreesToRadians(b.Elevation)
+	deltaAz := DegreesToRadians(a.Azimuth - b.Azimuth)
+
+	cosSeparation := math.Sin(elA)*math.Sin(elB) + math.Cos(elA)*math.Cos(elB)*math.Cos(deltaAz)
+	return RadiansToDegrees(math.Acos(math.Max(-1, math.Min(1, cosSeparation))))
+}
+
+// PassInterference reports the closest approaches of a satellite to the Sun and the Moon during a pass,
+// as seen from the observer. Close approaches raise the noise of radio links and blind optical sensors.
+type PassInterference struct {
+	MinSunSeparation    float64   // Degrees
+	MinSunSeparationAt  time.Time // Time of the closest approach to the Sun
+	MinMoonSeparation   float64   // Degrees
+	MinMoonSeparationAt time.Time // Time of the closest approach to the Moon
+}
+
+// NearSun reports whether the satellite came within the given number of degrees of the Sun.
+func (p PassInterference) NearSun(degrees float64) bool {
+	return p.MinSunSeparation <= degrees
+}
+
+// NearMoon reports whether the satellite came within the given number of degrees of the Moon.
+func (p PassInterference) NearMoon(degrees float64) bool {
+	return p.MinMoonSeparation <= degrees
+}
+
+// ComputePassInterference samples a pass at the given step and returns the closest approaches of the
+// satellite to the Sun and the Moon. Bodies below the horizon are included, since the separation is geometric.
+func ComputePassInterference(tleLine1, tleLine2 string, observer Observer, pass Pass, step time.Duration) (PassInterference, error) {
+	if step <= 0 {
+		return PassInterference{}, fmt.Errorf("step must be greater than zero")
+	}
+
+	satrec := satellite.TLEToSat(tleLine1, tleLine2, satellite.GravityWGS84)
+	if satrec.Error != 0 {
+		return PassInterference{}, fmt.Errorf("TLE to Satellite error code: %d", satrec.Error)
+	}
+
+	interference := PassInterference{MinSunSeparation: 180, MinMoonSeparation: 180}
+	for t := pass.AOS; ; t = t.Add(step) {
+		if t.After(pass.LOS) {
+			t = pass.LOS
+		}
+
+		angle, err := ComputeLookAngle(satrec, observer, t)
+		if err != nil {
+			return PassInterference{}, err
+		}
+		if separation := AngularSeparation(angle, ComputeCelestialLookAngle(SunPosition(t), observer, t)); separation < interference.MinSunSeparation {
+			interference.MinSunSeparation = separation
+			interference.MinSunSeparationAt = t
+		}
+		if separation := AngularSeparation(angle, ComputeCelestialLookAngle(MoonPosition(t), observer, t)); separation < interference.MinMoonSeparation {
+			interference.MinMoonSeparation = separation
+			interference.MinMoonSeparationAt = t
+		}
+
+		if !t.Before(pass.LOS) {
+			break
+		}
+	}
+	return interference, nil
+}
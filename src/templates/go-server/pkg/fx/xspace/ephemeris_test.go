@@ -0,0 +1,116 @@
+package xspace
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestMoonPosition(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 47.a
+	moon := MoonPosition(time.Date(1992, time.April, 12, 0, 0, 0, 0, time.UTC))
+
+	if math.Abs(LongitudeDifference(moon.RightAscension, 134.688)) > 0.5 {
+		t.Errorf("Expected right ascension 134.688, got %f", moon.RightAscension)
+	}
+	if math.Abs(moon.Declination-13.768) > 0.5 {
+		t.Errorf("Expected declination 13.768, got %f", moon.Declination)
+	}
+	if math.Abs(moon.DistanceKm-368409.7) > 1000 {
+		t.Errorf("Expected a distance of 368409.7 km, got %f", moon.DistanceKm)
+	}
+}
+
+func TestPlanetPosition(t *testing.T) {
+	tests := []struct {
+		name        string
+		planet      Planet
+		time        time.Time
+		ra          float64
+		declination float64
+		distanceAU  float64
+	}{
+		// Meeus, Astronomical Algorithms, example 33.a
+		{"Venus", Venus, time.Date(1992, time.December, 20, 0, 0, 0, 0, time.UTC), 316.173, -18.888, 0.911},
+		// Closest approach of Mars in 2003
+		{"Mars opposition", Mars, time.Date(2003, time.August, 27, 10, 0, 0, 0, time.UTC), 339.6, -15.8, 0.373},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			position, err := PlanetPosition(tt.planet, tt.time)
+			if err != nil {
+				t.Fatalf("PlanetPosition returned an error: %v", err)
+			}
+			if math.Abs(LongitudeDifference(position.RightAscension, tt.ra)) > 0.3 {
+				t.Errorf("Expected right ascension %f, got %f", tt.ra, position.RightAscension)
+			}
+			if math.Abs(position.Declination-tt.declination) > 0.3 {
+				t.Errorf("Expected declination %f, got %f", tt.declination, position.Declination)
+			}
+			if au := position.DistanceKm / 149597870.7; math.Abs(au-tt.distanceAU) > 0.01 {
+				t.Errorf("Expected a distance of %f AU, got %f", tt.distanceAU, au)
+			}
+		})
+	}
+
+	if _, err := PlanetPosition(Planet("PLUTO"), time.Now()); err == nil {
+		t.Error("Expected an error for an unsupported planet")
+	}
+}
+
+func TestComputeCelestialLookAngle(t *testing.T) {
+	// The Sun transits close to the zenith of the equator at the March equinox
+	noon := time.Date(2021, time.March, 20, 12, 7, 0, 0, time.UTC)
+	angle := ComputeCelestialLookAngle(SunPosition(noon), Observer{}, noon)
+	if angle.Elevation < 89 {
+		t.Errorf("Expected the Sun near the zenith, got an elevation of %f", angle.Elevation)
+	}
+
+	midnight := noon.Add(12 * time.Hour)
+	if angle := ComputeCelestialLookAngle(SunPosition(midnight), Observer{}, midnight); angle.Elevation > -89 {
+		t.Errorf("Expected the Sun near the nadir, got an elevation of %f", angle.Elevation)
+	}
+}
+
+func TestAngularSeparation(t *testing.T) {
+	tests := []struct {
+		a, b     LookAngle
+		expected float64
+	}{
+		{LookAngle{Azimuth: 10, Elevation: 20}, LookAngle{Azimuth: 10, Elevation: 20}, 0},
+		{LookAngle{Azimuth: 0, Elevation: 0}, LookAngle{Azimuth: 90, Elevation: 0}, 90},
+		{LookAngle{Azimuth: 0, Elevation: 0}, LookAngle{Azimuth: 180, Elevation: 0}, 180},
+		{LookAngle{Azimuth: 45, Elevation: 90}, LookAngle{Azimuth: 200, Elevation: 30}, 60},
+	}
+
+	for _, tt := range tests {
+		if got := AngularSeparation(tt.a, tt.b); math.Abs(got-tt.expected) > 1e-6 {
+			t.Errorf("AngularSeparation(%+v, %+v): expected %v, got %v", tt.a, tt.b, tt.expected, got)
+		}
+	}
+}
+
+func TestComputePassInterference(t *testing.T) {
+	observer := Observer{Latitude: 48.8566, Longitude: 2.3522}
+	start := time.Date(2021, time.October, 3, 0, 0, 0, 0, time.UTC)
+
+	passes, err := PredictPasses(mockTLELine1, mockTLELine2, observer, 0, nil, start, start.Add(24*time.Hour), 30*time.Second)
+	if err != nil || len(passes) == 0 {
+		t.Fatalf("Expected passes to analyse: %v", err)
+	}
+
+	interference, err := ComputePassInterference(mockTLELine1, mockTLELine2, observer, passes[0], 10*time.Second)
+	if err != nil {
+		t.Fatalf("ComputePassInterference returned an error: %v", err)
+	}
+	if interference.MinSunSeparation < 0 || interference.MinSunSeparation > 180 || interference.MinMoonSeparation < 0 || interference.MinMoonSeparation > 180 {
+		t.Errorf("Separations out of range: %+v", interference)
+	}
+	if interference.MinSunSeparationAt.Before(passes[0].AOS) || interference.MinSunSeparationAt.After(passes[0].LOS) {
+		t.Errorf("Closest approach to the Sun outside of the pass: %+v", interference)
+	}
+	if !interference.NearSun(180) || interference.NearMoon(-1) {
+		t.Error("Unexpected proximity flags")
+	}
+}
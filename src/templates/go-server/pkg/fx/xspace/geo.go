@@ -0,0 +1,73 @@
+package xspace
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/joshuaferrara/go-satellite"
+)
+
+// SIDEREAL_REVS_PER_DAY is the number of Earth rotations per solar day, i.e. the mean motion of an ideal geostationary orbit.
+const SIDEREAL_REVS_PER_DAY float64 = 1.00273790935
+
+// GeoState describes the position of a geosynchronous object relative to the Earth.
+type GeoState struct {
+	Longitude   float64   // Subsatellite longitude in degrees, in [-180, 180)
+	Latitude    float64   // Subsatellite latitude in degrees
+	DriftRate   float64   // Longitude drift in degrees per day, positive eastward
+	Inclination float64   // Degrees
+	Time        time.Time // Time of the state
+}
+
+// NormalizeLongitude wraps a longitude in degrees into [-180, 180).
+func NormalizeLongitude(longitude float64) float64 {
+	longitude = math.Mod(longitude+180, 360)
+	if longitude < 0 {
+		longitude += 360
+	}
+	return longitude - 180
+}
+
+// LongitudeDifference returns the signed shortest angular distance from a to b in degrees.
+func LongitudeDifference(a, b float64) float64 {
+	return NormalizeLongitude(b - a)
+}
+
+// GeoDriftRate returns the mean longitude drift in degrees per day for a given mean motion in rev/day.
+func GeoDriftRate(meanMotion float64) float64 {
+	return (meanMotion - SIDEREAL_REVS_PER_DAY) * 360.0
+}
+
+// ComputeGeoState propagates the TLE to t and returns the subsatellite point and the drift rate.
+func ComputeGeoState(tleLine1, tleLine2 string, t time.Time) (GeoState, error) {
+	elements, err := ParseMeanElements(tleLine1, tleLine2)
+	if err != nil {
+		return GeoState{}, err
+	}
+
+	satrec := satellite.TLEToSat(tleLine1, tleLine2, satellite.GravityWGS84)
+	if satrec.Error != 0 {
+		return GeoState{}, fmt.Errorf("TLE to Satellite error code: %d", satrec.Error)
+	}
+
+	t = t.UTC()
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+
+	position, _ := satellite.Propagate(satrec, year, int(month), day, hour, minute, second)
+	if satrec.Error != 0 {
+		return GeoState{}, fmt.Errorf("propagation error code: %d at %v", satrec.Error, t)
+	}
+
+	gmst := satellite.GSTimeFromDate(year, int(month), day, hour, minute, second)
+	_, _, geoPosition := satellite.ECIToLLA(position, gmst)
+
+	return GeoState{
+		Longitude:   NormalizeLongitude(RadiansToDegrees(geoPosition.Longitude)),
+		Latitude:    RadiansToDegrees(geoPosition.Latitude),
+		DriftRate:   GeoDriftRate(elements.MeanMotion),
+		Inclination: elements.Inclination,
+		Time:        t,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package xspace
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+const (
+	mockGeoTLELine1 = "1 41866U 16071A   21275.50000000 -.00000267  00000-0  00000-0 0  9990"
+	mockGeoTLELine2 = "2 41866   0.0367 263.4140 0000792 174.2618 212.0470  1.00272072 17755"
+)
+
+func TestNormalizeLongitude(t *testing.T) {
+	tests := []struct {
+		input    float64
+		expected float64
+	}{
+		{0, 0},
+		{190, -170},
+		{-190, 170},
+		{540, -180},
+		{-75.2, -75.2},
+	}
+
+	for _, tt := range tests {
+		if got := NormalizeLongitude(tt.input); math.Abs(got-tt.expected) > 1e-9 {
+			t.Errorf("NormalizeLongitude(%v): expected %v, got %v", tt.input, tt.expected, got)
+		}
+	}
+}
+
+func TestLongitudeDifference(t *testing.T) {
+	if diff := LongitudeDifference(179, -179); math.Abs(diff-2) > 1e-9 {
+		t.Errorf("Expected 2 degrees across the antimeridian, got %v", diff)
+	}
+	if diff := LongitudeDifference(-179, 179); math.Abs(diff+2) > 1e-9 {
+		t.Errorf("Expected -2 degrees across the antimeridian, got %v", diff)
+	}
+}
+
+func TestGeoDriftRate(t *testing.T) {
+	if drift := GeoDriftRate(SIDEREAL_REVS_PER_DAY); math.Abs(drift) > 1e-9 {
+		t.Errorf("Expected no drift at the sidereal rate, got %v", drift)
+	}
+	if drift := GeoDriftRate(1.00272072); math.Abs(drift+0.00619) > 0.0001 {
+		t.Errorf("Expected westward drift around -0.00619 deg/day, got %v", drift)
+	}
+}
+
+func TestComputeGeoState(t *testing.T) {
+	epoch := time.Date(2021, time.October, 2, 12, 0, 0, 0, time.UTC)
+	state, err := ComputeGeoState(mockGeoTLELine1, mockGeoTLELine2, epoch)
+	if err != nil {
+		t.Fatalf("ComputeGeoState returned an error: %v", err)
+	}
+
+	if state.Longitude < -180 || state.Longitude >= 180 {
+		t.Errorf("Longitude out of range: %v", state.Longitude)
+	}
+	if math.Abs(state.Latitude) > 1 {
+		t.Errorf("Expected a near-equatorial subsatellite point, got latitude %v", state.Latitude)
+	}
+
+	// A geostationary object barely moves over a few hours.
+	later, err := ComputeGeoState(mockGeoTLELine1, mockGeoTLELine2, epoch.Add(6*time.Hour))
+	if err != nil {
+		t.Fatalf("ComputeGeoState returned an error: %v", err)
+	}
+	if diff := LongitudeDifference(state.Longitude, later.Longitude); math.Abs(diff) > 0.5 {
+		t.Errorf("Expected a stable longitude, moved by %v degrees", diff)
+	}
+}
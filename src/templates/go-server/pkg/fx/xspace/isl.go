@@ -0,0 +1,183 @@
+package xspace
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	xconstants "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xconstants"
+	"github.com/joshuaferrara/go-satellite"
+)
+
+// DEFAULT_GRAZING_ALTITUDE_KM is the atmosphere layer an inter-satellite link must clear.
+const DEFAULT_GRAZING_ALTITUDE_KM float64 = 100.0
+
+// LinkWindow represents an interval during which two satellites can see each other.
+type LinkWindow struct {
+	Start      time.Time
+	End        time.Time
+	MinRangeKm float64 // Shortest distance between the satellites during the window
+	MaxRangeKm float64 // Longest distance between the satellites during the window
+}
+
+// Duration returns the length of the window.
+func (w LinkWindow) Duration() time.Duration {
+	return w.End.Sub(w.Start)
+}
+
+// HasLineOfSight checks if the segment between two ECI positions in kilometers clears
+// a spherical Earth inflated by the grazing altitude.
+func HasLineOfSight(a, b satellite.Vector3, grazingAltitudeKm float64) bool {
+	dx, dy, dz := b.X-a.X, b.Y-a.Y, b.Z-a.Z
+	lengthSquared := dx*dx + dy*dy + dz*dz
+	radius := xconstants.EARTH_EQUATORIAL_RADIUS_KM + grazingAltitudeKm
+
+	// Closest point of the segment to the Earth center
+	u := 0.0
+	if lengthSquared > 0 {
+		u = -(a.X*dx + a.Y*dy + a.Z*dz) / lengthSquared
+		u = math.Max(0, math.Min(1, u))
+	}
+	cx, cy, cz := a.X+u*dx, a.Y+u*dy, a.Z+u*dz
+	return math.Sqrt(cx*cx+cy*cy+cz*cz) > radius
+}
+
+// Distance returns the distance between two ECI positions in kilometers.
+func Distance(a, b satellite.Vector3) float64 {
+	dx, dy, dz := b.X-a.X, b.Y-a.Y, b.Z-a.Z
+	return math.Sqrt(dx*dx + dy*dy + dz*dz)
+}
+
+// propagateECI returns the ECI position of a satellite in kilometers at time t.
+func propagateECI(satrec satellite.Satellite, t time.Time) (satellite.Vector3, error) {
+	t = t.UTC()
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+	position, _ := satellite.Propagate(satrec, year, int(month), day, hour, minute, second)
+	if satrec.Error != 0 {
+		return satellite.Vector3{}, fmt.Errorf("propagation error code: %d at %v", satrec.Error, t)
+	}
+	return position, nil
+}
+
+// ComputeLinkWindows finds the intervals between start and end during which two satellites have a line of sight
+// clearing the grazing altitude. A positive maxRangeKm also limits the link distance.
+// Window boundaries are refined to the second.
+func ComputeLinkWindows(
+	tleLine1A, tleLine2A string,
+	tleLine1B, tleLine2B string,
+	grazingAltitudeKm float64,
+	maxRangeKm float64,
+	start, end time.Time,
+	step time.Duration,
+) ([]LinkWindow, error) {
+	if !end.After(start) {
+		return nil, errors.New("end must be after start")
+	}
+	if step <= 0 {
+		return nil, errors.New("step must be greater than zero")
+	}
+
+	satA := satellite.TLEToSat(tleLine1A, tleLine2A, satellite.GravityWGS84)
+	if satA.Error != 0 {
+		return nil, fmt.Errorf("TLE to Satellite error code: %d", satA.Error)
+	}
+	satB := satellite.TLEToSat(tleLine1B, tleLine2B, satellite.GravityWGS84)
+	if satB.Error != 0 {
+		return nil, fmt.Errorf("TLE to Satellite error code: %d", satB.Error)
+	}
+
+	linked := func(t time.Time) (float64, bool, error) {
+		a, err := propagateECI(satA, t)
+		if err != nil {
+			return 0, false, err
+		}
+		b, err := propagateECI(satB, t)
+		if err != nil {
+			return 0, false, err
+		}
+		distance := Distance(a, b)
+		inRange := maxRangeKm <= 0 || distance <= maxRangeKm
+		return distance, inRange && HasLineOfSight(a, b, grazingAltitudeKm), nil
+	}
+
+	// refine finds the first second at which the link state switches between a and b
+	refine := func(a, b time.Time, wasLinked bool) (time.Time, error) {
+		for b.Sub(a) > time.Second {
+			mid := a.Add(b.Sub(a) / 2).Truncate(time.Second)
+			if !mid.After(a) {
+				break
+			}
+			_, isLinked, err := linked(mid)
+			if err != nil {
+				return time.Time{}, err
+			}
+			if isLinked == wasLinked {
+				a = mid
+			} else {
+				b = mid
+			}
+		}
+		return b, nil
+	}
+
+	var windows []LinkWindow
+	var current *LinkWindow
+
+	previousTime := start
+	distance, previousLinked, err := linked(start)
+	if err != nil {
+		return nil, err
+	}
+	if previousLinked {
+		current = &LinkWindow{Start: start, MinRangeKm: distance, MaxRangeKm: distance}
+	}
+
+	for t := start.Add(step); ; t = t.Add(step) {
+		if t.After(end) {
+			t = end
+		}
+
+		distance, isLinked, err := linked(t)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case isLinked && !previousLinked:
+			linkStart, err := refine(previousTime, t, false)
+			if err != nil {
+				return nil, err
+			}
+			current = &LinkWindow{Start: linkStart, MinRangeKm: distance, MaxRangeKm: distance}
+		case !isLinked && previousLinked && current != nil:
+			linkEnd, err := refine(previousTime, t, true)
+			if err != nil {
+				return nil, err
+			}
+			current.End = linkEnd
+			windows = append(windows, *current)
+			current = nil
+		}
+
+		if isLinked && current != nil {
+			current.MinRangeKm = math.Min(current.MinRangeKm, distance)
+			current.MaxRangeKm = math.Max(current.MaxRangeKm, distance)
+		}
+
+		previousTime = t
+		previousLinked = isLinked
+		if !t.Before(end) {
+			break
+		}
+	}
+
+	// Close a window still open at the end of the interval
+	if current != nil {
+		current.End = end
+		windows = append(windows, *current)
+	}
+
+	return windows, nil
+}
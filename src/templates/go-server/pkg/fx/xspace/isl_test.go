@@ -0,0 +1,79 @@
+package xspace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/joshuaferrara/go-satellite"
+)
+
+func TestHasLineOfSight(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        satellite.Vector3
+		b        satellite.Vector3
+		grazing  float64
+		expected bool
+	}{
+		{"Neighbours in LEO", satellite.Vector3{X: 6800, Y: 0, Z: 0}, satellite.Vector3{X: 6700, Y: 1000, Z: 0}, 100, true},
+		{"Opposite sides of the Earth", satellite.Vector3{X: 7000, Y: 0, Z: 0}, satellite.Vector3{X: -7000, Y: 0, Z: 0}, 0, false},
+		{"Line grazing at 150 km", satellite.Vector3{X: 6528, Y: -3000, Z: 0}, satellite.Vector3{X: 6528, Y: 3000, Z: 0}, 0, true},
+		{"Line grazing at 150 km with a 200 km margin", satellite.Vector3{X: 6528, Y: -3000, Z: 0}, satellite.Vector3{X: 6528, Y: 3000, Z: 0}, 200, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasLineOfSight(tt.a, tt.b, tt.grazing); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestComputeLinkWindowsSameSatellite(t *testing.T) {
+	start := time.Date(2021, time.October, 3, 0, 0, 0, 0, time.UTC)
+	end := start.Add(6 * time.Hour)
+
+	windows, err := ComputeLinkWindows(mockTLELine1, mockTLELine2, mockTLELine1, mockTLELine2, DEFAULT_GRAZING_ALTITUDE_KM, 0, start, end, time.Minute)
+	if err != nil {
+		t.Fatalf("ComputeLinkWindows returned an error: %v", err)
+	}
+	if len(windows) != 1 || !windows[0].Start.Equal(start) || !windows[0].End.Equal(end) {
+		t.Fatalf("Expected a single window over the whole interval, got %+v", windows)
+	}
+}
+
+func TestComputeLinkWindowsLEOToGEO(t *testing.T) {
+	start := time.Date(2021, time.October, 3, 0, 0, 0, 0, time.UTC)
+	end := start.Add(12 * time.Hour)
+
+	windows, err := ComputeLinkWindows(mockTLELine1, mockTLELine2, mockGeoTLELine1, mockGeoTLELine2, DEFAULT_GRAZING_ALTITUDE_KM, 0, start, end, time.Minute)
+	if err != nil {
+		t.Fatalf("ComputeLinkWindows returned an error: %v", err)
+	}
+	if len(windows) < 2 {
+		t.Fatalf("Expected the Earth to interrupt the link at least once, got %d windows", len(windows))
+	}
+
+	var linked time.Duration
+	for _, window := range windows {
+		if !window.End.After(window.Start) {
+			t.Errorf("Invalid window %+v", window)
+		}
+		if window.MinRangeKm < 35000 || window.MaxRangeKm > 50000 {
+			t.Errorf("Unexpected LEO to GEO range in window %+v", window)
+		}
+		linked += window.Duration()
+	}
+	if ratio := linked.Hours() / 12; ratio < 0.4 || ratio > 0.9 {
+		t.Errorf("Unexpected link ratio %.2f", ratio)
+	}
+
+	limited, err := ComputeLinkWindows(mockTLELine1, mockTLELine2, mockGeoTLELine1, mockGeoTLELine2, DEFAULT_GRAZING_ALTITUDE_KM, 1000, start, end, time.Minute)
+	if err != nil {
+		t.Fatalf("ComputeLinkWindows returned an error: %v", err)
+	}
+	if len(limited) != 0 {
+		t.Errorf("Expected no window with a 1000 km range limit, got %d", len(limited))
+	}
+}
@@ -0,0 +1,269 @@
+package xspace
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/joshuaferrara/go-satellite"
+)
+
+// Observer represents a ground location looking at satellites.
+type Observer struct {
+	Latitude   float64     `json:"latitude"`             // Degrees
+	Longitude  float64     `json:"longitude"`            // Degrees
+	AltitudeKm float64     `json:"altitudeKm"`           // Altitude above the ellipsoid in kilometers
+	Refraction *Atmosphere `json:"refraction,omitempty"` // Conditions used to correct elevations for refraction, nil for geometric elevations
+}
+
+// LookAngle represents the topocentric direction of a satellite seen from an observer.
+type LookAngle struct {
+	Azimuth   float64   // Degrees clockwise from north, in [0, 360)
+	Elevation float64   // Degrees above the horizon
+	RangeKm   float64   // Slant range in kilometers
+	Time      time.Time // Time of the observation
+}
+
+// HorizonPoint is one vertex of a terrain mask.
+type HorizonPoint struct {
+	Azimuth   float64 `json:"azimuth"`   // Degrees clockwise from north
+	Elevation float64 `json:"elevation"` // Minimum visible elevation in degrees at this azimuth
+}
+
+// HorizonMask describes the terrain horizon of a site as a function of azimuth.
+// Elevations between points are linearly interpolated, wrapping around north.
+type HorizonMask []HorizonPoint
+
+// Validate checks that the mask points are within range.
+func (m HorizonMask) Validate() error {
+	for _, point := range m {
+		if point.Azimuth < 0 || point.Azimuth >= 360 {
+			return fmt.Errorf("mask azimuth out of range: %f", point.Azimuth)
+		}
+		if point.Elevation < -90 || point.Elevation > 90 {
+			return fmt.Errorf("mask elevation out of range: %f", point.Elevation)
+		}
+	}
+	return nil
+}
+
+// ElevationAt returns the mask elevation in degrees at the given azimuth. An empty mask is flat at 0.
+func (m HorizonMask) ElevationAt(azimuth float64) float64 {
+	if len(m) == 0 {
+		return 0
+	}
+	if len(m) == 1 {
+		return m[0].Elevation
+	}
+
+	points := make(HorizonMask, len(m))
+	copy(points, m)
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].Azimuth < points[j].Azimuth
+	})
+
+	azimuth = math.Mod(azimuth, 360)
+	if azimuth < 0 {
+		azimuth += 360
+	}
+
+	// Find the surrounding points, wrapping from the last point to the first one
+	previous := points[len(points)-1]
+	previousAz := previous.Azimuth - 360
+	for _, next := range points {
+		if azimuth <= next.Azimuth {
+			return interpolate(previousAz, previous.Elevation, next.Azimuth, next.Elevation, azimuth)
+		}
+		previous = next
+		previousAz = next.Azimuth
+	}
+	first := points[0]
+	return interpolate(previousAz, previous.Elevation, first.Azimuth+360, first.Elevation, azimuth)
+}
+
+func interpolate(x0, y0, x1, y1, x float64) float64 {
+	if x1 == x0 {
+		return y0
+	}
+	return y0 + (y1-y0)*(x-x0)/(x1-x0)
+}
+
+// Pass represents a satellite pass over an observer.
+type Pass struct {
+	AOS          time.Time // Acquisition of signal
+	TCA          time.Time // Time of closest approach (maximum elevation)
+	LOS          time.Time // Loss of signal
+	MaxElevation float64   // Degrees
+	AOSAzimuth   float64   // Degrees
+	LOSAzimuth   float64   // Degrees
+}
+
+// Duration returns the length of the pass.
+func (p Pass) Duration() time.Duration {
+	return p.LOS.Sub(p.AOS)
+}
+
+// ComputeLookAngle computes the azimuth, elevation and range of a satellite from an observer at time t.
+// The elevation is corrected for refraction when the observer has an atmosphere.
+func ComputeLookAngle(satrec satellite.Satellite, observer Observer, t time.Time) (LookAngle, error) {
+	t = t.UTC()
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+
+	position, _ := satellite.Propagate(satrec, year, int(month), day, hour, minute, second)
+	if satrec.Error != 0 {
+		return LookAngle{}, fmt.Errorf("propagation error code: %d at %v", satrec.Error, t)
+	}
+
+	jday := satellite.JDay(year, int(month), day, hour, minute, second)
+	observerCoords := satellite.LatLong{
+		Latitude:  DegreesToRadians(observer.Latitude),
+		Longitude: DegreesToRadians(observer.Longitude),
+	}
+	angles := satellite.ECIToLookAngles(position, observerCoords, observer.AltitudeKm, jday)
+
+	return LookAngle{
+		Azimuth:   RadiansToDegrees(angles.Az),
+		Elevation: ApparentElevation(RadiansToDegrees(angles.El), observer.Refraction),
+		RangeKm:   angles.Rg,
+		Time:      t,
+	}, nil
+}
+
+// ComputeLookAngleFromTLE computes the azimuth, elevation and range of the satellite described by a TLE
+// from an observer at time t.
+func ComputeLookAngleFromTLE(tleLine1, tleLine2 string, observer Observer, t time.Time) (LookAngle, error) {
+	satrec := satellite.TLEToSat(tleLine1, tleLine2, satellite.GravityWGS84)
+	if satrec.Error != 0 {
+		return LookAngle{}, fmt.Errorf("TLE to Satellite error code: %d", satrec.Error)
+	}
+	return ComputeLookAngle(satrec, observer, t)
+}
+
+// PredictPasses finds the passes of a satellite above an observer between start and end.
+// A satellite is visible when its elevation is above both minElevation and the horizon mask.
+// Crossings are refined to the second.
+func PredictPasses(
+	tleLine1, tleLine2 string,
+	observer Observer,
+	minElevation float64,
+	mask HorizonMask,
+	start, end time.Time,
+	step time.Duration,
+) ([]Pass, error) {
+	if !end.After(start) {
+		return nil, errors.New("end must be after start")
+	}
+	if step <= 0 {
+		return nil, errors.New("step must be greater than zero")
+	}
+
+	satrec := satellite.TLEToSat(tleLine1, tleLine2, satellite.GravityWGS84)
+	if satrec.Error != 0 {
+		return nil, fmt.Errorf("TLE to Satellite error code: %d", satrec.Error)
+	}
+
+	visible := func(t time.Time) (LookAngle, bool, error) {
+		angle, err := ComputeLookAngle(satrec, observer, t)
+		if err != nil {
+			return LookAngle{}, false, err
+		}
+		threshold := math.Max(minElevation, mask.ElevationAt(angle.Azimuth))
+		return angle, angle.Elevation >= threshold, nil
+	}
+
+	// refine finds the first second at which visibility switches between a and b
+	refine := func(a, b time.Time, wasVisible bool) (time.Time, error) {
+		for b.Sub(a) > time.Second {
+			mid := a.Add(b.Sub(a) / 2).Truncate(time.Second)
+			if !mid.After(a) {
+				break
+			}
+			_, isVisible, err := visible(mid)
+			if err != nil {
+				return time.Time{}, err
+			}
+			if isVisible == wasVisible {
+				a = mid
+			} else {
+				b = mid
+			}
+		}
+		return b, nil
+	}
+
+	var passes []Pass
+	var current *Pass
+
+	previousTime := start
+	startAngle, previousVisible, err := visible(start)
+	if err != nil {
+		return nil, err
+	}
+	if previousVisible {
+		current = &Pass{AOS: start, TCA: start, MaxElevation: startAngle.Elevation, AOSAzimuth: startAngle.Azimuth}
+	}
+
+	for t := start.Add(step); ; t = t.Add(step) {
+		if t.After(end) {
+			t = end
+		}
+
+		angle, isVisible, err := visible(t)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case isVisible && !previousVisible:
+			aos, err := refine(previousTime, t, false)
+			if err != nil {
+				return nil, err
+			}
+			aosAngle, _, err := visible(aos)
+			if err != nil {
+				return nil, err
+			}
+			current = &Pass{AOS: aos, TCA: aos, MaxElevation: aosAngle.Elevation, AOSAzimuth: aosAngle.Azimuth}
+		case !isVisible && previousVisible && current != nil:
+			los, err := refine(previousTime, t, true)
+			if err != nil {
+				return nil, err
+			}
+			losAngle, _, err := visible(los)
+			if err != nil {
+				return nil, err
+			}
+			current.LOS = los
+			current.LOSAzimuth = losAngle.Azimuth
+			passes = append(passes, *current)
+			current = nil
+		}
+
+		if isVisible && current != nil && angle.Elevation > current.MaxElevation {
+			current.MaxElevation = angle.Elevation
+			current.TCA = t
+		}
+
+		previousTime = t
+		previousVisible = isVisible
+		if !t.Before(end) {
+			break
+		}
+	}
+
+	// Close a pass still in progress at the end of the window
+	if current != nil {
+		angle, _, err := visible(end)
+		if err != nil {
+			return nil, err
+		}
+		current.LOS = end
+		current.LOSAzimuth = angle.Azimuth
+		passes = append(passes, *current)
+	}
+
+	return passes, nil
+}
@@ -0,0 +1,107 @@
+package xspace
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestHorizonMaskElevationAt(t *testing.T) {
+	mask := HorizonMask{
+		{Azimuth: 0, Elevation: 10},
+		{Azimuth: 90, Elevation: 20},
+		{Azimuth: 270, Elevation: 0},
+	}
+
+	tests := []struct {
+		azimuth  float64
+		expected float64
+	}{
+		{0, 10},
+		{45, 15},
+		{90, 20},
+		{180, 10},
+		{315, 5},
+		{-45, 5},
+	}
+
+	for _, tt := range tests {
+		if got := mask.ElevationAt(tt.azimuth); math.Abs(got-tt.expected) > 1e-9 {
+			t.Errorf("ElevationAt(%v): expected %v, got %v", tt.azimuth, tt.expected, got)
+		}
+	}
+
+	if got := (HorizonMask{}).ElevationAt(123); got != 0 {
+		t.Errorf("Expected a flat horizon for an empty mask, got %v", got)
+	}
+}
+
+func TestHorizonMaskValidate(t *testing.T) {
+	if err := (HorizonMask{{Azimuth: 360, Elevation: 0}}).Validate(); err == nil {
+		t.Error("Expected an error for an azimuth of 360")
+	}
+	if err := (HorizonMask{{Azimuth: 10, Elevation: 5}}).Validate(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestPredictPasses(t *testing.T) {
+	observer := Observer{Latitude: 48.8566, Longitude: 2.3522, AltitudeKm: 0.035}
+	start := time.Date(2021, time.October, 3, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	passes, err := PredictPasses(mockTLELine1, mockTLELine2, observer, 0, nil, start, end, 30*time.Second)
+	if err != nil {
+		t.Fatalf("PredictPasses returned an error: %v", err)
+	}
+	if len(passes) == 0 {
+		t.Fatal("Expected at least one ISS pass over Paris in a day")
+	}
+
+	for _, pass := range passes {
+		if !pass.AOS.Before(pass.LOS) || pass.TCA.Before(pass.AOS) || pass.TCA.After(pass.LOS) {
+			t.Errorf("Inconsistent pass times: %+v", pass)
+		}
+		if pass.MaxElevation < 0 || pass.MaxElevation > 90 {
+			t.Errorf("Unexpected max elevation: %v", pass.MaxElevation)
+		}
+		if pass.Duration() > 15*time.Minute {
+			t.Errorf("ISS pass too long: %v", pass.Duration())
+		}
+	}
+
+	// A high terrain mask hides most of the passes
+	mask := HorizonMask{{Azimuth: 0, Elevation: 45}}
+	masked, err := PredictPasses(mockTLELine1, mockTLELine2, observer, 0, mask, start, end, 30*time.Second)
+	if err != nil {
+		t.Fatalf("PredictPasses returned an error: %v", err)
+	}
+	if len(masked) >= len(passes) {
+		t.Errorf("Expected fewer passes with a 45 degrees mask: %d vs %d", len(masked), len(passes))
+	}
+	for _, pass := range masked {
+		if pass.MaxElevation < 45 {
+			t.Errorf("Masked pass below the mask: %v", pass.MaxElevation)
+		}
+	}
+}
+
+func TestPredictPassesInvalidWindow(t *testing.T) {
+	start := time.Date(2021, time.October, 3, 0, 0, 0, 0, time.UTC)
+	if _, err := PredictPasses(mockTLELine1, mockTLELine2, Observer{}, 0, nil, start, start, time.Minute); err == nil {
+		t.Error("Expected an error for an empty window")
+	}
+}
+
+func TestComputeLookAngleFromTLE(t *testing.T) {
+	observer := Observer{Latitude: 48.8566, Longitude: 2.3522}
+	at := time.Date(2021, time.October, 3, 0, 0, 0, 0, time.UTC)
+
+	angle, err := ComputeLookAngleFromTLE(mockTLELine1, mockTLELine2, observer, at)
+	if err != nil {
+		t.Fatalf("ComputeLookAngleFromTLE returned an error: %v", err)
+	}
+	if angle.Elevation < -90 || angle.Elevation > 90 || angle.RangeKm <= 0 || !angle.Time.Equal(at) {
+		t.Errorf("Unexpected look angle: %+v", angle)
+	}
+}
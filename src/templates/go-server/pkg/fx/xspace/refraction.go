@@ -0,0 +1,56 @@
+package xspace
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// StandardPressureMbar sea-level pressure of the standard atmosphere used by the refraction model.
+	StandardPressureMbar = 1010.0
+	// StandardTemperatureC sea-level temperature of the standard atmosphere used by the refraction model.
+	StandardTemperatureC = 10.0
+	// minRefractionElevation is the lowest true elevation, in degrees, at which the refraction formula is evaluated.
+	// Below it the correction is held constant so that elevations stay monotonic.
+	minRefractionElevation = -1.0
+)
+
+// Atmosphere holds the local conditions used to correct elevations for atmospheric refraction.
+type Atmosphere struct {
+	PressureMbar float64 `json:"pressureMbar"` // Surface pressure in millibars
+	TemperatureC float64 `json:"temperatureC"` // Surface temperature in degrees Celsius
+}
+
+// StandardAtmosphere returns the sea-level conditions for which the refraction formula is calibrated.
+func StandardAtmosphere() Atmosphere {
+	return Atmosphere{PressureMbar: StandardPressureMbar, TemperatureC: StandardTemperatureC}
+}
+
+// Validate checks that the conditions are physically plausible.
+func (a Atmosphere) Validate() error {
+	if a.PressureMbar <= 0 || a.PressureMbar > 1100 {
+		return fmt.Errorf("pressure out of range: %f", a.PressureMbar)
+	}
+	if a.TemperatureC < -90 || a.TemperatureC > 60 {
+		return fmt.Errorf("temperature out of range: %f", a.TemperatureC)
+	}
+	return nil
+}
+
+// Refraction returns the refraction in degrees that lifts an object seen at the given true (geometric) elevation,
+// using Saemundsson's formula scaled by pressure and temperature. It is about 0.48 degrees at the horizon
+// and vanishes at the zenith.
+func (a Atmosphere) Refraction(trueElevation float64) float64 {
+	h := math.Max(trueElevation, minRefractionElevation)
+	arcMinutes := 1.02 / math.Tan(DegreesToRadians(h+10.3/(h+5.11)))
+	arcMinutes *= (a.PressureMbar / StandardPressureMbar) * (283.0 / (273.0 + a.TemperatureC))
+	return math.Max(arcMinutes, 0) / 60
+}
+
+// ApparentElevation returns the elevation corrected for refraction. A nil atmosphere returns the true elevation.
+func ApparentElevation(trueElevation float64, atmosphere *Atmosphere) float64 {
+	if atmosphere == nil {
+		return trueElevation
+	}
+	return math.Min(trueElevation+atmosphere.Refraction(trueElevation), 90)
+}
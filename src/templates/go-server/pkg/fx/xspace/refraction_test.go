@@ -0,0 +1,111 @@
+package xspace
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	xpolygon "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xpolygon"
+)
+
+func TestAtmosphereRefraction(t *testing.T) {
+	standard := StandardAtmosphere()
+
+	// About 29 arcminutes at the horizon and nothing at the zenith
+	if got := standard.Refraction(0); math.Abs(got-0.483) > 0.01 {
+		t.Errorf("Expected about 0.483 degrees at the horizon, got %v", got)
+	}
+	if got := standard.Refraction(90); math.Abs(got) > 1e-3 {
+		t.Errorf("Expected no refraction at the zenith, got %v", got)
+	}
+	if standard.Refraction(5) >= standard.Refraction(0) {
+		t.Error("Expected refraction to decrease with elevation")
+	}
+
+	// Thinner, warmer air refracts less
+	highSite := Atmosphere{PressureMbar: 700, TemperatureC: 25}
+	if highSite.Refraction(0) >= standard.Refraction(0) {
+		t.Errorf("Expected less refraction at altitude: %v vs %v", highSite.Refraction(0), standard.Refraction(0))
+	}
+
+	// Held constant below the horizon so apparent elevations stay monotonic
+	if standard.Refraction(-10) != standard.Refraction(minRefractionElevation) {
+		t.Error("Expected a constant correction below the minimum elevation")
+	}
+}
+
+func TestAtmosphereValidate(t *testing.T) {
+	if err := StandardAtmosphere().Validate(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := (Atmosphere{PressureMbar: 0, TemperatureC: 10}).Validate(); err == nil {
+		t.Error("Expected an error for a zero pressure")
+	}
+	if err := (Atmosphere{PressureMbar: 1010, TemperatureC: 100}).Validate(); err == nil {
+		t.Error("Expected an error for a temperature of 100 degrees")
+	}
+}
+
+func TestApparentElevation(t *testing.T) {
+	if got := ApparentElevation(10, nil); got != 10 {
+		t.Errorf("Expected the true elevation without atmosphere, got %v", got)
+	}
+	standard := StandardAtmosphere()
+	if got := ApparentElevation(89.999, &standard); got > 90 {
+		t.Errorf("Apparent elevation above the zenith: %v", got)
+	}
+}
+
+func TestCalculateElevationFromObserver(t *testing.T) {
+	observer := Observer{Latitude: 10, Longitude: 20}
+
+	overhead := CalculateElevationFromObserver(xpolygon.Point{Latitude: 10, Longitude: 20}, 500, observer)
+	if math.Abs(overhead-90) > 1e-6 {
+		t.Errorf("Expected 90 degrees overhead, got %v", overhead)
+	}
+
+	// A satellite on the other side of the Earth is below the horizon
+	antipode := CalculateElevationFromObserver(xpolygon.Point{Latitude: -10, Longitude: -160}, 500, observer)
+	if antipode >= 0 {
+		t.Errorf("Expected a negative elevation at the antipode, got %v", antipode)
+	}
+
+	// A raised observer is closer to the satellite altitude, which sits lower above its local horizontal
+	satellite := xpolygon.Point{Latitude: 10, Longitude: 40}
+	seaLevel := CalculateElevationFromObserver(satellite, 500, observer)
+	mountain := CalculateElevationFromObserver(satellite, 500, Observer{Latitude: 10, Longitude: 20, AltitudeKm: 4})
+	if mountain >= seaLevel {
+		t.Errorf("Expected a lower elevation from a mountain: %v vs %v", mountain, seaLevel)
+	}
+
+	standard := StandardAtmosphere()
+	refracted := CalculateElevationFromObserver(satellite, 500, Observer{Latitude: 10, Longitude: 20, Refraction: &standard})
+	if refracted <= seaLevel {
+		t.Errorf("Expected refraction to raise the elevation: %v vs %v", refracted, seaLevel)
+	}
+}
+
+func TestPredictPassesWithRefraction(t *testing.T) {
+	start := time.Date(2021, time.October, 3, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	standard := StandardAtmosphere()
+
+	geometric, err := PredictPasses(mockTLELine1, mockTLELine2, Observer{Latitude: 48.8566, Longitude: 2.3522}, 0, nil, start, end, 30*time.Second)
+	if err != nil {
+		t.Fatalf("PredictPasses returned an error: %v", err)
+	}
+	refracted, err := PredictPasses(mockTLELine1, mockTLELine2, Observer{Latitude: 48.8566, Longitude: 2.3522, Refraction: &standard}, 0, nil, start, end, 30*time.Second)
+	if err != nil {
+		t.Fatalf("PredictPasses returned an error: %v", err)
+	}
+	if len(geometric) == 0 || len(refracted) != len(geometric) {
+		t.Fatalf("Expected the same passes with and without refraction: %d vs %d", len(refracted), len(geometric))
+	}
+
+	// Refraction lifts the satellite above the horizon earlier and keeps it later
+	for i := range geometric {
+		if !refracted[i].AOS.Before(geometric[i].AOS) || !refracted[i].LOS.After(geometric[i].LOS) {
+			t.Errorf("Expected a longer refracted pass: %+v vs %+v", refracted[i], geometric[i])
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package xspace
+
+import (
+	"math"
+	"time"
+
+	xconstants "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xconstants"
+)
+
+// OrbitLighting describes the illumination geometry of an orbit at a given time.
+type OrbitLighting struct {
+	Time            time.Time
+	RAAN            float64 // Right ascension of the ascending node at Time, in degrees
+	LTAN            float64 // Mean local time of the ascending node in hours, in [0, 24)
+	LTDN            float64 // Mean local time of the descending node in hours, in [0, 24)
+	BetaAngle       float64 // Angle between the orbit plane and the Sun direction in degrees
+	EclipseFraction float64 // Fraction of the orbit spent in the Earth shadow
+	EclipseMinutes  float64 // Eclipse duration per orbit in minutes
+}
+
+// LocalTimeOfNode returns the mean local solar time in hours at a node of right ascension raan.
+func LocalTimeOfNode(raan float64, t time.Time) float64 {
+	hours := 12 + (raan-SunMeanLongitude(t))/15
+	return math.Mod(math.Mod(hours, 24)+24, 24)
+}
+
+// BetaAngle returns the angle in degrees between an orbit plane and the Sun direction.
+// It is positive when the Sun lies on the side of the orbit normal.
+func BetaAngle(raan, inclination float64, t time.Time) float64 {
+	omega := DegreesToRadians(raan)
+	i := DegreesToRadians(inclination)
+	normal := [3]float64{math.Sin(i) * math.Sin(omega), -math.Sin(i) * math.Cos(omega), math.Cos(i)}
+
+	sun := SunPosition(t).ECI
+	norm := math.Sqrt(sun.X*sun.X + sun.Y*sun.Y + sun.Z*sun.Z)
+	dot := (normal[0]*sun.X + normal[1]*sun.Y + normal[2]*sun.Z) / norm
+	return RadiansToDegrees(math.Asin(dot))
+}
+
+// EclipseFraction returns the fraction of a circular orbit at the given altitude spent in the
+// cylindrical Earth shadow for a beta angle in degrees.
+func EclipseFraction(betaAngle, altitudeKm float64) float64 {
+	radius := xconstants.EARTH_EQUATORIAL_RADIUS_KM
+	r := radius + altitudeKm
+	beta := DegreesToRadians(math.Abs(betaAngle))
+	if math.Sin(beta) >= radius/r {
+		return 0
+	}
+	return math.Acos(math.Sqrt(altitudeKm*altitudeKm+2*radius*altitudeKm)/(r*math.Cos(beta))) / math.Pi
+}
+
+// ComputeOrbitLighting computes the node local times, beta angle and eclipse fraction of an orbit at time t.
+// The node is propagated from the element epoch with the J2 secular drift; the orbit is treated as circular
+// at its mean altitude for the eclipse.
+func ComputeOrbitLighting(elements MeanElements, t time.Time) OrbitLighting {
+	a := elements.SemiMajorAxisKm()
+	days := t.Sub(elements.Epoch).Hours() / 24
+	raan := math.Mod(math.Mod(elements.RAAN+NodalPrecessionRate(a, elements.Eccentricity, elements.Inclination)*days, 360)+360, 360)
+
+	ltan := LocalTimeOfNode(raan, t)
+	beta := BetaAngle(raan, elements.Inclination, t)
+	fraction := EclipseFraction(beta, a-xconstants.EARTH_EQUATORIAL_RADIUS_KM)
+
+	return OrbitLighting{
+		Time:            t,
+		RAAN:            raan,
+		LTAN:            ltan,
+		LTDN:            math.Mod(ltan+12, 24),
+		BetaAngle:       beta,
+		EclipseFraction: fraction,
+		EclipseMinutes:  fraction * elements.PeriodMinutes(),
+	}
+}
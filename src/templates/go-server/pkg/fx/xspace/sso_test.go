@@ -0,0 +1,70 @@
+package xspace
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestLocalTimeOfNode(t *testing.T) {
+	now := time.Date(2021, time.October, 3, 0, 0, 0, 0, time.UTC)
+	sun := SunMeanLongitude(now)
+
+	if got := LocalTimeOfNode(sun, now); math.Abs(got-12) > 1e-9 {
+		t.Errorf("Expected noon for a node under the Sun, got %f", got)
+	}
+	if got := LocalTimeOfNode(sun-37.5, now); math.Abs(got-9.5) > 1e-9 {
+		t.Errorf("Expected 9.5h, got %f", got)
+	}
+}
+
+func TestBetaAngle(t *testing.T) {
+	solstice := time.Date(2021, time.June, 21, 3, 32, 0, 0, time.UTC)
+	if got := BetaAngle(0, 0, solstice); math.Abs(got-23.44) > 0.05 {
+		t.Errorf("Expected an equatorial orbit beta equal to the Sun declination, got %f", got)
+	}
+
+	// A polar orbit with its node facing the Sun sees it in plane
+	sun := SunPosition(solstice)
+	if got := BetaAngle(sun.RightAscension, 90, solstice); math.Abs(got) > 0.05 {
+		t.Errorf("Expected a zero beta angle, got %f", got)
+	}
+}
+
+func TestEclipseFraction(t *testing.T) {
+	// ISS-like orbit in the orbit plane of the Sun spends about 36 minutes of 92 in eclipse
+	if got := EclipseFraction(0, 420); math.Abs(got-0.39) > 0.01 {
+		t.Errorf("Expected about 0.39, got %f", got)
+	}
+	if got := EclipseFraction(80, 420); got != 0 {
+		t.Errorf("Expected no eclipse at high beta, got %f", got)
+	}
+	if EclipseFraction(30, 420) >= EclipseFraction(0, 420) {
+		t.Error("Expected the eclipse to shrink as beta grows")
+	}
+}
+
+func TestComputeOrbitLighting(t *testing.T) {
+	elements, err := ParseMeanElements(mockTLELine1, mockTLELine2)
+	if err != nil {
+		t.Fatalf("ParseMeanElements returned an error: %v", err)
+	}
+
+	at := elements.Epoch.Add(10 * 24 * time.Hour)
+	lighting := ComputeOrbitLighting(elements, at)
+
+	// The ISS node regresses by about 5 degrees per day
+	drift := LongitudeDifference(elements.RAAN, lighting.RAAN)
+	if drift > -45 || drift < -55 {
+		t.Errorf("Unexpected node drift over 10 days: %f", drift)
+	}
+	if math.Abs(math.Mod(lighting.LTAN+12, 24)-lighting.LTDN) > 1e-9 {
+		t.Errorf("Expected LTDN 12 hours after LTAN, got %f and %f", lighting.LTAN, lighting.LTDN)
+	}
+	if lighting.BetaAngle < -75 || lighting.BetaAngle > 75 {
+		t.Errorf("Beta angle out of range for the ISS: %f", lighting.BetaAngle)
+	}
+	if lighting.EclipseMinutes < 0 || lighting.EclipseMinutes > 40 {
+		t.Errorf("Unexpected eclipse duration: %f", lighting.EclipseMinutes)
+	}
+}
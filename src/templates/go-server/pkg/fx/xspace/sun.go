@@ -0,0 +1,75 @@
+package xspace
+
+import (
+	"math"
+	"time"
+
+	xconstants "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xconstants"
+	"github.com/joshuaferrara/go-satellite"
+)
+
+// CelestialPosition represents the geocentric equatorial position of a body.
+type CelestialPosition struct {
+	RightAscension float64           // Degrees, in [0, 360)
+	Declination    float64           // Degrees
+	DistanceKm     float64           // Geocentric distance in kilometers
+	ECI            satellite.Vector3 // Geocentric equatorial coordinates in kilometers
+}
+
+// JulianDate returns the Julian date of t.
+func JulianDate(t time.Time) float64 {
+	return float64(t.UTC().UnixNano())/1e9/xconstants.SECONDS_PER_DAY + xconstants.UNIX_EPOCH_JULIAN_DATE
+}
+
+// daysSinceJ2000 returns the number of days elapsed since the J2000 epoch.
+func daysSinceJ2000(t time.Time) float64 {
+	return JulianDate(t) - xconstants.J2000_JULIAN_DATE
+}
+
+// obliquity returns the obliquity of the ecliptic in degrees.
+func obliquity(t time.Time) float64 {
+	return 23.439 - 0.0000004*daysSinceJ2000(t)
+}
+
+// SunMeanLongitude returns the mean longitude of the Sun in degrees, in [0, 360).
+func SunMeanLongitude(t time.Time) float64 {
+	return math.Mod(math.Mod(280.460+0.9856474*daysSinceJ2000(t), 360)+360, 360)
+}
+
+// SunPosition returns the position of the Sun using the low-precision formulas of the
+// Astronomical Almanac (about 0.01 degree accuracy between 1950 and 2050).
+func SunPosition(t time.Time) CelestialPosition {
+	n := daysSinceJ2000(t)
+	g := DegreesToRadians(357.528 + 0.9856003*n)
+	lambda := DegreesToRadians(SunMeanLongitude(t) + 1.915*math.Sin(g) + 0.020*math.Sin(2*g))
+	distance := (1.00014 - 0.01671*math.Cos(g) - 0.00014*math.Cos(2*g)) * xconstants.ASTRONOMICAL_UNIT_KM
+
+	return eclipticToCelestialPosition(lambda, 0, distance, t)
+}
+
+// eclipticToCelestialPosition converts geocentric ecliptic coordinates in radians into an equatorial position.
+func eclipticToCelestialPosition(longitude, latitude, distanceKm float64, t time.Time) CelestialPosition {
+	epsilon := DegreesToRadians(obliquity(t))
+
+	x := math.Cos(latitude) * math.Cos(longitude)
+	y := math.Cos(latitude) * math.Sin(longitude)
+	z := math.Sin(latitude)
+
+	// Rotate around the x axis by the obliquity
+	eqY := y*math.Cos(epsilon) - z*math.Sin(epsilon)
+	eqZ := y*math.Sin(epsilon) + z*math.Cos(epsilon)
+
+	rightAscension := math.Mod(RadiansToDegrees(math.Atan2(eqY, x))+360, 360)
+	declination := RadiansToDegrees(math.Asin(eqZ))
+
+	return CelestialPosition{
+		RightAscension: rightAscension,
+		Declination:    declination,
+		DistanceKm:     distanceKm,
+		ECI: satellite.Vector3{
+			X: x * distanceKm,
+			Y: eqY * distanceKm,
+			Z: eqZ * distanceKm,
+		},
+	}
+}
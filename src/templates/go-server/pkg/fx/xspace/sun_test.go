@@ -0,0 +1,42 @@
+package xspace
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestJulianDate(t *testing.T) {
+	j2000 := time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC)
+	if got := JulianDate(j2000); math.Abs(got-2451545.0) > 1e-9 {
+		t.Errorf("Expected 2451545.0, got %f", got)
+	}
+}
+
+func TestSunPosition(t *testing.T) {
+	tests := []struct {
+		name        string
+		time        time.Time
+		ra          float64
+		declination float64
+	}{
+		{"March equinox", time.Date(2021, time.March, 20, 9, 37, 0, 0, time.UTC), 0, 0},
+		{"June solstice", time.Date(2021, time.June, 21, 3, 32, 0, 0, time.UTC), 90, 23.44},
+		{"December solstice", time.Date(2021, time.December, 21, 15, 59, 0, 0, time.UTC), 270, -23.44},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sun := SunPosition(tt.time)
+			if math.Abs(LongitudeDifference(sun.RightAscension, tt.ra)) > 0.05 {
+				t.Errorf("Expected right ascension %f, got %f", tt.ra, sun.RightAscension)
+			}
+			if math.Abs(sun.Declination-tt.declination) > 0.05 {
+				t.Errorf("Expected declination %f, got %f", tt.declination, sun.Declination)
+			}
+			if au := sun.DistanceKm / 149597870.7; au < 0.98 || au > 1.02 {
+				t.Errorf("Unexpected Sun distance %f AU", au)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package xspace
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	xconstants "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xconstants"
+	xpolygon "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xpolygon"
+)
+
+// SensorType describes the geometry of an observation payload.
+type SensorType string
+
+const (
+	// SensorTypeSwath is a push-broom or whisk-broom sensor imaging a cross-track swath.
+	SensorTypeSwath SensorType = "SWATH"
+	// SensorTypeConical is a framing sensor with a conical field of view.
+	SensorTypeConical SensorType = "CONICAL"
+)
+
+// Sensor represents an observation payload and its pointing limits.
+type Sensor struct {
+	Type         SensorType `json:"type"`
+	SwathWidthKm float64    `json:"swathWidthKm"` // Cross-track swath width at nadir, swath sensors only
+	HalfAngle    float64    `json:"halfAngle"`    // Half cone angle in degrees, conical sensors only
+	MaxOffNadir  float64    `json:"maxOffNadir"`  // Maximum off-nadir pointing angle in degrees
+}
+
+// Validate checks that the sensor parameters are consistent with its type.
+func (s Sensor) Validate() error {
+	if s.MaxOffNadir < 0 || s.MaxOffNadir >= 90 {
+		return fmt.Errorf("off-nadir limit out of range: %f", s.MaxOffNadir)
+	}
+	switch s.Type {
+	case SensorTypeSwath:
+		if s.SwathWidthKm <= 0 {
+			return errors.New("swath width must be greater than zero")
+		}
+	case SensorTypeConical:
+		if s.HalfAngle <= 0 || s.HalfAngle+s.MaxOffNadir >= 90 {
+			return fmt.Errorf("cone half angle out of range: %f", s.HalfAngle)
+		}
+	default:
+		return fmt.Errorf("invalid sensor type: %s", s.Type)
+	}
+	return nil
+}
+
+// AccessHalfWidthKm returns the cross-track ground distance from nadir reachable by the sensor at an altitude,
+// pointing included.
+func (s Sensor) AccessHalfWidthKm(altitudeKm float64) float64 {
+	switch s.Type {
+	case SensorTypeSwath:
+		return OffNadirGroundRange(altitudeKm, s.MaxOffNadir) + s.SwathWidthKm/2
+	case SensorTypeConical:
+		return OffNadirGroundRange(altitudeKm, s.MaxOffNadir+s.HalfAngle)
+	default:
+		return 0
+	}
+}
+
+// OffNadirGroundRange returns the ground distance in kilometers between the sub-satellite point and the point
+// seen at the given off-nadir angle, on a spherical Earth. Angles beyond the horizon are clamped to it.
+func OffNadirGroundRange(altitudeKm, offNadir float64) float64 {
+	radius := xconstants.EARTH_EQUATORIAL_RADIUS_KM
+	eta := DegreesToRadians(offNadir)
+	sinRho := radius / (radius + altitudeKm)
+	horizon := math.Asin(sinRho)
+	if eta >= horizon {
+		// Central angle to the horizon
+		return radius * (math.Pi/2 - horizon)
+	}
+	elevation := math.Acos(math.Sin(eta) / sinRho)
+	return radius * (math.Pi/2 - eta - elevation)
+}
+
+// InitialBearing returns the bearing in degrees from a to b along the great circle.
+func InitialBearing(a, b xpolygon.Point) float64 {
+	lat1, lat2 := DegreesToRadians(a.Latitude), DegreesToRadians(b.Latitude)
+	dLon := DegreesToRadians(b.Longitude - a.Longitude)
+	y := math.Sin(dLon) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLon)
+	return math.Mod(RadiansToDegrees(math.Atan2(y, x))+360, 360)
+}
+
+// DestinationPoint returns the point reached from origin along a bearing in degrees after a distance in kilometers.
+// The longitude is normalized to [-180, 180).
+func DestinationPoint(origin xpolygon.Point, bearing, distanceKm float64) xpolygon.Point {
+	delta := distanceKm / xconstants.EARTH_EQUATORIAL_RADIUS_KM
+	theta := DegreesToRadians(bearing)
+	lat1 := DegreesToRadians(origin.Latitude)
+	lon1 := DegreesToRadians(origin.Longitude)
+
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(delta) + math.Cos(lat1)*math.Sin(delta)*math.Cos(theta))
+	lon2 := lon1 + math.Atan2(math.Sin(theta)*math.Sin(delta)*math.Cos(lat1), math.Cos(delta)-math.Sin(lat1)*math.Sin(lat2))
+
+	return xpolygon.Point{
+		Latitude:  RadiansToDegrees(lat2),
+		Longitude: NormalizeLongitude(RadiansToDegrees(lon2)),
+	}
+}
+
+// SwathPolygons computes the ground area reachable by a sensor along a track.
+// The track is split where it crosses the antimeridian, giving one closed polygon per segment.
+func SwathPolygons(track []SatellitePosition, sensor Sensor) ([][]xpolygon.Point, error) {
+	if err := sensor.Validate(); err != nil {
+		return nil, err
+	}
+	if len(track) < 2 {
+		return nil, errors.New("at least two positions are required to build a swath")
+	}
+
+	var polygons [][]xpolygon.Point
+	segmentStart := 0
+	for i := 1; i <= len(track); i++ {
+		if i < len(track) && math.Abs(track[i].Longitude-track[i-1].Longitude) <= 180 {
+			continue
+		}
+		if i-segmentStart >= 2 {
+			polygons = append(polygons, swathPolygon(track[segmentStart:i], sensor))
+		}
+		segmentStart = i
+	}
+	return polygons, nil
+}
+
+// swathPolygon builds the closed polygon of a track segment that does not cross the antimeridian.
+// The edges are kept within 180 degrees of the track, so that the polygon stays continuous when the swath
+// reaches past the antimeridian.
+func swathPolygon(track []SatellitePosition, sensor Sensor) []xpolygon.Point {
+	left := make([]xpolygon.Point, len(track))
+	right := make([]xpolygon.Point, len(track))
+	for i, position := range track {
+		point := xpolygon.Point{Latitude: position.Latitude, Longitude: position.Longitude}
+
+		var bearing float64
+		if i < len(track)-1 {
+			bearing = InitialBearing(point, xpolygon.Point{Latitude: track[i+1].Latitude, Longitude: track[i+1].Longitude})
+		} else {
+			// Reverse the bearing from the last point back to the previous one
+			previous := xpolygon.Point{Latitude: track[i-1].Latitude, Longitude: track[i-1].Longitude}
+			bearing = math.Mod(InitialBearing(point, previous)+180, 360)
+		}
+
+		halfWidth := sensor.AccessHalfWidthKm(position.Altitude)
+		left[i] = DestinationPoint(point, bearing-90, halfWidth)
+		left[i].Longitude = point.Longitude + LongitudeDifference(point.Longitude, left[i].Longitude)
+		right[i] = DestinationPoint(point, bearing+90, halfWidth)
+		right[i].Longitude = point.Longitude + LongitudeDifference(point.Longitude, right[i].Longitude)
+	}
+
+	polygon := make([]xpolygon.Point, 0, 2*len(track)+1)
+	polygon = append(polygon, left...)
+	for i := len(right) - 1; i >= 0; i-- {
+		polygon = append(polygon, right[i])
+	}
+	return append(polygon, left[0])
+}
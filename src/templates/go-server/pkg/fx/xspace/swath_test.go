@@ -0,0 +1,116 @@
+package xspace
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	xpolygon "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xpolygon"
+)
+
+func TestSensorValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		sensor  Sensor
+		wantErr bool
+	}{
+		{"Valid swath", Sensor{Type: SensorTypeSwath, SwathWidthKm: 290, MaxOffNadir: 20}, false},
+		{"Valid cone", Sensor{Type: SensorTypeConical, HalfAngle: 2, MaxOffNadir: 30}, false},
+		{"Swath without width", Sensor{Type: SensorTypeSwath}, true},
+		{"Cone beyond the horizon", Sensor{Type: SensorTypeConical, HalfAngle: 45, MaxOffNadir: 50}, true},
+		{"Unknown type", Sensor{Type: "RADAR", SwathWidthKm: 10}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.sensor.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOffNadirGroundRange(t *testing.T) {
+	if got := OffNadirGroundRange(700, 0); got != 0 {
+		t.Errorf("Expected no ground range at nadir, got %f", got)
+	}
+
+	// Flat Earth approximation holds for small angles
+	got := OffNadirGroundRange(700, 5)
+	expected := 700 * math.Tan(DegreesToRadians(5))
+	if math.Abs(got-expected) > 1 {
+		t.Errorf("Expected about %f km, got %f km", expected, got)
+	}
+
+	// Pointing past the horizon is clamped to it
+	if OffNadirGroundRange(700, 89) != OffNadirGroundRange(700, 70) {
+		t.Error("Expected the ground range to be clamped to the horizon")
+	}
+}
+
+func TestDestinationPoint(t *testing.T) {
+	origin := xpolygon.Point{Latitude: 0, Longitude: 0}
+	quarter := math.Pi / 2 * 6378.137
+
+	east := DestinationPoint(origin, 90, quarter)
+	if math.Abs(east.Latitude) > 1e-6 || math.Abs(east.Longitude-90) > 1e-6 {
+		t.Errorf("Expected (0, 90), got %+v", east)
+	}
+	west := DestinationPoint(origin, 270, quarter)
+	if math.Abs(west.Longitude+90) > 1e-6 {
+		t.Errorf("Expected (0, -90), got %+v", west)
+	}
+
+	// Crossing the antimeridian wraps the longitude
+	crossing := DestinationPoint(xpolygon.Point{Latitude: 0, Longitude: 170}, 90, quarter/4.5)
+	if math.Abs(crossing.Longitude+170) > 1e-6 {
+		t.Errorf("Expected (0, -170), got %+v", crossing)
+	}
+	crossing = DestinationPoint(xpolygon.Point{Latitude: 10, Longitude: -175}, 270, 2000)
+	if crossing.Longitude < 150 || crossing.Longitude >= 180 {
+		t.Errorf("Expected an eastern longitude in range, got %+v", crossing)
+	}
+	if bearing := InitialBearing(origin, xpolygon.Point{Latitude: 10, Longitude: 0}); math.Abs(bearing) > 1e-9 {
+		t.Errorf("Expected a northward bearing, got %f", bearing)
+	}
+}
+
+func TestSwathPolygons(t *testing.T) {
+	start := time.Date(2021, time.October, 3, 0, 0, 0, 0, time.UTC)
+	track, err := PropagateRange(mockTLELine1, mockTLELine2, start, start.Add(95*time.Minute), time.Minute)
+	if err != nil {
+		t.Fatalf("PropagateRange returned an error: %v", err)
+	}
+
+	sensor := Sensor{Type: SensorTypeSwath, SwathWidthKm: 100, MaxOffNadir: 0}
+	polygons, err := SwathPolygons(track, sensor)
+	if err != nil {
+		t.Fatalf("SwathPolygons returned an error: %v", err)
+	}
+	if len(polygons) < 2 {
+		t.Fatalf("Expected the orbit to be split at the antimeridian, got %d polygons", len(polygons))
+	}
+
+	for _, polygon := range polygons {
+		if polygon[0] != polygon[len(polygon)-1] {
+			t.Error("Expected a closed polygon")
+		}
+	}
+
+	// The sub-satellite point lies inside its swath
+	middle := track[len(track)/4]
+	point := xpolygon.Point{Latitude: middle.Latitude, Longitude: middle.Longitude}
+	inside := false
+	for _, polygon := range polygons {
+		if xpolygon.IsPointInPolygon(point, polygon) {
+			inside = true
+		}
+	}
+	if !inside {
+		t.Errorf("Expected the sub-satellite point %+v to lie inside the swath", point)
+	}
+
+	if _, err := SwathPolygons(track[:1], sensor); err == nil {
+		t.Error("Expected an error for a single position")
+	}
+}
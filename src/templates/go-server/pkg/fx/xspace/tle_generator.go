@@ -0,0 +1,204 @@
+package xspace
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	xconstants "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xconstants"
+)
+
+// MAX_TLE_NORAD_ID is the largest catalog number that fits the five-digit TLE field.
+const MAX_TLE_NORAD_ID int = 99999
+
+// KeplerianElements holds the classical orbital elements of a user-defined orbit.
+type KeplerianElements struct {
+	Epoch           time.Time // Epoch of the elements
+	SemiMajorAxisKm float64   // Kilometers
+	Eccentricity    float64   // Dimensionless
+	Inclination     float64   // Degrees
+	RAAN            float64   // Right ascension of the ascending node in degrees
+	ArgPerigee      float64   // Degrees
+	MeanAnomaly     float64   // Degrees
+	BStar           float64   // Drag term in inverse earth radii
+}
+
+// Validate ensures that the elements describe a closed orbit above the Earth surface.
+func (k KeplerianElements) Validate() error {
+	if k.Epoch.IsZero() {
+		return fmt.Errorf("epoch cannot be zero")
+	}
+	if k.Eccentricity < 0 || k.Eccentricity >= 1 {
+		return fmt.Errorf("eccentricity out of bounds: %f", k.Eccentricity)
+	}
+	if k.Inclination < 0 || k.Inclination > 180 {
+		return fmt.Errorf("inclination out of bounds: %f", k.Inclination)
+	}
+	if perigee := k.SemiMajorAxisKm * (1 - k.Eccentricity); perigee <= xconstants.EARTH_EQUATORIAL_RADIUS_KM {
+		return fmt.Errorf("perigee radius below the Earth surface: %f km", perigee)
+	}
+	return nil
+}
+
+// MeanMotionFromSemiMajorAxis returns the mean motion in revolutions per day of an orbit of the given size.
+func MeanMotionFromSemiMajorAxis(semiMajorAxisKm float64) float64 {
+	n := math.Sqrt(xconstants.EARTH_MU_KM / math.Pow(semiMajorAxisKm, 3)) // rad/s
+	return n * xconstants.SECONDS_PER_DAY / (2 * math.Pi)
+}
+
+// MeanElements converts the Keplerian elements to the mean elements carried by a TLE.
+// The two-body mean motion is used as the SGP4 mean motion, which is accurate enough for synthetic objects.
+func (k KeplerianElements) MeanElements() (MeanElements, error) {
+	if err := k.Validate(); err != nil {
+		return MeanElements{}, err
+	}
+	return MeanElements{
+		Epoch:        k.Epoch.UTC(),
+		Inclination:  k.Inclination,
+		RAAN:         normalizeAngle(k.RAAN),
+		Eccentricity: k.Eccentricity,
+		ArgPerigee:   normalizeAngle(k.ArgPerigee),
+		MeanAnomaly:  normalizeAngle(k.MeanAnomaly),
+		MeanMotion:   MeanMotionFromSemiMajorAxis(k.SemiMajorAxisKm),
+		BStar:        k.BStar,
+	}, nil
+}
+
+// TLEChecksum computes the modulo 10 checksum of the first 68 columns of a TLE line.
+// Digits count for their value, minus signs count for one, every other character is ignored.
+func TLEChecksum(line string) int {
+	if len(line) > 68 {
+		line = line[:68]
+	}
+	sum := 0
+	for _, c := range line {
+		switch {
+		case c >= '0' && c <= '9':
+			sum += int(c - '0')
+		case c == '-':
+			sum++
+		}
+	}
+	return sum % 10
+}
+
+// ValidateTLEChecksum checks that the last column of a TLE line matches its checksum.
+func ValidateTLEChecksum(line string) error {
+	if len(line) != 69 {
+		return fmt.Errorf("invalid TLE line length: %d", len(line))
+	}
+	expected := TLEChecksum(line)
+	if int(line[68]-'0') != expected {
+		return fmt.Errorf("invalid TLE checksum: got %c, expected %d", line[68], expected)
+	}
+	return nil
+}
+
+// FormatTLE builds the two lines of an unclassified TLE from mean elements, including the checksums.
+func FormatTLE(noradID int, intlDesignator string, elements MeanElements) (string, string, error) {
+	if noradID <= 0 || noradID > MAX_TLE_NORAD_ID {
+		return "", "", fmt.Errorf("NORAD ID out of bounds: %d", noradID)
+	}
+	if len(intlDesignator) > 8 {
+		return "", "", fmt.Errorf("international designator too long: %s", intlDesignator)
+	}
+	if elements.MeanMotion <= 0 || elements.MeanMotion >= 100 {
+		return "", "", fmt.Errorf("mean motion out of bounds: %f", elements.MeanMotion)
+	}
+
+	meanMotionDot, err := formatMeanMotionDot(elements.MeanMotionDot)
+	if err != nil {
+		return "", "", err
+	}
+	bstar, err := formatImpliedDecimal(elements.BStar)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid bstar: %w", err)
+	}
+	eccentricity := int(math.Round(elements.Eccentricity * 1e7))
+	if eccentricity < 0 || eccentricity > 9999999 {
+		return "", "", fmt.Errorf("eccentricity out of bounds: %f", elements.Eccentricity)
+	}
+
+	line1 := fmt.Sprintf("1 %05dU %-8s %s %s  00000-0 %s 0  999",
+		noradID, intlDesignator, formatTLEEpoch(elements.Epoch), meanMotionDot, bstar)
+	line2 := fmt.Sprintf("2 %05d %8.4f %8.4f %07d %8.4f %8.4f %11.8f%5d",
+		noradID, elements.Inclination, normalizeAngle(elements.RAAN), eccentricity,
+		normalizeAngle(elements.ArgPerigee), normalizeAngle(elements.MeanAnomaly), elements.MeanMotion, 0)
+
+	line1 += strconv.Itoa(TLEChecksum(line1))
+	line2 += strconv.Itoa(TLEChecksum(line2))
+	return line1, line2, nil
+}
+
+// GenerateTLE builds a TLE for a user-defined orbit from its Keplerian elements.
+func GenerateTLE(noradID int, intlDesignator string, elements KeplerianElements) (string, string, error) {
+	mean, err := elements.MeanElements()
+	if err != nil {
+		return "", "", err
+	}
+	return FormatTLE(noradID, intlDesignator, mean)
+}
+
+// formatTLEEpoch formats a time as the YYDDD.DDDDDDDD epoch of a TLE.
+func formatTLEEpoch(t time.Time) string {
+	t = t.UTC()
+	startOfYear := time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	dayOfYear := 1 + t.Sub(startOfYear).Hours()/24
+	// Rounding up to the next day would overflow the 8 decimals field
+	dayOfYear = math.Min(dayOfYear, math.Floor(dayOfYear)+0.99999999)
+	return fmt.Sprintf("%02d%012.8f", t.Year()%100, dayOfYear)
+}
+
+// formatMeanMotionDot formats the first derivative of the mean motion as the signed " .NNNNNNNN" TLE field.
+func formatMeanMotionDot(value float64) (string, error) {
+	if math.Abs(value) >= 1 {
+		return "", fmt.Errorf("mean motion derivative out of bounds: %f", value)
+	}
+	sign := " "
+	if value < 0 {
+		sign = "-"
+	}
+	digits := fmt.Sprintf("%.8f", math.Abs(value))
+	if strings.HasPrefix(digits, "1") {
+		return "", fmt.Errorf("mean motion derivative out of bounds: %f", value)
+	}
+	return sign + strings.TrimPrefix(digits, "0"), nil
+}
+
+// formatImpliedDecimal formats a value in the TLE "implied decimal point" notation (e.g. 0.58234e-4 = " 58234-4").
+func formatImpliedDecimal(value float64) (string, error) {
+	sign := ' '
+	if value < 0 {
+		sign = '-'
+	}
+	abs := math.Abs(value)
+	if abs == 0 {
+		return " 00000-0", nil
+	}
+
+	exponent := int(math.Floor(math.Log10(abs))) + 1
+	mantissa := int(math.Round(abs / math.Pow(10, float64(exponent)) * 1e5))
+	if mantissa >= 100000 {
+		mantissa /= 10
+		exponent++
+	}
+	if exponent < -9 || exponent > 9 {
+		return "", fmt.Errorf("value out of bounds: %g", value)
+	}
+	return fmt.Sprintf("%c%05d%+d", sign, mantissa, exponent), nil
+}
+
+// normalizeAngle wraps an angle in degrees into [0, 360).
+func normalizeAngle(angle float64) float64 {
+	angle = math.Mod(angle, 360)
+	if angle < 0 {
+		angle += 360
+	}
+	// Values that would print as 360.0000 are wrapped to zero
+	if angle >= 359.99995 {
+		angle = 0
+	}
+	return angle
+}
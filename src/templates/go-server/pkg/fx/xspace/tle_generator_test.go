@@ -0,0 +1,147 @@
+package xspace
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/joshuaferrara/go-satellite"
+)
+
+// Reference element set with valid checksums
+const (
+	checksumTLELine1 = "1 25544U 98067A   08264.51782528 -.00002182  00000-0 -11606-4 0  2927"
+	checksumTLELine2 = "2 25544  51.6416 247.4627 0006703 130.5360 325.0288 15.72125391563537"
+)
+
+func TestTLEChecksum(t *testing.T) {
+	for _, line := range []string{checksumTLELine1, checksumTLELine2} {
+		if err := ValidateTLEChecksum(line); err != nil {
+			t.Errorf("Expected a valid checksum for %q: %v", line, err)
+		}
+	}
+
+	corrupted := checksumTLELine2[:68] + "0"
+	if err := ValidateTLEChecksum(corrupted); err == nil {
+		t.Error("Expected an error for a corrupted checksum")
+	}
+}
+
+func TestFormatTLERoundTrip(t *testing.T) {
+	elements, err := ParseMeanElements(checksumTLELine1, checksumTLELine2)
+	if err != nil {
+		t.Fatalf("ParseMeanElements returned an error: %v", err)
+	}
+
+	line1, line2, err := FormatTLE(25544, "98067A", elements)
+	if err != nil {
+		t.Fatalf("FormatTLE returned an error: %v", err)
+	}
+	if len(line1) != 69 || len(line2) != 69 {
+		t.Fatalf("Unexpected line lengths: %d, %d", len(line1), len(line2))
+	}
+	if line1[:52] != checksumTLELine1[:52] || line1[53:61] != checksumTLELine1[53:61] {
+		t.Errorf("Line 1 mismatch:\n got %q\nwant %q", line1, checksumTLELine1)
+	}
+	if line2[:63] != checksumTLELine2[:63] {
+		t.Errorf("Line 2 mismatch:\n got %q\nwant %q", line2, checksumTLELine2)
+	}
+	for _, line := range []string{line1, line2} {
+		if err := ValidateTLEChecksum(line); err != nil {
+			t.Errorf("Invalid checksum for %q: %v", line, err)
+		}
+	}
+
+	parsed, err := ParseMeanElements(line1, line2)
+	if err != nil {
+		t.Fatalf("ParseMeanElements of the generated TLE returned an error: %v", err)
+	}
+	if math.Abs(parsed.BStar-elements.BStar) > 1e-12 || math.Abs(parsed.MeanMotionDot-elements.MeanMotionDot) > 1e-12 {
+		t.Errorf("Drag terms mismatch: got %+v, want %+v", parsed, elements)
+	}
+	if parsed.Epoch.Sub(elements.Epoch).Abs() > time.Millisecond {
+		t.Errorf("Epoch mismatch: got %v, want %v", parsed.Epoch, elements.Epoch)
+	}
+}
+
+func TestFormatImpliedDecimal(t *testing.T) {
+	tests := []struct {
+		value    float64
+		expected string
+	}{
+		{0, " 00000-0"},
+		{0.58234e-4, " 58234-4"},
+		{-0.11606e-3, "-11606-3"},
+		{0.999999e-5, " 10000-4"},
+	}
+
+	for _, tt := range tests {
+		got, err := formatImpliedDecimal(tt.value)
+		if err != nil {
+			t.Fatalf("formatImpliedDecimal(%g) returned an error: %v", tt.value, err)
+		}
+		if got != tt.expected {
+			t.Errorf("formatImpliedDecimal(%g): expected %q, got %q", tt.value, tt.expected, got)
+		}
+	}
+}
+
+func TestGenerateTLEPropagates(t *testing.T) {
+	epoch := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	elements := KeplerianElements{
+		Epoch:           epoch,
+		SemiMajorAxisKm: 6878.137,
+		Eccentricity:    0.001,
+		Inclination:     97.4,
+		RAAN:            -10,
+		ArgPerigee:      90,
+		MeanAnomaly:     370,
+	}
+
+	line1, line2, err := GenerateTLE(90001, "24900A", elements)
+	if err != nil {
+		t.Fatalf("GenerateTLE returned an error: %v", err)
+	}
+
+	parsed, err := ParseMeanElements(line1, line2)
+	if err != nil {
+		t.Fatalf("ParseMeanElements returned an error: %v", err)
+	}
+	if math.Abs(parsed.SemiMajorAxisKm()-elements.SemiMajorAxisKm) > 0.01 {
+		t.Errorf("Semi-major axis mismatch: got %f", parsed.SemiMajorAxisKm())
+	}
+	if math.Abs(parsed.RAAN-350) > 1e-4 || math.Abs(parsed.MeanAnomaly-10) > 1e-4 {
+		t.Errorf("Angles were not normalized: RAAN %f, mean anomaly %f", parsed.RAAN, parsed.MeanAnomaly)
+	}
+
+	satrec := satellite.TLEToSat(line1, line2, satellite.GravityWGS84)
+	if satrec.Error != 0 {
+		t.Fatalf("SGP4 rejected the generated TLE: error code %d", satrec.Error)
+	}
+	position, _ := satellite.Propagate(satrec, 2024, 3, 1, 13, 0, 0)
+	radius := math.Sqrt(position.X*position.X + position.Y*position.Y + position.Z*position.Z)
+	if math.Abs(radius-elements.SemiMajorAxisKm) > 50 {
+		t.Errorf("Unexpected orbit radius after propagation: %f km", radius)
+	}
+}
+
+func TestGenerateTLEInvalid(t *testing.T) {
+	epoch := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	valid := KeplerianElements{Epoch: epoch, SemiMajorAxisKm: 7000, Inclination: 53}
+
+	if _, _, err := GenerateTLE(100000, "", valid); err == nil {
+		t.Error("Expected an error for an out of range NORAD ID")
+	}
+
+	belowSurface := valid
+	belowSurface.SemiMajorAxisKm = 6000
+	if _, _, err := GenerateTLE(90000, "", belowSurface); err == nil {
+		t.Error("Expected an error for an orbit below the surface")
+	}
+
+	hyperbolic := valid
+	hyperbolic.Eccentricity = 1.2
+	if _, _, err := GenerateTLE(90000, "", hyperbolic); err == nil {
+		t.Error("Expected an error for an open orbit")
+	}
+}
@@ -10,10 +10,11 @@ import (
 	"github.com/joshuaferrara/go-satellite"
 )
 
-// ComputeVisibilityWindow computes the visibility window for a satellite over a given tile.
+// ComputeVisibilityWindow computes the visibility window for a satellite over a given tile,
+// seen from an observer at the tile center whose altitude and refraction are accounted for.
 func ComputeVisibilityWindow(
 	noradID, tleLine1, tleLine2 string,
-	point xpolygon.Point,
+	observer Observer,
 	radius float64,
 	startTime, endTime time.Time, timeStep time.Duration,
 ) (time.Time, float64) {
@@ -24,7 +25,7 @@ func ComputeVisibilityWindow(
 
 	satrec := satellite.TLEToSat(tleLine1, tleLine2, satellite.GravityWGS84)
 
-	aos := ComputeAOS(satrec, point, tileRadiusKm, startTime, endTime, timeStep, &maxElevation)
+	aos := ComputeAOS(satrec, observer, tileRadiusKm, startTime, endTime, timeStep, &maxElevation)
 	if aos.IsZero() {
 		return aos, maxElevation
 	} else {
@@ -34,13 +35,15 @@ func ComputeVisibilityWindow(
 	return aos, maxElevation
 }
 
-// ComputeAOS computes the Acquisition of Signal (AOS) time for a satellite over a given tile.
+// ComputeAOS computes the Acquisition of Signal (AOS) time for a satellite over a given tile,
+// that is the first time it crosses the tile while above the horizon of the observer at the tile center.
 func ComputeAOS(
-	satrec satellite.Satellite, point xpolygon.Point,
+	satrec satellite.Satellite, observer Observer,
 	tileRadiusKm float64, startTime, endTime time.Time,
 	timeStep time.Duration, maxElevation *float64,
 ) time.Time {
 
+	point := xpolygon.Point{Latitude: observer.Latitude, Longitude: observer.Longitude}
 	for t := startTime; t.Before(endTime); t = t.Add(timeStep) {
 		altitude, geo, err := PropagateSatellitePosition(satrec, t)
 		if err != nil {
@@ -52,7 +55,7 @@ func ComputeAOS(
 
 		if Intersects(point, satellitePos, tileRadiusKm, altitude) {
 
-			elevation := CalculateIntegratedElevationFromPoint(satellitePos, altitude, point)
+			elevation := CalculateElevationFromObserver(satellitePos, altitude, observer)
 
 			// Check if AOS is valid
 			if elevation > 0 {
@@ -104,22 +107,23 @@ func Intersects(tileCenter xpolygon.Point, satellitePos xpolygon.Point, tileRadi
 	return centerDistance <= tileRadiusKm+marginOfError
 }
 
-// PropagateSatellitePosition calculates the satellite's geodetic position at a specific time.
+// PropagateSatellitePosition calculates the satellite's geodetic position at a specific time,
+// latitude and longitude in degrees like the ground points they are compared with.
 func PropagateSatellitePosition(satrec satellite.Satellite, t time.Time) (float64, satellite.LatLong, error) {
 	year, month, day := t.Date()
 	hour, minute, second := t.Clock()
 	position, _ := satellite.Propagate(satrec, year, int(month), day, hour, minute, second)
 	gmst := satellite.GSTimeFromDate(year, int(month), day, hour, minute, second)
 	altitude, _, geo := satellite.ECIToLLA(position, gmst)
-	return altitude, geo, nil
+	return altitude, satellite.LatLongDeg(geo), nil
 }
 
-// CalculateIntegratedElevation computes the elevation of a satellite relative to a ground point.
-func CalculateIntegratedElevation(satelliteQuadKey xpolygon.Quadkey, satelliteAltitude float64, pointQuadKey xpolygon.Point) float64 {
-	return CalculateIntegratedElevationFromPoint(
+// CalculateIntegratedElevation computes the elevation of a satellite above the local horizon of an observer.
+func CalculateIntegratedElevation(satelliteQuadKey xpolygon.Quadkey, satelliteAltitude float64, observer Observer) float64 {
+	return CalculateElevationFromObserver(
 		xpolygon.Point{Latitude: satelliteQuadKey.Latitude, Longitude: satelliteQuadKey.Longitude},
 		satelliteAltitude,
-		xpolygon.Point{Latitude: pointQuadKey.Latitude, Longitude: pointQuadKey.Longitude},
+		observer,
 	)
 }
 
@@ -163,8 +167,8 @@ func ComputeSatelliteHorizon(t time.Time, line1 string, line2 string) ([]xpolygo
 	numPoints := 36
 	for i := 0; i < numPoints; i++ {
 		angle := float64(i) * (2 * math.Pi / float64(numPoints))
-		latOffset := horizonDistance / xconstants.EARTH_RADIUS_KM * math.Sin(angle)
-		lonOffset := horizonDistance / xconstants.EARTH_RADIUS_KM * math.Cos(angle)
+		latOffset := RadiansToDegrees(horizonDistance / xconstants.EARTH_RADIUS_KM * math.Sin(angle))
+		lonOffset := RadiansToDegrees(horizonDistance / xconstants.EARTH_RADIUS_KM * math.Cos(angle))
 
 		// Calculate the new latitude and longitude by applying the offsets
 		lat := subSatellitePoint.Latitude + latOffset
@@ -145,10 +145,10 @@ func TestIntersectsEdge(t *testing.T) {
 }
 
 func TestComputeAOS(t *testing.T) {
-	startTime := time.Now()
-	endTime := startTime.Add(1 * time.Hour) // Extended time window
-	timeStep := 5 * time.Second             // Reduced time step
-	tileRadiusKm := 7000.0                  // Increased tile radius to 7000 km
+	startTime := time.Date(2020, time.December, 9, 13, 0, 0, 0, time.UTC) // TLE epoch
+	endTime := startTime.Add(24 * time.Hour)                              // A day of ground tracks
+	timeStep := 5 * time.Second                                           // Reduced time step
+	tileRadiusKm := 7000.0                                                // Increased tile radius to 7000 km
 
 	tleLine1 := "1 25544U 98067A   20344.54791435  .00001234  00000-0  29746-4 0  9998"
 	tleLine2 := "2 25544  51.6456 212.9669 0001235 341.2074 106.3520 15.48921140255678"
@@ -199,7 +199,8 @@ func TestComputeAOS(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var maxElevation float64 = -1.0
 
-			aos := ComputeAOS(satrec, tt.vertices[0], tileRadiusKm, startTime, endTime, timeStep, &maxElevation)
+			observer := Observer{Latitude: tt.vertices[0].Latitude, Longitude: tt.vertices[0].Longitude}
+			aos := ComputeAOS(satrec, observer, tileRadiusKm, startTime, endTime, timeStep, &maxElevation)
 
 			if tt.expectedAOS && aos.IsZero() {
 				t.Errorf("Expected AOS but got none.")
@@ -285,7 +286,8 @@ func TestComputeVisibilityWindow(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Compute the visibility window
-			aos, maxElevation := ComputeVisibilityWindow("25544", tt.tleLine1, tt.tleLine2, tt.vertices[0], tt.tileRadiusKm, tt.startTime, tt.endTime, timeStep)
+			observer := Observer{Latitude: tt.vertices[0].Latitude, Longitude: tt.vertices[0].Longitude}
+			aos, maxElevation := ComputeVisibilityWindow("25544", tt.tleLine1, tt.tleLine2, observer, tt.tileRadiusKm, tt.startTime, tt.endTime, timeStep)
 
 			// Assert AOS is not zero
 			if aos.IsZero() {
@@ -0,0 +1,81 @@
+package xspace
+
+import (
+	"fmt"
+	"time"
+
+	xconstants "github.com/Elbujito/2112/src/templates/go-server/pkg/fx/xconstants"
+)
+
+// WalkerPattern represents the way the orbital planes of a Walker constellation are spread.
+type WalkerPattern string
+
+const (
+	// WALKER_DELTA spreads the ascending nodes over 360 degrees.
+	WALKER_DELTA WalkerPattern = "DELTA"
+	// WALKER_STAR spreads the ascending nodes over 180 degrees (polar constellations).
+	WALKER_STAR WalkerPattern = "STAR"
+)
+
+// WalkerConstellation describes a Walker T/P/F constellation of circular orbits.
+type WalkerConstellation struct {
+	Pattern         WalkerPattern `json:"pattern"`
+	TotalSatellites int           `json:"totalSatellites"` // T
+	Planes          int           `json:"planes"`          // P
+	Phasing         int           `json:"phasing"`         // F, in [0, P-1]
+	AltitudeKm      float64       `json:"altitudeKm"`
+	Inclination     float64       `json:"inclination"` // Degrees
+	RAANOffset      float64       `json:"raanOffset"`  // RAAN of the first plane in degrees
+}
+
+// Validate ensures that the constellation parameters are consistent.
+func (w WalkerConstellation) Validate() error {
+	if w.Pattern != WALKER_DELTA && w.Pattern != WALKER_STAR {
+		return fmt.Errorf("invalid walker pattern: %s", w.Pattern)
+	}
+	if w.TotalSatellites <= 0 || w.Planes <= 0 {
+		return fmt.Errorf("total satellites and planes must be greater than zero")
+	}
+	if w.TotalSatellites%w.Planes != 0 {
+		return fmt.Errorf("total satellites %d is not a multiple of the number of planes %d", w.TotalSatellites, w.Planes)
+	}
+	if w.Phasing < 0 || w.Phasing >= w.Planes {
+		return fmt.Errorf("phasing factor out of bounds: %d", w.Phasing)
+	}
+	if w.AltitudeKm <= 0 {
+		return fmt.Errorf("altitude must be greater than zero")
+	}
+	if w.Inclination < 0 || w.Inclination > 180 {
+		return fmt.Errorf("inclination out of bounds: %f", w.Inclination)
+	}
+	return nil
+}
+
+// Elements returns the Keplerian elements of every satellite of the constellation at the given epoch,
+// ordered plane by plane.
+func (w WalkerConstellation) Elements(epoch time.Time) ([]KeplerianElements, error) {
+	if err := w.Validate(); err != nil {
+		return nil, err
+	}
+
+	nodeSpread := 360.0
+	if w.Pattern == WALKER_STAR {
+		nodeSpread = 180.0
+	}
+	perPlane := w.TotalSatellites / w.Planes
+	phaseStep := 360.0 * float64(w.Phasing) / float64(w.TotalSatellites)
+
+	elements := make([]KeplerianElements, 0, w.TotalSatellites)
+	for plane := 0; plane < w.Planes; plane++ {
+		for slot := 0; slot < perPlane; slot++ {
+			elements = append(elements, KeplerianElements{
+				Epoch:           epoch,
+				SemiMajorAxisKm: xconstants.EARTH_EQUATORIAL_RADIUS_KM + w.AltitudeKm,
+				Inclination:     w.Inclination,
+				RAAN:            normalizeAngle(w.RAANOffset + nodeSpread*float64(plane)/float64(w.Planes)),
+				MeanAnomaly:     normalizeAngle(360.0*float64(slot)/float64(perPlane) + phaseStep*float64(plane)),
+			})
+		}
+	}
+	return elements, nil
+}
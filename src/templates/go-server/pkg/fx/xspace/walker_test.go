@@ -0,0 +1,82 @@
+package xspace
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestWalkerConstellationElements(t *testing.T) {
+	walker := WalkerConstellation{
+		Pattern:         WALKER_DELTA,
+		TotalSatellites: 24,
+		Planes:          3,
+		Phasing:         1,
+		AltitudeKm:      23222,
+		Inclination:     56,
+	}
+
+	elements, err := walker.Elements(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Elements returned an error: %v", err)
+	}
+	if len(elements) != 24 {
+		t.Fatalf("Expected 24 satellites, got %d", len(elements))
+	}
+
+	tests := []struct {
+		index       int
+		raan        float64
+		meanAnomaly float64
+	}{
+		{0, 0, 0},
+		{1, 0, 45},
+		{8, 120, 15},  // Second plane shifted by F*360/T
+		{17, 240, 75}, // Third plane, second slot
+	}
+	for _, tt := range tests {
+		got := elements[tt.index]
+		if math.Abs(got.RAAN-tt.raan) > 1e-9 || math.Abs(got.MeanAnomaly-tt.meanAnomaly) > 1e-9 {
+			t.Errorf("Satellite %d: expected RAAN %f and M %f, got %f and %f", tt.index, tt.raan, tt.meanAnomaly, got.RAAN, got.MeanAnomaly)
+		}
+	}
+}
+
+func TestWalkerStarSpreadsNodesOverHalfCircle(t *testing.T) {
+	walker := WalkerConstellation{
+		Pattern:         WALKER_STAR,
+		TotalSatellites: 66,
+		Planes:          6,
+		Phasing:         2,
+		AltitudeKm:      780,
+		Inclination:     86.4,
+	}
+
+	elements, err := walker.Elements(time.Now())
+	if err != nil {
+		t.Fatalf("Elements returned an error: %v", err)
+	}
+	if got := elements[len(elements)-1].RAAN; math.Abs(got-150) > 1e-9 {
+		t.Errorf("Expected the last plane at RAAN 150, got %f", got)
+	}
+}
+
+func TestWalkerConstellationValidate(t *testing.T) {
+	base := WalkerConstellation{Pattern: WALKER_DELTA, TotalSatellites: 12, Planes: 3, Phasing: 1, AltitudeKm: 550, Inclination: 53}
+	if err := base.Validate(); err != nil {
+		t.Fatalf("Expected a valid constellation: %v", err)
+	}
+
+	notMultiple := base
+	notMultiple.TotalSatellites = 13
+	phasing := base
+	phasing.Phasing = 3
+	pattern := base
+	pattern.Pattern = "ROSETTE"
+
+	for _, w := range []WalkerConstellation{notMultiple, phasing, pattern} {
+		if err := w.Validate(); err == nil {
+			t.Errorf("Expected an error for %+v", w)
+		}
+	}
+}